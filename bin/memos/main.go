@@ -44,6 +44,9 @@ var (
 				Driver:      viper.GetString("driver"),
 				DSN:         viper.GetString("dsn"),
 				InstanceURL: viper.GetString("instance-url"),
+				SMTPAddr:    viper.GetString("smtp-addr"),
+				SMTPTLSCert: viper.GetString("smtp-tls-cert"),
+				SMTPTLSKey:  viper.GetString("smtp-tls-key"),
 				Version:     version.GetCurrentVersion(viper.GetString("mode")),
 			}
 			if err := instanceProfile.Validate(); err != nil {
@@ -112,6 +115,9 @@ func init() {
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
 	rootCmd.PersistentFlags().String("instance-url", "", "the url of your memos instance")
+	rootCmd.PersistentFlags().String("smtp-addr", "", "address of the SMTP listener creating memos from emails, e.g. :2525, disabled if empty")
+	rootCmd.PersistentFlags().String("smtp-tls-cert", "", "path to the certificate of the SMTP listener, STARTTLS and authentication are disabled if empty")
+	rootCmd.PersistentFlags().String("smtp-tls-key", "", "path to the private key of the SMTP listener")
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -137,6 +143,15 @@ func init() {
 	if err := viper.BindPFlag("instance-url", rootCmd.PersistentFlags().Lookup("instance-url")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("smtp-addr", rootCmd.PersistentFlags().Lookup("smtp-addr")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("smtp-tls-cert", rootCmd.PersistentFlags().Lookup("smtp-tls-cert")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("smtp-tls-key", rootCmd.PersistentFlags().Lookup("smtp-tls-key")); err != nil {
+		panic(err)
+	}
 
 	viper.SetEnvPrefix("memos")
 	viper.AutomaticEnv()
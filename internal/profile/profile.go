@@ -32,6 +32,13 @@ type Profile struct {
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
+	// SMTPAddr is the binding address of the SMTP listener creating memos from emails.
+	// The listener is disabled if empty.
+	SMTPAddr string
+	// SMTPTLSCert and SMTPTLSKey are the paths of the certificate and the key the SMTP listener offers STARTTLS with.
+	// Senders can only authenticate with their username and token once the connection is encrypted.
+	SMTPTLSCert string
+	SMTPTLSKey  string
 }
 
 func (p *Profile) IsDev() bool {
@@ -0,0 +1,68 @@
+// Package template renders memo templates with placeholders like {{date}} or {{project}}.
+package template
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Built-in variables are filled in by the server, any other variable is prompted for.
+const (
+	VariableDate = "date"
+	VariableTime = "time"
+	VariableUser = "user"
+)
+
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_-]*)\s*\}\}`)
+
+// IsBuiltinVariable returns true if the variable is filled in by the server.
+func IsBuiltinVariable(name string) bool {
+	return name == VariableDate || name == VariableTime || name == VariableUser
+}
+
+// BuiltinValues returns the values of the built-in variables for the user at the given time.
+func BuiltinValues(username string, t time.Time) map[string]string {
+	return map[string]string{
+		VariableDate: t.Format("2006-01-02"),
+		VariableTime: t.Format("15:04"),
+		VariableUser: username,
+	}
+}
+
+// Variables returns the variables of the content to prompt for in order of appearance, without the built-in ones.
+func Variables(content string) []string {
+	variables := []string{}
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(content, -1) {
+		name := match[1]
+		if IsBuiltinVariable(name) || slices.Contains(variables, name) {
+			continue
+		}
+		variables = append(variables, name)
+	}
+	return variables
+}
+
+// Render replaces the placeholders of the content with their values.
+// Values are inserted as is, so placeholders inside them are left alone.
+func Render(content string, values map[string]string) (string, error) {
+	missing := []string{}
+	rendered := placeholderRegexp.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := placeholderRegexp.FindStringSubmatch(placeholder)[1]
+		value, ok := values[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", errors.Errorf("missing values for variables: %s", strings.Join(missing, ", "))
+	}
+	return rendered, nil
+}
@@ -0,0 +1,26 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariables(t *testing.T) {
+	require.Equal(t, []string{}, Variables("# Stand-up {{date}}"))
+	require.Equal(t, []string{"project", "blockers"}, Variables("{{ project }} by {{user}}\n{{blockers}} {{project}}"))
+	require.Equal(t, []string{}, Variables("{{not a variable}} {single}"))
+}
+
+func TestRender(t *testing.T) {
+	values := BuiltinValues("steven", time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC))
+	values["project"] = "memos {{date}}"
+
+	rendered, err := Render("# {{date}} {{time}}\n@{{user}} on {{ project }}", values)
+	require.NoError(t, err)
+	require.Equal(t, "# 2024-03-05 09:30\n@steven on memos {{date}}", rendered)
+
+	_, err = Render("{{project}} {{blockers}} {{owner}} {{blockers}}", values)
+	require.EqualError(t, err, "missing values for variables: blockers, owner")
+}
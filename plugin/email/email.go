@@ -0,0 +1,204 @@
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of connecting to the SMTP server and sending an email.
+var timeout = 30 * time.Second
+
+// headerReplacer keeps header values on a single line.
+var headerReplacer = strings.NewReplacer("\r", "", "\n", " ")
+
+// Security is how the connection to the SMTP server is secured.
+type Security int
+
+const (
+	// SecurityStartTLS upgrades the connection with STARTTLS, and fails if the server doesn't support it.
+	SecurityStartTLS Security = iota
+	// SecurityTLS connects with implicit TLS, usually on port 465.
+	SecurityTLS
+	// SecurityNone never encrypts the connection, credentials and emails are sent in cleartext.
+	SecurityNone
+)
+
+// Config is the configuration of the SMTP server emails are sent through.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Security Security
+	// FromEmail is the address emails are sent from.
+	FromEmail string
+	// FromName is the display name emails are sent from.
+	FromName string
+}
+
+// Message is an email with a plain text and an HTML body.
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Send sends the message through the SMTP server.
+func Send(config *Config, message *Message) error {
+	if len(message.To) == 0 {
+		return errors.New("no recipients")
+	}
+	for _, to := range append([]string{config.FromEmail}, message.To...) {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return errors.Wrapf(err, "invalid email address %q", to)
+		}
+	}
+	body, err := buildMessage(config, message)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	tlsConfig := &tls.Config{ServerName: config.Host}
+	var conn net.Conn
+	if config.Security == SecurityTLS {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = net.DialTimeout("tcp", addr, timeout)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to SMTP server %s", addr)
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to set deadline")
+	}
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to create SMTP client")
+	}
+	defer client.Close()
+
+	if config.Security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.Errorf("SMTP server %s doesn't support STARTTLS, set the security to none to send emails in cleartext", addr)
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(config.FromEmail); err != nil {
+		return errors.Wrapf(err, "failed to set sender %s", config.FromEmail)
+	}
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return errors.Wrapf(err, "failed to add recipient %s", to)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start data")
+	}
+	if _, err := writer.Write(body); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to send message")
+	}
+	return client.Quit()
+}
+
+// buildMessage builds the MIME message with the text and HTML bodies as alternatives.
+func buildMessage(config *Config, message *Message) ([]byte, error) {
+	from := (&mail.Address{Name: headerReplacer.Replace(config.FromName), Address: config.FromEmail}).String()
+	to := make([]string, 0, len(message.To))
+	for _, address := range message.To {
+		to = append(to, (&mail.Address{Address: address}).String())
+	}
+	messageID, err := generateMessageID(config.FromEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	headers := []string{
+		"From: " + from,
+		"To: " + strings.Join(to, ", "),
+		"Subject: " + mime.QEncoding.Encode("utf-8", headerReplacer.Replace(message.Subject)),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + messageID,
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", writer.Boundary()),
+	}
+	for _, header := range headers {
+		buffer.WriteString(header + "\r\n")
+	}
+	buffer.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create message part")
+		}
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.body)); err != nil {
+			return nil, errors.Wrap(err, "failed to encode message part")
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to encode message part")
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close message")
+	}
+	return buffer.Bytes(), nil
+}
+
+func generateMessageID(fromEmail string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate message id")
+	}
+	domain := "localhost"
+	if address, err := mail.ParseAddress(fromEmail); err == nil {
+		if i := strings.LastIndex(address.Address, "@"); i >= 0 {
+			domain = address.Address[i+1:]
+		}
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
+}
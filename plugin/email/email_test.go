@@ -0,0 +1,162 @@
+package email
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+)
+
+func TestSend(t *testing.T) {
+	server, err := emailtest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	config := &Config{
+		Host:      server.Host(),
+		Port:      server.Port(),
+		Username:  "memos",
+		Password:  "secret",
+		FromEmail: "memos@example.com",
+		FromName:  "Memos",
+	}
+	message, err := NewMessage([]string{"alice@example.com"}, "Héllo\r\nBcc: eve@example.com", TemplateNotification, &NotificationData{
+		Title:   "bob commented on your memo",
+		Content: "<b>Nice</b>\n.memo",
+		Link:    "https://memos.example.com/memos/abc",
+	})
+	require.NoError(t, err)
+	// The server doesn't support STARTTLS, so sending fails unless cleartext is allowed.
+	require.ErrorContains(t, Send(config, message), "doesn't support STARTTLS")
+	require.Empty(t, server.Messages())
+	config.Security = SecurityNone
+	require.NoError(t, Send(config, message))
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, "memos@example.com", messages[0].From)
+	require.Equal(t, []string{"alice@example.com"}, messages[0].To)
+	parsed, err := messages[0].Parse()
+	require.NoError(t, err)
+	require.Equal(t, `"Memos" <memos@example.com>`, parsed.Header.Get("From"))
+	require.Empty(t, parsed.Header.Get("Bcc"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Héllo Bcc: eve@example.com", subject)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	parts := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[contentType] = string(body)
+	}
+	require.Contains(t, parts["text/plain"], "bob commented on your memo")
+	require.Contains(t, parts["text/plain"], "<b>Nice</b>\r\n.memo")
+	require.Contains(t, parts["text/plain"], "https://memos.example.com/memos/abc")
+	require.Contains(t, parts["text/html"], "&lt;b&gt;Nice&lt;/b&gt;")
+	require.Contains(t, parts["text/html"], `href="https://memos.example.com/memos/abc"`)
+
+	// Invalid addresses are refused before connecting.
+	message.To = []string{"alice@example.com\r\nDATA"}
+	require.Error(t, Send(config, message))
+}
+
+func TestTemplates(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		data any
+		want string
+	}{
+		{TemplateDigest, &DigestData{Period: "day", Items: []*NotificationData{{Title: "Reminder: pay rent"}}}, "Reminder: pay rent"},
+		{TemplatePasswordReset, &PasswordResetData{Username: "alice", Link: "https://memos.example.com/auth/reset", ExpiresIn: "1 hour"}, "https://memos.example.com/auth/reset"},
+		{TemplateInvitation, &InvitationData{Inviter: "bob", Link: "https://memos.example.com/auth/signup", ExpiresIn: "7 days"}, "bob invited you"},
+	} {
+		message, err := NewMessage([]string{"alice@example.com"}, "Subject", test.name, test.data)
+		require.NoError(t, err)
+		require.Contains(t, message.Text, test.want)
+		require.Contains(t, message.HTML, test.want)
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	data := strings.Join([]string{
+		`From: "Alice" <Alice@Example.com>`,
+		"To: memos@example.com",
+		"Subject: =?utf-8?q?Caf=C3=A9_notes?=",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="mixed"`,
+		"",
+		"--mixed",
+		`Content-Type: multipart/alternative; boundary="alternative"`,
+		"",
+		"--alternative",
+		"Content-Type: text/plain; charset=iso-8859-1",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Caf=E9 au lait",
+		"--alternative",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>Café <b>au lait</b></p>",
+		"--alternative--",
+		"--mixed",
+		`Content-Type: text/plain; name="notes.txt"`,
+		`Content-Disposition: attachment; filename="../../notes.txt"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		"aGVsbG8g",
+		"d29ybGQ=",
+		"--mixed--",
+		"",
+	}, "\r\n")
+	message, err := ParseMessage([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, "Alice@Example.com", message.From)
+	require.Equal(t, "Café notes", message.Subject)
+	require.Equal(t, "Café au lait", strings.TrimSpace(message.Text))
+	require.Contains(t, message.HTML, "<b>au lait</b>")
+	require.Len(t, message.Attachments, 1)
+	require.Equal(t, "notes.txt", message.Attachments[0].Filename)
+	require.Equal(t, "text/plain", message.Attachments[0].ContentType)
+	require.Equal(t, "hello world", string(message.Attachments[0].Data))
+
+	// The HTML body is converted when there's no plain text body.
+	message.Text = ""
+	markdown, err := message.Markdown()
+	require.NoError(t, err)
+	require.Equal(t, "Café **au lait**", markdown)
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	for _, test := range []struct {
+		html string
+		want string
+	}{
+		{`<p>Hello <b>world</b></p><p>Second  paragraph</p>`, "Hello **world**\n\nSecond paragraph"},
+		{`<div>One<br>Two</div><div>Three</div>`, "One\nTwo\nThree"},
+		{`<h2>Title</h2><p>See <a href="https://usememos.com">the site</a> or https://example.com</p>`, "## Title\n\nSee [the site](https://usememos.com) or https://example.com"},
+		{`<ul><li>one</li><li>two<ol><li>nested</li></ol></li></ul>`, "- one\n- two\n  1. nested"},
+		{`<blockquote><p>quoted</p><p>more</p></blockquote>`, "> quoted\n>\n> more"},
+		{`<pre>if x {\n  y()\n}</pre><p>Use <code>y</code></p>`, "```\nif x {\\n  y()\\n}\n```\n\nUse `y`"},
+		{`<html><head><style>p {}</style></head><body><img src="cid:logo"><img src="https://example.com/a.png" alt="A"><script>x()</script></body></html>`, "![A](https://example.com/a.png)"},
+	} {
+		markdown, err := HTMLToMarkdown(test.html)
+		require.NoError(t, err)
+		require.Equal(t, test.want, markdown)
+	}
+}
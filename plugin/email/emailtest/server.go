@@ -0,0 +1,153 @@
+// Package emailtest provides a fake SMTP server to test sending emails against.
+package emailtest
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Message is an email received by the server.
+type Message struct {
+	From string
+	To   []string
+	// Data is the raw message.
+	Data []byte
+}
+
+// Parse parses the raw message.
+func (m *Message) Parse() (*mail.Message, error) {
+	return mail.ReadMessage(bytes.NewReader(m.Data))
+}
+
+// Server is a fake SMTP server listening on localhost.
+// It accepts any credentials and keeps the messages it receives.
+type Server struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []*Message
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server on a random port of localhost.
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{listener: listener}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Host returns the host the server listens on.
+func (s *Server) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the port the server listens on.
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Messages returns the messages received so far.
+func (s *Server) Messages() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Message{}, s.messages...)
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	reply := func(code int, text string) {
+		conn.Write([]byte(strconv.Itoa(code) + " " + text + "\r\n"))
+	}
+	reply(220, "localhost fake SMTP server")
+
+	message := &Message{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command, argument, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(command) {
+		case "EHLO":
+			conn.Write([]byte("250-localhost\r\n250 AUTH PLAIN\r\n"))
+		case "HELO", "NOOP":
+			reply(250, "OK")
+		case "AUTH":
+			reply(235, "Authentication succeeded")
+		case "RSET":
+			message = &Message{}
+			reply(250, "OK")
+		case "MAIL":
+			message.From = parsePath(argument)
+			reply(250, "OK")
+		case "RCPT":
+			message.To = append(message.To, parsePath(argument))
+			reply(250, "OK")
+		case "DATA":
+			reply(354, "End data with <CR><LF>.<CR><LF>")
+			var data bytes.Buffer
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			message.Data = data.Bytes()
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+			message = &Message{}
+			reply(250, "OK")
+		case "QUIT":
+			reply(221, "Bye")
+			return
+		default:
+			reply(502, "Command not implemented")
+		}
+	}
+}
+
+// parsePath returns the address of a MAIL FROM or RCPT TO argument, e.g. FROM:<alice@example.com>.
+func parsePath(argument string) string {
+	_, path, _ := strings.Cut(argument, ":")
+	path, _, _ = strings.Cut(strings.TrimSpace(path), " ")
+	return strings.Trim(path, "<>")
+}
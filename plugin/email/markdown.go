@@ -0,0 +1,241 @@
+package email
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	whitespaceRegexp = regexp.MustCompile(`\s+`)
+	blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLToMarkdown converts an HTML email body to markdown.
+// Scripts, styles and inline images referenced by content ID are dropped.
+func HTMLToMarkdown(source string) (string, error) {
+	document, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse HTML")
+	}
+	w := &markdownWriter{}
+	w.writeChildren(document)
+	return w.String(), nil
+}
+
+// markdownWriter writes the markdown of HTML nodes.
+type markdownWriter struct {
+	builder strings.Builder
+}
+
+// String returns the markdown without trailing spaces and with at most one blank line between blocks.
+func (w *markdownWriter) String() string {
+	lines := strings.Split(w.builder.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(blankLinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func (w *markdownWriter) atLineStart() bool {
+	output := w.builder.String()
+	return output == "" || strings.HasSuffix(output, "\n")
+}
+
+// writeText writes text with whitespace collapsed as HTML renders it.
+func (w *markdownWriter) writeText(text string) {
+	text = whitespaceRegexp.ReplaceAllString(text, " ")
+	if w.atLineStart() {
+		text = strings.TrimLeft(text, " ")
+	}
+	w.builder.WriteString(text)
+}
+
+// writeBlock writes a block of markdown on its own lines, with a blank line before and after if separated.
+func (w *markdownWriter) writeBlock(block string, separated bool) {
+	w.writeNewline(separated)
+	w.builder.WriteString(block)
+	w.writeNewline(separated)
+}
+
+func (w *markdownWriter) writeNewline(blankLine bool) {
+	if w.builder.Len() == 0 {
+		return
+	}
+	if !w.atLineStart() {
+		w.builder.WriteString("\n")
+	}
+	if blankLine && !strings.HasSuffix(w.builder.String(), "\n\n") {
+		w.builder.WriteString("\n")
+	}
+}
+
+func (w *markdownWriter) writeChildren(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		w.writeNode(child)
+	}
+}
+
+// renderChildren returns the markdown of the children of the node.
+func renderChildren(node *html.Node) string {
+	w := &markdownWriter{}
+	w.writeChildren(node)
+	return w.String()
+}
+
+func (w *markdownWriter) writeNode(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		w.writeText(node.Data)
+		return
+	case html.ElementNode:
+	default:
+		w.writeChildren(node)
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title, atom.Template:
+	case atom.Br:
+		w.builder.WriteString("\n")
+	case atom.Hr:
+		w.writeBlock("---", true)
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(node.Data[1] - '0')
+		if text := strings.ReplaceAll(renderChildren(node), "\n", " "); text != "" {
+			w.writeBlock(strings.Repeat("#", level)+" "+text, true)
+		}
+	case atom.P, atom.Table, atom.Dl:
+		w.writeNewline(true)
+		w.writeChildren(node)
+		w.writeNewline(true)
+	case atom.Ul, atom.Ol:
+		// Nested lists aren't separated from their item by a blank line.
+		separated := node.Parent == nil || node.Parent.DataAtom != atom.Li
+		w.writeNewline(separated)
+		w.writeList(node)
+		w.writeNewline(separated)
+	case atom.Div, atom.Tr, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Center, atom.Dt, atom.Dd:
+		w.writeNewline(false)
+		w.writeChildren(node)
+		w.writeNewline(false)
+	case atom.Td, atom.Th:
+		w.writeChildren(node)
+		w.builder.WriteString(" ")
+	case atom.Blockquote:
+		if quote := renderChildren(node); quote != "" {
+			w.writeBlock(prefixLines(quote, "> ", "> "), true)
+		}
+	case atom.Pre:
+		code := strings.Trim(textContent(node), "\n")
+		w.writeBlock("```\n"+code+"\n```", true)
+	case atom.Code, atom.Kbd, atom.Samp:
+		if code := textContent(node); strings.TrimSpace(code) != "" {
+			w.writeText("`" + strings.ReplaceAll(code, "`", "") + "`")
+		}
+	case atom.Strong, atom.B:
+		w.writeEmphasis(node, "**")
+	case atom.Em, atom.I:
+		w.writeEmphasis(node, "*")
+	case atom.Del, atom.S, atom.Strike:
+		w.writeEmphasis(node, "~~")
+	case atom.A:
+		w.writeLink(node)
+	case atom.Img:
+		src := getAttribute(node, "src")
+		// Inline images are saved as attachments.
+		if src == "" || strings.HasPrefix(strings.ToLower(src), "cid:") || strings.HasPrefix(strings.ToLower(src), "data:") {
+			return
+		}
+		w.writeText(fmt.Sprintf("![%s](%s)", getAttribute(node, "alt"), src))
+	default:
+		w.writeChildren(node)
+	}
+}
+
+func (w *markdownWriter) writeEmphasis(node *html.Node, marker string) {
+	text := strings.ReplaceAll(renderChildren(node), "\n", " ")
+	if text == "" {
+		return
+	}
+	// Keep the spaces around the text outside the markers.
+	if !w.atLineStart() && node.FirstChild != nil && node.FirstChild.Type == html.TextNode && strings.TrimLeft(node.FirstChild.Data, " \t\r\n") != node.FirstChild.Data {
+		w.writeText(" ")
+	}
+	w.writeText(marker + text + marker)
+	if node.LastChild != nil && node.LastChild.Type == html.TextNode && strings.TrimRight(node.LastChild.Data, " \t\r\n") != node.LastChild.Data {
+		w.writeText(" ")
+	}
+}
+
+func (w *markdownWriter) writeLink(node *html.Node) {
+	text := strings.ReplaceAll(renderChildren(node), "\n", " ")
+	href := strings.TrimSpace(getAttribute(node, "href"))
+	lowerHref := strings.ToLower(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(lowerHref, "javascript:") {
+		w.writeText(text)
+		return
+	}
+	if text == "" || text == href || "mailto:"+text == href {
+		w.writeText(href)
+		return
+	}
+	w.writeText(fmt.Sprintf("[%s](%s)", text, href))
+}
+
+func (w *markdownWriter) writeList(list *html.Node) {
+	number := 1
+	for item := list.FirstChild; item != nil; item = item.NextSibling {
+		if item.Type != html.ElementNode || item.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if list.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+		w.writeNewline(false)
+		w.builder.WriteString(prefixLines(renderChildren(item), marker, strings.Repeat(" ", len(marker))))
+	}
+}
+
+// prefixLines prefixes the first line with the prefix and the next ones with the indent.
+func prefixLines(text, prefix, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = prefix + line
+		} else if line != "" || strings.TrimSpace(indent) != "" {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var builder strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && child.DataAtom == atom.Br {
+			builder.WriteString("\n")
+			continue
+		}
+		builder.WriteString(textContent(child))
+	}
+	return builder.String()
+}
+
+func getAttribute(node *html.Node, key string) string {
+	for _, attribute := range node.Attr {
+		if attribute.Key == key {
+			return attribute.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,185 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// maxMultipartDepth is how deep nested multipart bodies are parsed.
+const maxMultipartDepth = 10
+
+// ReceivedMessage is a parsed email.
+type ReceivedMessage struct {
+	// From is the address of the From header.
+	From    string
+	Subject string
+	// Text is the plain text body.
+	Text string
+	// HTML is the HTML body.
+	HTML        string
+	Attachments []*Attachment
+}
+
+// Attachment is a file attached to an email, or an inline image of the HTML body.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Markdown returns the body of the message as markdown, converted from HTML if there's no plain text body.
+func (m *ReceivedMessage) Markdown() (string, error) {
+	if strings.TrimSpace(m.Text) != "" || m.HTML == "" {
+		return strings.TrimSpace(strings.ReplaceAll(m.Text, "\r\n", "\n")), nil
+	}
+	return HTMLToMarkdown(m.HTML)
+}
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+// ParseMessage parses the raw email.
+func ParseMessage(data []byte) (*ReceivedMessage, error) {
+	message, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read message")
+	}
+	received := &ReceivedMessage{}
+	addressParser := &mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := addressParser.Parse(message.Header.Get("From")); err == nil {
+		received.From = from.Address
+	}
+	subject, err := wordDecoder.DecodeHeader(message.Header.Get("Subject"))
+	if err != nil {
+		subject = message.Header.Get("Subject")
+	}
+	received.Subject = strings.TrimSpace(subject)
+
+	if err := received.parsePart(message.Header, message.Body, 0); err != nil {
+		return nil, err
+	}
+	return received, nil
+}
+
+// header is the header of the message or of one of its parts.
+type header interface {
+	Get(key string) string
+}
+
+func (m *ReceivedMessage) parsePart(header header, body io.Reader, depth int) error {
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "application/octet-stream", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth {
+			return errors.New("too many nested parts")
+		}
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to read part")
+			}
+			if err := m.parsePart(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode part")
+	}
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dispositionParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	if decoded, err := wordDecoder.DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	filename = path.Base(path.Clean("/" + strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "/" || filename == "." {
+		filename = ""
+	}
+
+	// The first plain text and HTML parts that aren't attachments are the body.
+	isBody := disposition != "attachment" && filename == ""
+	switch {
+	case isBody && mediaType == "text/plain" && m.Text == "":
+		m.Text, err = decodeCharset(params["charset"], data)
+		return err
+	case isBody && mediaType == "text/html" && m.HTML == "":
+		m.HTML, err = decodeCharset(params["charset"], data)
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if filename == "" {
+		filename = "attachment"
+		if extensions, _ := mime.ExtensionsByType(mediaType); len(extensions) > 0 {
+			filename += extensions[0]
+		}
+	}
+	m.Attachments = append(m.Attachments, &Attachment{
+		Filename:    filename,
+		ContentType: mediaType,
+		Data:        data,
+	})
+	return nil
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeCharset(charset string, data []byte) (string, error) {
+	reader, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		// Unknown charsets are read as UTF-8.
+		return string(data), nil
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode charset")
+	}
+	return string(decoded), nil
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	if charset == "" || charset == "utf-8" || charset == "us-ascii" {
+		return input, nil
+	}
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unsupported charset %q", charset)
+	}
+	return encoding.NewDecoder().Reader(input), nil
+}
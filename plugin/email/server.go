@@ -0,0 +1,523 @@
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxLineLength is the max length of command lines, longer than the 512 octets of RFC 5321 for extensions.
+	maxLineLength = 2048
+	// maxRecipients is the max number of recipients of a message.
+	maxRecipients = 100
+	// commandTimeout is how long the server waits for a command, for the message data or for the TLS handshake.
+	commandTimeout = 5 * time.Minute
+	// maxConnections is the max number of connections served at the same time, more are refused.
+	maxConnections = 100
+	// maxAuthFailures is the number of failed AUTH attempts after which the connection is closed.
+	maxAuthFailures = 3
+)
+
+// authFailureDelay is how long the server waits before replying to a failed AUTH attempt, to slow down guessing.
+var authFailureDelay = time.Second
+
+var errLineTooLong = errors.New("line too long")
+
+// Error is an SMTP reply returned by backends to refuse a command.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s", e.Code, e.Message)
+}
+
+// Envelope is a message received by the server.
+type Envelope struct {
+	// Username is the username the client authenticated with, empty if it didn't.
+	Username string
+	// From is the address of the sender given with MAIL FROM.
+	From string
+	// To are the addresses of the recipients given with RCPT TO.
+	To []string
+	// Data is the raw message.
+	Data []byte
+}
+
+// Backend decides which messages the server accepts, and handles them.
+type Backend interface {
+	// Authenticate returns an error if the credentials given with AUTH are invalid.
+	Authenticate(ctx context.Context, username, password string) error
+	// MaxMessageSize returns the max size of messages in bytes.
+	MaxMessageSize(ctx context.Context) int64
+	// CheckRecipient returns an error if the recipient of the envelope is refused.
+	CheckRecipient(ctx context.Context, envelope *Envelope, recipient string) error
+	// Deliver handles a received message.
+	Deliver(ctx context.Context, envelope *Envelope) error
+}
+
+// Server is a minimal SMTP server receiving messages for a Backend.
+// It supports STARTTLS when it has a TLS config, and AUTH PLAIN and LOGIN only once the connection is encrypted,
+// either with STARTTLS or by serving a TLS listener.
+type Server struct {
+	// Domain is the domain the server greets clients with.
+	Domain  string
+	Backend Backend
+	// TLSConfig enables STARTTLS, and so AUTH, if set.
+	TLSConfig *tls.Config
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func NewServer(domain string, backend Backend) *Server {
+	return &Server{
+		Domain:  domain,
+		Backend: backend,
+		conns:   map[net.Conn]struct{}{},
+	}
+}
+
+// Serve accepts connections on the listener until the server is closed.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		if len(s.conns) >= maxConnections {
+			s.mu.Unlock()
+			slog.Warn("refused SMTP connection over the limit", "remoteAddr", conn.RemoteAddr().String(), "maxConnections", maxConnections)
+			_ = conn.SetWriteDeadline(time.Now().Add(time.Second))
+			_, _ = fmt.Fprintf(conn, "421 %s Too many connections, try again later\r\n", s.Domain)
+			conn.Close()
+			continue
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+				conn.Close()
+			}()
+			newSession(s, conn).serve(ctx)
+		}()
+	}
+}
+
+// Close stops accepting connections and closes the open ones.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	return err
+}
+
+// session is the conversation with a client.
+type session struct {
+	server   *Server
+	conn     net.Conn
+	reader   *bufio.Reader
+	greeted  bool
+	envelope *Envelope
+	username string
+	// encrypted is whether the connection is encrypted with TLS.
+	encrypted    bool
+	authFailures int
+}
+
+func newSession(server *Server, conn net.Conn) *session {
+	_, encrypted := conn.(*tls.Conn)
+	return &session{
+		server:    server,
+		conn:      conn,
+		reader:    bufio.NewReaderSize(conn, maxLineLength),
+		encrypted: encrypted,
+	}
+}
+
+func (s *session) reply(code int, lines ...string) {
+	var buffer bytes.Buffer
+	for i, line := range lines {
+		separator := "-"
+		if i == len(lines)-1 {
+			separator = " "
+		}
+		fmt.Fprintf(&buffer, "%d%s%s\r\n", code, separator, line)
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(commandTimeout)); err != nil {
+		s.conn.Close()
+		return
+	}
+	if _, err := s.conn.Write(buffer.Bytes()); err != nil {
+		s.conn.Close()
+	}
+}
+
+// replyError replies with the error of the backend, or with the default reply for other errors.
+func (s *session) replyError(err error, code int, message string) {
+	var smtpError *Error
+	if errors.As(err, &smtpError) {
+		s.reply(smtpError.Code, smtpError.Message)
+		return
+	}
+	s.reply(code, message)
+}
+
+func (s *session) readLine() (string, error) {
+	if err := s.conn.SetReadDeadline(time.Now().Add(commandTimeout)); err != nil {
+		return "", err
+	}
+	line, err := s.reader.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		// Skip the rest of the line.
+		for err == bufio.ErrBufferFull {
+			_, err = s.reader.ReadSlice('\n')
+		}
+		if err != nil {
+			return "", err
+		}
+		return "", errLineTooLong
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+func (s *session) serve(ctx context.Context) {
+	// Connections of TLS listeners are handshaken before the greeting, so that the handshake has a deadline.
+	if conn, ok := s.conn.(*tls.Conn); ok {
+		if err := handshake(conn); err != nil {
+			return
+		}
+	}
+	s.reply(220, s.server.Domain+" ESMTP Memos")
+	for {
+		line, err := s.readLine()
+		if err == errLineTooLong {
+			s.reply(500, "Line too long")
+			continue
+		}
+		if err != nil {
+			return
+		}
+		command, argument, _ := strings.Cut(line, " ")
+		argument = strings.TrimSpace(argument)
+		switch strings.ToUpper(command) {
+		case "EHLO":
+			s.greeted = true
+			s.envelope = nil
+			extensions := []string{s.server.Domain, "PIPELINING", "8BITMIME", fmt.Sprintf("SIZE %d", s.server.Backend.MaxMessageSize(ctx))}
+			if s.server.TLSConfig != nil && !s.encrypted {
+				extensions = append(extensions, "STARTTLS")
+			}
+			// Credentials are only accepted over encrypted connections.
+			if s.encrypted {
+				extensions = append(extensions, "AUTH PLAIN LOGIN")
+			}
+			s.reply(250, extensions...)
+		case "HELO":
+			s.greeted = true
+			s.envelope = nil
+			s.reply(250, s.server.Domain)
+		case "STARTTLS":
+			if !s.handleStartTLS() {
+				return
+			}
+		case "AUTH":
+			if !s.handleAuth(ctx, argument) {
+				return
+			}
+		case "MAIL":
+			s.handleMail(ctx, argument)
+		case "RCPT":
+			s.handleRcpt(ctx, argument)
+		case "DATA":
+			if !s.handleData(ctx) {
+				return
+			}
+		case "RSET":
+			s.envelope = nil
+			s.reply(250, "OK")
+		case "NOOP":
+			s.reply(250, "OK")
+		case "VRFY":
+			s.reply(252, "Cannot verify user")
+		case "QUIT":
+			s.reply(221, "Bye")
+			return
+		default:
+			s.reply(502, "Command not implemented")
+		}
+	}
+}
+
+// handleStartTLS upgrades the connection to TLS, and returns false if the connection should be closed.
+func (s *session) handleStartTLS() bool {
+	if s.server.TLSConfig == nil {
+		s.reply(502, "Command not implemented")
+		return true
+	}
+	if s.encrypted {
+		s.reply(503, "Already running TLS")
+		return true
+	}
+	s.reply(220, "Ready to start TLS")
+	conn := tls.Server(s.conn, s.server.TLSConfig)
+	if err := handshake(conn); err != nil {
+		return false
+	}
+	// Commands sent before the handshake are discarded, and the client starts over as required by RFC 3207.
+	s.conn = conn
+	s.reader = bufio.NewReaderSize(conn, maxLineLength)
+	s.encrypted = true
+	s.greeted = false
+	s.envelope = nil
+	s.username = ""
+	return true
+}
+
+// handshake runs the TLS handshake of the connection within the command timeout.
+func handshake(conn *tls.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(commandTimeout)); err != nil {
+		return err
+	}
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// handleAuth authenticates the client, and returns false if the connection should be closed.
+func (s *session) handleAuth(ctx context.Context, argument string) bool {
+	if !s.greeted {
+		s.reply(503, "Send EHLO first")
+		return true
+	}
+	if !s.encrypted {
+		s.reply(538, "Encryption required for requested authentication mechanism")
+		return true
+	}
+	if s.username != "" {
+		s.reply(503, "Already authenticated")
+		return true
+	}
+	if s.envelope != nil {
+		s.reply(503, "Not allowed during a mail transaction")
+		return true
+	}
+	mechanism, initialResponse, _ := strings.Cut(argument, " ")
+	var username, password string
+	switch strings.ToUpper(mechanism) {
+	case "PLAIN":
+		response, err := s.readAuthResponse(initialResponse, "")
+		if err != nil {
+			return true
+		}
+		// The response is authorization identity, username and password separated by NUL.
+		parts := strings.Split(response, "\x00")
+		if len(parts) != 3 {
+			s.reply(501, "Invalid credentials")
+			return true
+		}
+		username, password = parts[1], parts[2]
+	case "LOGIN":
+		var err error
+		if username, err = s.readAuthResponse(initialResponse, "VXNlcm5hbWU6"); err != nil {
+			return true
+		}
+		if password, err = s.readAuthResponse("", "UGFzc3dvcmQ6"); err != nil {
+			return true
+		}
+	default:
+		s.reply(504, "Unrecognized authentication type")
+		return true
+	}
+	if err := s.server.Backend.Authenticate(ctx, username, password); err != nil {
+		s.authFailures++
+		time.Sleep(authFailureDelay)
+		if s.authFailures >= maxAuthFailures {
+			s.reply(421, s.server.Domain+" Too many authentication failures")
+			return false
+		}
+		s.replyError(err, 535, "Authentication credentials invalid")
+		return true
+	}
+	s.username = username
+	s.reply(235, "Authentication succeeded")
+	return true
+}
+
+// readAuthResponse returns the decoded initial response if any, or asks the client for it with the challenge.
+// It replies to the client and returns an error if the response is invalid.
+func (s *session) readAuthResponse(initialResponse, challenge string) (string, error) {
+	response := initialResponse
+	if response == "" {
+		s.reply(334, challenge)
+		line, err := s.readLine()
+		if err != nil {
+			s.reply(501, "Invalid response")
+			return "", err
+		}
+		if line == "*" {
+			s.reply(501, "Authentication cancelled")
+			return "", errors.New("authentication cancelled")
+		}
+		response = line
+	}
+	decoded, err := base64.StdEncoding.DecodeString(response)
+	if err != nil {
+		s.reply(501, "Invalid response")
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func (s *session) handleMail(ctx context.Context, argument string) {
+	if !s.greeted {
+		s.reply(503, "Send EHLO first")
+		return
+	}
+	if s.envelope != nil {
+		s.reply(503, "Nested MAIL command")
+		return
+	}
+	from, params, ok := parsePathArgument(argument, "FROM:")
+	if !ok {
+		s.reply(501, "Syntax: MAIL FROM:<address>")
+		return
+	}
+	for _, param := range params {
+		key, value, _ := strings.Cut(param, "=")
+		if strings.EqualFold(key, "SIZE") {
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				s.reply(501, "Invalid SIZE parameter")
+				return
+			}
+			if size > s.server.Backend.MaxMessageSize(ctx) {
+				s.reply(552, "Message size exceeds the limit")
+				return
+			}
+		}
+	}
+	s.envelope = &Envelope{
+		Username: s.username,
+		From:     from,
+	}
+	s.reply(250, "OK")
+}
+
+func (s *session) handleRcpt(ctx context.Context, argument string) {
+	if s.envelope == nil {
+		s.reply(503, "Send MAIL first")
+		return
+	}
+	to, _, ok := parsePathArgument(argument, "TO:")
+	if !ok || to == "" {
+		s.reply(501, "Syntax: RCPT TO:<address>")
+		return
+	}
+	if len(s.envelope.To) >= maxRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+	if err := s.server.Backend.CheckRecipient(ctx, s.envelope, to); err != nil {
+		s.replyError(err, 550, "Mailbox unavailable")
+		return
+	}
+	s.envelope.To = append(s.envelope.To, to)
+	s.reply(250, "OK")
+}
+
+// handleData receives the message, and returns false if the connection should be closed.
+func (s *session) handleData(ctx context.Context) bool {
+	if s.envelope == nil || len(s.envelope.To) == 0 {
+		s.reply(503, "Send RCPT first")
+		return true
+	}
+	envelope := s.envelope
+	s.envelope = nil
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	if err := s.conn.SetReadDeadline(time.Now().Add(commandTimeout)); err != nil {
+		return false
+	}
+	maxMessageSize := s.server.Backend.MaxMessageSize(ctx)
+	reader := textproto.NewReader(s.reader).DotReader()
+	data, err := io.ReadAll(io.LimitReader(reader, maxMessageSize+1))
+	if err != nil {
+		return false
+	}
+	if int64(len(data)) > maxMessageSize {
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return false
+		}
+		s.reply(552, "Message size exceeds the limit")
+		return true
+	}
+	envelope.Data = data
+
+	if err := s.server.Backend.Deliver(ctx, envelope); err != nil {
+		slog.Warn("failed to deliver email", "err", err, "from", envelope.From)
+		s.replyError(err, 451, "Local error in processing")
+		return true
+	}
+	s.reply(250, "OK")
+	return true
+}
+
+// parsePathArgument parses arguments like FROM:<alice@example.com> SIZE=1024.
+func parsePathArgument(argument, prefix string) (string, []string, bool) {
+	if len(argument) < len(prefix) || !strings.EqualFold(argument[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+	fields := strings.Fields(strings.TrimSpace(argument[len(prefix):]))
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "<") || !strings.HasSuffix(fields[0], ">") {
+		return "", nil, false
+	}
+	return strings.Trim(fields[0], "<>"), fields[1:], true
+}
@@ -0,0 +1,105 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+)
+
+type testBackend struct{}
+
+func (testBackend) Authenticate(_ context.Context, username, password string) error {
+	if username != "alice" || password != "secret" {
+		return &Error{Code: 535, Message: "Authentication credentials invalid"}
+	}
+	return nil
+}
+
+func (testBackend) MaxMessageSize(_ context.Context) int64 {
+	return 1 << 20
+}
+
+func (testBackend) CheckRecipient(_ context.Context, _ *Envelope, _ string) error {
+	return nil
+}
+
+func (testBackend) Deliver(_ context.Context, _ *Envelope) error {
+	return nil
+}
+
+func TestServerAuth(t *testing.T) {
+	authFailureDelay = 0
+	certPEM, keyPEM, err := emailtest.NewCertificate()
+	require.NoError(t, err)
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certPEM))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer("localhost", testBackend{})
+	server.TLSConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	go server.Serve(context.Background(), listener)
+	defer server.Close()
+	dial := func(startTLS bool) *smtp.Client {
+		client, err := smtp.Dial(listener.Addr().String())
+		require.NoError(t, err)
+		if startTLS {
+			require.NoError(t, client.StartTLS(&tls.Config{RootCAs: roots, ServerName: "127.0.0.1"}))
+		}
+		return client
+	}
+
+	// AUTH is only offered once the connection is encrypted.
+	client := dial(false)
+	ok, _ := client.Extension("STARTTLS")
+	require.True(t, ok)
+	ok, _ = client.Extension("AUTH")
+	require.False(t, ok)
+	require.ErrorContains(t, client.Auth(smtp.PlainAuth("", "alice", "secret", "127.0.0.1")), "538")
+	client.Close()
+
+	client = dial(true)
+	ok, _ = client.Extension("AUTH")
+	require.True(t, ok)
+	require.NoError(t, client.Auth(smtp.PlainAuth("", "alice", "secret", "127.0.0.1")))
+	require.NoError(t, client.Quit())
+
+	// The connection is closed after too many failed attempts, here over implicit TLS.
+	tlsListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	tlsServer := NewServer("localhost", testBackend{})
+	go tlsServer.Serve(context.Background(), tls.NewListener(tlsListener, server.TLSConfig))
+	defer tlsServer.Close()
+	conn, err := tls.Dial("tcp", tlsListener.Addr().String(), &tls.Config{RootCAs: roots, ServerName: "127.0.0.1"})
+	require.NoError(t, err)
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	_, _, err = text.ReadResponse(220)
+	require.NoError(t, err)
+	require.NoError(t, text.PrintfLine("EHLO localhost"))
+	_, message, err := text.ReadResponse(250)
+	require.NoError(t, err)
+	require.Contains(t, message, "AUTH PLAIN LOGIN")
+	wrongCredentials := base64.StdEncoding.EncodeToString([]byte("\x00alice\x00wrong"))
+	for i := 0; i < maxAuthFailures-1; i++ {
+		require.NoError(t, text.PrintfLine("AUTH PLAIN %s", wrongCredentials))
+		_, _, err = text.ReadResponse(235)
+		require.ErrorContains(t, err, "535")
+	}
+	require.NoError(t, text.PrintfLine("AUTH PLAIN %s", wrongCredentials))
+	_, _, err = text.ReadResponse(235)
+	require.ErrorContains(t, err, "421")
+	_, err = text.ReadLine()
+	require.Error(t, err)
+}
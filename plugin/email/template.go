@@ -0,0 +1,75 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html"))
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt"))
+)
+
+// Template names, each has an HTML and a plain text version in the templates directory.
+const (
+	TemplateNotification  = "notification"
+	TemplateDigest        = "digest"
+	TemplatePasswordReset = "password_reset"
+	TemplateInvitation    = "invitation"
+)
+
+// NotificationData is the data of the notification template.
+type NotificationData struct {
+	Title   string
+	Content string
+	Link    string
+}
+
+// DigestData is the data of the digest template.
+type DigestData struct {
+	// Period is the period the digest covers, e.g. "day" or "week".
+	Period string
+	Items  []*NotificationData
+	// InboxLink is the link to the inbox in the web app.
+	InboxLink string
+}
+
+// PasswordResetData is the data of the password reset template.
+type PasswordResetData struct {
+	Username string
+	Link     string
+	// ExpiresIn is how long the link is valid, e.g. "1 hour".
+	ExpiresIn string
+}
+
+// InvitationData is the data of the invitation template.
+type InvitationData struct {
+	Inviter string
+	Link    string
+	// ExpiresIn is how long the link is valid, e.g. "7 days".
+	ExpiresIn string
+}
+
+// NewMessage renders the template with the data into the bodies of a message.
+func NewMessage(to []string, subject, templateName string, data any) (*Message, error) {
+	var text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, templateName+".txt", data); err != nil {
+		return nil, errors.Wrapf(err, "failed to render %s text template", templateName)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, templateName+".html", data); err != nil {
+		return nil, errors.Wrapf(err, "failed to render %s html template", templateName)
+	}
+	return &Message{
+		To:      to,
+		Subject: subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -45,9 +45,21 @@ func (c *CommonSQLConverter) ConvertExprToSQL(ctx *ConvertContext, expr *exprv1.
 			return c.handleInOperator(ctx, v.CallExpr)
 		case "contains":
 			return c.handleContainsOperator(ctx, v.CallExpr)
+		case "search":
+			return c.handleSearchFunction(ctx, v.CallExpr)
+		case "in_tree":
+			return c.handleInTreeFunction(ctx, v.CallExpr)
+		case "granted_to":
+			return c.handleUserIDFunction(ctx, v.CallExpr, c.dialect.GetMemoGrantCondition())
+		case "in_groups_of":
+			return c.handleUserIDFunction(ctx, v.CallExpr, c.dialect.GetMemoGroupCondition())
+		case "has_relation":
+			return c.handleRelationFunction(ctx, v.CallExpr)
 		}
 	} else if v, ok := expr.ExprKind.(*exprv1.Expr_IdentExpr); ok {
 		return c.handleIdentifier(ctx, v.IdentExpr)
+	} else if v, ok := expr.ExprKind.(*exprv1.Expr_ComprehensionExpr); ok {
+		return c.handleComprehension(ctx, v.ComprehensionExpr)
 	}
 	return nil
 }
@@ -122,7 +134,7 @@ func (c *CommonSQLConverter) handleComparisonOperator(ctx *ConvertContext, callE
 		return err
 	}
 
-	if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "pinned", "has_task_list", "has_link", "has_code", "has_incomplete_tasks"}, identifier) {
+	if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "pinned", "has_task_list", "has_link", "has_code", "has_incomplete_tasks", "status", "message_type"}, identifier) {
 		return errors.Errorf("invalid identifier for %s", callExpr.Function)
 	}
 
@@ -144,6 +156,8 @@ func (c *CommonSQLConverter) handleComparisonOperator(ctx *ConvertContext, callE
 		return c.handlePinnedComparison(ctx, operator, value)
 	case "has_task_list", "has_link", "has_code", "has_incomplete_tasks":
 		return c.handleBooleanComparison(ctx, identifier, operator, value)
+	case "status", "message_type":
+		return c.handleInboxComparison(ctx, identifier, operator, value)
 	}
 
 	return nil
@@ -198,6 +212,9 @@ func (c *CommonSQLConverter) handleInOperator(ctx *ConvertContext, callExpr *exp
 		if identifier == "tags" {
 			return c.handleElementInTags(ctx, callExpr.Args[0])
 		}
+		if identifier == "mentions" {
+			return c.handleElementInMentions(ctx, callExpr.Args[0])
+		}
 		return errors.Errorf("invalid collection identifier for %s: %s", callExpr.Function, identifier)
 	}
 
@@ -207,7 +224,7 @@ func (c *CommonSQLConverter) handleInOperator(ctx *ConvertContext, callExpr *exp
 		return err
 	}
 
-	if !slices.Contains([]string{"tag", "visibility", "content_id", "memo_id"}, identifier) {
+	if !slices.Contains([]string{"tag", "visibility", "content_id", "memo_id", "status", "message_type"}, identifier) {
 		return errors.Errorf("invalid identifier for %s", callExpr.Function)
 	}
 
@@ -228,6 +245,8 @@ func (c *CommonSQLConverter) handleInOperator(ctx *ConvertContext, callExpr *exp
 		return c.handleContentIDInList(ctx, values)
 	} else if identifier == "memo_id" {
 		return c.handleMemoIDInList(ctx, values)
+	} else if identifier == "status" || identifier == "message_type" {
+		return c.handleInboxFieldInList(ctx, identifier, values)
 	}
 
 	return nil
@@ -238,6 +257,9 @@ func (c *CommonSQLConverter) handleElementInTags(ctx *ConvertContext, elementExp
 	if err != nil {
 		return errors.Errorf("first argument must be a constant value for 'element in tags': %v", err)
 	}
+	if tag, ok := element.(string); ok && len(ctx.TagAliases[tag]) > 0 {
+		return c.handleTagInList(ctx, []any{element})
+	}
 
 	// Use dialect-specific JSON contains logic
 	template := c.dialect.GetJSONContains("$.tags", "element")
@@ -259,11 +281,61 @@ func (c *CommonSQLConverter) handleElementInTags(ctx *ConvertContext, elementExp
 	return nil
 }
 
+// handleComprehension converts `mentions.exists(m, m == "alice")`, which CEL expands into a comprehension
+// that ORs the predicate over the list.
+func (c *CommonSQLConverter) handleComprehension(ctx *ConvertContext, comprehension *exprv1.Expr_Comprehension) error {
+	identifier, err := GetIdentExprName(comprehension.IterRange)
+	if err != nil || identifier != "mentions" {
+		return errors.New("only mentions.exists() is supported")
+	}
+	step := comprehension.LoopStep.GetCallExpr()
+	if step == nil || step.Function != "_||_" || len(step.Args) != 2 {
+		return errors.New("only mentions.exists() is supported")
+	}
+	predicate := step.Args[1].GetCallExpr()
+	if predicate == nil || predicate.Function != "_==_" || len(predicate.Args) != 2 {
+		return errors.New(`mentions.exists() only supports comparing the mention to a username, e.g. m == "alice"`)
+	}
+	for i, arg := range predicate.Args {
+		if name, err := GetIdentExprName(arg); err == nil && name == comprehension.IterVar {
+			return c.handleElementInMentions(ctx, predicate.Args[1-i])
+		}
+	}
+	return errors.New(`mentions.exists() only supports comparing the mention to a username, e.g. m == "alice"`)
+}
+
+func (c *CommonSQLConverter) handleElementInMentions(ctx *ConvertContext, elementExpr *exprv1.Expr) error {
+	element, err := GetConstValue(elementExpr)
+	if err != nil {
+		return errors.Errorf("mentioned username must be a constant value: %v", err)
+	}
+	username, ok := element.(string)
+	if !ok {
+		return errors.New("mentioned username must be a string")
+	}
+
+	template := c.dialect.GetJSONContains("$.mentions", "element")
+	arg := jsonString(username)
+	if _, ok := c.dialect.(*SQLiteDialect); ok {
+		// SQLite matches the JSON text of the mentions, so the wildcards of the username are escaped.
+		template += " ESCAPE '!'"
+		arg = "%" + escapeLikePattern(arg) + "%"
+	}
+	sqlExpr := strings.Replace(template, "?", c.dialect.GetParameterPlaceholder(c.paramIndex), 1)
+	if _, err := ctx.Buffer.WriteString(sqlExpr); err != nil {
+		return err
+	}
+	ctx.Args = append(ctx.Args, arg)
+	c.paramIndex++
+
+	return nil
+}
+
 func (c *CommonSQLConverter) handleTagInList(ctx *ConvertContext, values []any) error {
 	subconditions := []string{}
 	args := []any{}
 
-	for _, v := range values {
+	for _, v := range ctx.expandTagAliases(values) {
 		if _, ok := c.dialect.(*SQLiteDialect); ok {
 			subconditions = append(subconditions, c.dialect.GetJSONLike("$.tags", "pattern"))
 			args = append(args, fmt.Sprintf(`%%"%s"%%`, v))
@@ -357,6 +429,21 @@ func (c *CommonSQLConverter) handleMemoIDInList(ctx *ConvertContext, values []an
 	return nil
 }
 
+func (c *CommonSQLConverter) handleInboxFieldInList(ctx *ConvertContext, field string, values []any) error {
+	placeholders := []string{}
+	for range values {
+		placeholders = append(placeholders, c.dialect.GetParameterPlaceholder(c.paramIndex))
+		c.paramIndex++
+	}
+
+	if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s IN (%s)", c.getInboxField(field), strings.Join(placeholders, ","))); err != nil {
+		return err
+	}
+
+	ctx.Args = append(ctx.Args, values...)
+	return nil
+}
+
 func (c *CommonSQLConverter) handleContainsOperator(ctx *ConvertContext, callExpr *exprv1.Expr_Call) error {
 	if len(callExpr.Args) != 1 {
 		return errors.Errorf("invalid number of arguments for %s", callExpr.Function)
@@ -395,6 +482,119 @@ func (c *CommonSQLConverter) handleContainsOperator(ctx *ConvertContext, callExp
 	return nil
 }
 
+func (c *CommonSQLConverter) handleSearchFunction(ctx *ConvertContext, callExpr *exprv1.Expr_Call) error {
+	if len(callExpr.Args) != 1 {
+		return errors.Errorf("invalid number of arguments for %s", callExpr.Function)
+	}
+
+	arg, err := GetConstValue(callExpr.Args[0])
+	if err != nil {
+		return err
+	}
+	query, ok := arg.(string)
+	if !ok || strings.TrimSpace(query) == "" {
+		return errors.New("search query must be a non-empty string")
+	}
+
+	sqlExpr := strings.Replace(c.dialect.GetFullTextSearch(), "?", c.dialect.GetParameterPlaceholder(c.paramIndex), 1)
+	if _, err := ctx.Buffer.WriteString(sqlExpr); err != nil {
+		return err
+	}
+
+	ctx.Args = append(ctx.Args, c.dialect.GetFullTextQuery(query))
+	ctx.SearchQueries = append(ctx.SearchQueries, query)
+	c.paramIndex++
+
+	return nil
+}
+
+func (c *CommonSQLConverter) handleInTreeFunction(ctx *ConvertContext, callExpr *exprv1.Expr_Call) error {
+	if len(callExpr.Args) != 2 {
+		return errors.Errorf("invalid number of arguments for %s", callExpr.Function)
+	}
+
+	identifier, err := GetIdentExprName(callExpr.Args[0])
+	if err != nil {
+		return err
+	}
+	if identifier != "tag" {
+		return errors.Errorf("in_tree only supports 'tag' identifier, got: %s", identifier)
+	}
+	arg, err := GetConstValue(callExpr.Args[1])
+	if err != nil {
+		return err
+	}
+	tag, ok := arg.(string)
+	tag = strings.Trim(tag, "/")
+	if !ok || tag == "" {
+		return errors.New("in_tree tag must be a non-empty string")
+	}
+
+	parts := strings.Split(c.dialect.GetTagTreeCondition(), "?")
+	sqlExpr := parts[0]
+	for _, part := range parts[1:] {
+		sqlExpr += c.dialect.GetParameterPlaceholder(c.paramIndex) + part
+		c.paramIndex++
+	}
+	if _, err := ctx.Buffer.WriteString(sqlExpr); err != nil {
+		return err
+	}
+	ctx.Args = append(ctx.Args, c.dialect.GetTagTreeArgs(tag)...)
+
+	return nil
+}
+
+// handleUserIDFunction converts a function taking a user ID to the given SQL condition with a single placeholder.
+func (c *CommonSQLConverter) handleUserIDFunction(ctx *ConvertContext, callExpr *exprv1.Expr_Call, condition string) error {
+	if len(callExpr.Args) != 1 {
+		return errors.Errorf("invalid number of arguments for %s", callExpr.Function)
+	}
+
+	arg, err := GetConstValue(callExpr.Args[0])
+	if err != nil {
+		return err
+	}
+	userID, ok := arg.(int64)
+	if !ok {
+		return errors.Errorf("%s user ID must be an integer", callExpr.Function)
+	}
+
+	sqlExpr := strings.Replace(condition, "?", c.dialect.GetParameterPlaceholder(c.paramIndex), 1)
+	if _, err := ctx.Buffer.WriteString(sqlExpr); err != nil {
+		return err
+	}
+	ctx.Args = append(ctx.Args, userID)
+	ctx.UserIDs = append(ctx.UserIDs, userID)
+	c.paramIndex++
+
+	return nil
+}
+
+// handleRelationFunction converts has_relation() to a condition on the relations of the memo.
+func (c *CommonSQLConverter) handleRelationFunction(ctx *ConvertContext, callExpr *exprv1.Expr_Call) error {
+	if len(callExpr.Args) != 1 {
+		return errors.New("invalid number of arguments for has_relation")
+	}
+
+	arg, err := GetConstValue(callExpr.Args[0])
+	if err != nil {
+		return err
+	}
+	relationType, ok := arg.(string)
+	if !ok || relationType == "" {
+		return errors.New("relation type must be a non-empty string")
+	}
+
+	sqlExpr := strings.Replace(c.dialect.GetMemoRelationCondition(), "?", c.dialect.GetParameterPlaceholder(c.paramIndex), 1)
+	if _, err := ctx.Buffer.WriteString(sqlExpr); err != nil {
+		return err
+	}
+	ctx.Args = append(ctx.Args, relationType)
+	c.paramIndex++
+
+	return nil
+}
+
 func (c *CommonSQLConverter) handleIdentifier(ctx *ConvertContext, identExpr *exprv1.Expr_Ident) error {
 	identifier := identExpr.GetName()
 
@@ -487,6 +687,38 @@ func (c *CommonSQLConverter) handleStringComparison(ctx *ConvertContext, field,
 	return nil
 }
 
+func (c *CommonSQLConverter) handleInboxComparison(ctx *ConvertContext, field, operator string, value interface{}) error {
+	if operator != "=" && operator != "!=" {
+		return errors.Errorf("invalid operator for %s", field)
+	}
+
+	valueStr, ok := value.(string)
+	if !ok {
+		return errors.New("invalid string value")
+	}
+
+	if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s %s", c.getInboxField(field), operator, c.dialect.GetParameterPlaceholder(c.paramIndex))); err != nil {
+		return err
+	}
+
+	ctx.Args = append(ctx.Args, valueStr)
+	c.paramIndex++
+
+	return nil
+}
+
+// getInboxField returns the SQL expression of the inbox field, the message type is stored in the message JSON.
+func (c *CommonSQLConverter) getInboxField(field string) string {
+	if field == "message_type" {
+		return c.dialect.GetInboxMessageType()
+	}
+	tablePrefix := c.dialect.GetTablePrefix("inbox")
+	if _, ok := c.dialect.(*PostgreSQLDialect); ok {
+		return fmt.Sprintf("%s.%s", tablePrefix, field)
+	}
+	return fmt.Sprintf("%s.`%s`", tablePrefix, field)
+}
+
 func (c *CommonSQLConverter) handleIntComparison(ctx *ConvertContext, field, operator string, value interface{}) error {
 	if operator != "=" && operator != "!=" {
 		return errors.Errorf("invalid operator for %s", field)
@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"slices"
 	"strings"
 )
 
@@ -10,6 +11,32 @@ type ConvertContext struct {
 	// The offset of the next argument in the condition string.
 	// Mainly using for PostgreSQL.
 	ArgsOffset int
+	// SearchQueries are the queries of the search() calls in the filter, used for relevance ordering.
+	SearchQueries []string
+	// UserIDs are the ids of the users passed to granted_to() and in_groups_of() in the filter.
+	UserIDs []int64
+	// TagAliases maps a tag to the other tags that mean the same, so that filters on any of them match all of them.
+	TagAliases map[string][]string
+}
+
+// expandTagAliases appends the aliases of the tags that are not in the list yet.
+func (ctx *ConvertContext) expandTagAliases(values []any) []any {
+	if len(ctx.TagAliases) == 0 {
+		return values
+	}
+	expanded := append([]any{}, values...)
+	for _, value := range values {
+		tag, ok := value.(string)
+		if !ok {
+			continue
+		}
+		for _, alias := range ctx.TagAliases[tag] {
+			if !slices.Contains(expanded, any(alias)) {
+				expanded = append(expanded, alias)
+			}
+		}
+	}
+	return expanded
 }
 
 func NewConvertContext() *ConvertContext {
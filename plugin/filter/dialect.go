@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -25,6 +26,23 @@ type SQLDialect interface {
 	// Timestamp operations
 	GetTimestampComparison(field string) string
 	GetCurrentTimestamp() string
+
+	// Full-text search operations
+	GetFullTextSearch() string
+	GetFullTextRank() string
+	GetFullTextQuery(query string) string
+
+	// Tag hierarchy operations
+	GetTagTreeCondition() string
+	GetTagTreeArgs(tag string) []any
+
+	// Memo access operations
+	GetMemoGrantCondition() string
+	GetMemoGroupCondition() string
+	GetMemoRelationCondition() string
+
+	// Inbox operations
+	GetInboxMessageType() string
 }
 
 // DatabaseType represents the type of database.
@@ -103,6 +121,54 @@ func (*SQLiteDialect) GetCurrentTimestamp() string {
 	return "strftime('%s', 'now')"
 }
 
+func (d *SQLiteDialect) GetFullTextSearch() string {
+	return fmt.Sprintf("%s.`id` IN (SELECT `rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ?)", d.GetTablePrefix("memo"))
+}
+
+// GetFullTextQuery quotes every term of the query so that FTS5 operators in user input are matched literally.
+func (*SQLiteDialect) GetFullTextQuery(query string) string {
+	terms := []string{}
+	for _, term := range strings.Fields(query) {
+		terms = append(terms, fmt.Sprintf(`"%s"`, strings.ReplaceAll(term, `"`, `""`)))
+	}
+	return strings.Join(terms, " ")
+}
+
+func (d *SQLiteDialect) GetFullTextRank() string {
+	// bm25() returns lower values for better matches, so it is negated to sort in descending order.
+	return fmt.Sprintf("(SELECT -bm25(`memo_fts`) FROM `memo_fts` WHERE `memo_fts` MATCH ? AND `memo_fts`.`rowid` = %s.`id`)", d.GetTablePrefix("memo"))
+}
+
+// GetTagTreeCondition matches memos with the tag or any of its child tags.
+// The tags are compared with substr rather than LIKE, which is case-insensitive in SQLite.
+func (d *SQLiteDialect) GetTagTreeCondition() string {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s.`payload`, '$.tags') WHERE `value` = ? OR substr(`value`, 1, length(?)) = ?)", d.GetTablePrefix("memo"))
+}
+
+func (*SQLiteDialect) GetTagTreeArgs(tag string) []any {
+	return []any{tag, tag + "/", tag + "/"}
+}
+
+// GetMemoGrantCondition matches memos that have been granted to a user.
+func (d *SQLiteDialect) GetMemoGrantCondition() string {
+	return fmt.Sprintf("%s.`id` IN (SELECT `memo_id` FROM `memo_grant` WHERE `user_id` = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetMemoGroupCondition matches GROUP visibility memos targeting a group of a user.
+func (d *SQLiteDialect) GetMemoGroupCondition() string {
+	return fmt.Sprintf("(%s.`visibility` = 'GROUP' AND %s.`id` IN (SELECT `memo_group`.`memo_id` FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `user_group_member`.`user_id` = ?))", d.GetTablePrefix("memo"), d.GetTablePrefix("memo"))
+}
+
+// GetMemoRelationCondition matches memos with a relation of a type to another memo.
+func (d *SQLiteDialect) GetMemoRelationCondition() string {
+	return fmt.Sprintf("%s.`id` IN (SELECT `memo_id` FROM `memo_relation` WHERE `type` = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetInboxMessageType returns the type of the inbox message, e.g. MEMO_COMMENT.
+func (d *SQLiteDialect) GetInboxMessageType() string {
+	return fmt.Sprintf("JSON_EXTRACT(%s.`message`, '$.type')", d.GetTablePrefix("inbox"))
+}
+
 // MySQLDialect implements SQLDialect for MySQL.
 type MySQLDialect struct{}
 
@@ -153,6 +219,47 @@ func (*MySQLDialect) GetCurrentTimestamp() string {
 	return "UNIX_TIMESTAMP()"
 }
 
+func (d *MySQLDialect) GetFullTextSearch() string {
+	return fmt.Sprintf("MATCH(%s.`content`) AGAINST (? IN NATURAL LANGUAGE MODE)", d.GetTablePrefix("memo"))
+}
+
+func (*MySQLDialect) GetFullTextQuery(query string) string {
+	return query
+}
+
+func (d *MySQLDialect) GetFullTextRank() string {
+	return d.GetFullTextSearch()
+}
+
+// GetTagTreeCondition matches memos with the tag or any of its child tags.
+func (d *MySQLDialect) GetTagTreeCondition() string {
+	return fmt.Sprintf("(JSON_CONTAINS(%s, ?) OR JSON_SEARCH(%s, 'one', ?, '!') IS NOT NULL)", d.GetJSONExtract("$.tags"), d.GetJSONExtract("$.tags"))
+}
+
+func (*MySQLDialect) GetTagTreeArgs(tag string) []any {
+	return []any{jsonString(tag), escapeLikePattern(tag) + "/%"}
+}
+
+// GetMemoGrantCondition matches memos that have been granted to a user.
+func (d *MySQLDialect) GetMemoGrantCondition() string {
+	return fmt.Sprintf("%s.`id` IN (SELECT `memo_id` FROM `memo_grant` WHERE `user_id` = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetMemoGroupCondition matches GROUP visibility memos targeting a group of a user.
+func (d *MySQLDialect) GetMemoGroupCondition() string {
+	return fmt.Sprintf("(%s.`visibility` = 'GROUP' AND %s.`id` IN (SELECT `memo_group`.`memo_id` FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `user_group_member`.`user_id` = ?))", d.GetTablePrefix("memo"), d.GetTablePrefix("memo"))
+}
+
+// GetMemoRelationCondition matches memos with a relation of a type to another memo.
+func (d *MySQLDialect) GetMemoRelationCondition() string {
+	return fmt.Sprintf("%s.`id` IN (SELECT `memo_id` FROM `memo_relation` WHERE `type` = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetInboxMessageType returns the type of the inbox message, e.g. MEMO_COMMENT.
+func (d *MySQLDialect) GetInboxMessageType() string {
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s.`message`, '$.type'))", d.GetTablePrefix("inbox"))
+}
+
 // PostgreSQLDialect implements SQLDialect for PostgreSQL.
 type PostgreSQLDialect struct{}
 
@@ -184,7 +291,8 @@ func (d *PostgreSQLDialect) GetJSONArrayLength(path string) string {
 }
 
 func (d *PostgreSQLDialect) GetJSONContains(path, _ string) string {
-	jsonPath := strings.Replace(path, "$.tags", "payload->'tags'", 1)
+	// Convert $.tags to payload->'tags'
+	jsonPath := fmt.Sprintf("payload->'%s'", strings.TrimPrefix(path, "$."))
 	return fmt.Sprintf("%s.%s @> jsonb_build_array(?::json)", d.GetTablePrefix("memo"), jsonPath)
 }
 
@@ -213,3 +321,56 @@ func (d *PostgreSQLDialect) GetTimestampComparison(field string) string {
 func (*PostgreSQLDialect) GetCurrentTimestamp() string {
 	return "EXTRACT(EPOCH FROM NOW())"
 }
+
+func (d *PostgreSQLDialect) GetFullTextSearch() string {
+	return fmt.Sprintf("to_tsvector('simple', %s.content) @@ plainto_tsquery('simple', ?)", d.GetTablePrefix("memo"))
+}
+
+func (*PostgreSQLDialect) GetFullTextQuery(query string) string {
+	return query
+}
+
+func (d *PostgreSQLDialect) GetFullTextRank() string {
+	return fmt.Sprintf("ts_rank(to_tsvector('simple', %s.content), plainto_tsquery('simple', ?))", d.GetTablePrefix("memo"))
+}
+
+// GetTagTreeCondition matches memos with the tag or any of its child tags.
+func (d *PostgreSQLDialect) GetTagTreeCondition() string {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM jsonb_array_elements_text(COALESCE(%s.payload->'tags', '[]'::jsonb)) AS memo_tag WHERE memo_tag = ? OR memo_tag LIKE ? ESCAPE '!')", d.GetTablePrefix("memo"))
+}
+
+func (*PostgreSQLDialect) GetTagTreeArgs(tag string) []any {
+	return []any{tag, escapeLikePattern(tag) + "/%"}
+}
+
+// GetMemoGrantCondition matches memos that have been granted to a user.
+func (d *PostgreSQLDialect) GetMemoGrantCondition() string {
+	return fmt.Sprintf("%s.id IN (SELECT memo_id FROM memo_grant WHERE user_id = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetMemoGroupCondition matches GROUP visibility memos targeting a group of a user.
+func (d *PostgreSQLDialect) GetMemoGroupCondition() string {
+	return fmt.Sprintf("(%s.visibility = 'GROUP' AND %s.id IN (SELECT memo_group.memo_id FROM memo_group JOIN user_group_member ON user_group_member.group_id = memo_group.group_id WHERE user_group_member.user_id = ?))", d.GetTablePrefix("memo"), d.GetTablePrefix("memo"))
+}
+
+// GetMemoRelationCondition matches memos with a relation of a type to another memo.
+func (d *PostgreSQLDialect) GetMemoRelationCondition() string {
+	return fmt.Sprintf("%s.id IN (SELECT memo_id FROM memo_relation WHERE type = ?)", d.GetTablePrefix("memo"))
+}
+
+// GetInboxMessageType returns the type of the inbox message, e.g. MEMO_COMMENT.
+func (d *PostgreSQLDialect) GetInboxMessageType() string {
+	return fmt.Sprintf("%s.message::jsonb->>'type'", d.GetTablePrefix("inbox"))
+}
+
+// jsonString encodes the value as a JSON string, quoted and escaped, e.g. for JSON_CONTAINS.
+func jsonString(value string) string {
+	// Marshaling a string never fails.
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+// escapeLikePattern escapes the wildcards of a LIKE pattern with '!', the escape character of the tag tree conditions.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
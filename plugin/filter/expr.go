@@ -125,3 +125,24 @@ func GetExprValue(expr *exprv1.Expr) (any, error) {
 	// If not a constant, try to evaluate as a function
 	return GetFunctionValue(expr)
 }
+
+// GetSearchQueries returns the queries of all search() calls in the expression.
+func GetSearchQueries(expr *exprv1.Expr) []string {
+	callExpr, ok := expr.ExprKind.(*exprv1.Expr_CallExpr)
+	if !ok {
+		return nil
+	}
+
+	queries := []string{}
+	if callExpr.CallExpr.Function == "search" && len(callExpr.CallExpr.Args) == 1 {
+		if query, err := GetConstValue(callExpr.CallExpr.Args[0]); err == nil {
+			if queryStr, ok := query.(string); ok {
+				queries = append(queries, queryStr)
+			}
+		}
+	}
+	for _, arg := range callExpr.CallExpr.Args {
+		queries = append(queries, GetSearchQueries(arg)...)
+	}
+	return queries
+}
@@ -1,6 +1,8 @@
 package filter
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -19,6 +21,8 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("pinned", cel.BoolType),
 	cel.Variable("tag", cel.StringType),
 	cel.Variable("tags", cel.ListType(cel.StringType)),
+	// The usernames mentioned in the content, e.g. `mentions.exists(m, m == "alice")`.
+	cel.Variable("mentions", cel.ListType(cel.StringType)),
 	cel.Variable("visibility", cel.StringType),
 	cel.Variable("has_task_list", cel.BoolType),
 	cel.Variable("has_link", cel.BoolType),
@@ -34,6 +38,44 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 			}),
 		),
 	),
+	// Full-text search function, backed by the native full-text index of each database.
+	cel.Function("search",
+		cel.Overload("search_string",
+			[]*cel.Type{cel.StringType},
+			cel.BoolType,
+		),
+	),
+	// Hierarchical tag function, matching a tag and all of its child tags.
+	// Filters use it as `tag in_tree "work"`, which is rewritten to `in_tree(tag, "work")` before compiling.
+	cel.Function("in_tree",
+		cel.Overload("in_tree_string_string",
+			[]*cel.Type{cel.StringType, cel.StringType},
+			cel.BoolType,
+		),
+	),
+	// Memo grant function, matching memos that have been granted to the user with the given ID.
+	// The API only accepts the ID of the current user, see ConvertContext.UserIDs.
+	cel.Function("granted_to",
+		cel.Overload("granted_to_int",
+			[]*cel.Type{cel.IntType},
+			cel.BoolType,
+		),
+	),
+	// User group function, matching GROUP visibility memos that target a group the user with the given ID is a member of.
+	// The API only accepts the ID of the current user as well.
+	cel.Function("in_groups_of",
+		cel.Overload("in_groups_of_int",
+			[]*cel.Type{cel.IntType},
+			cel.BoolType,
+		),
+	),
+	// Memo relation function, matching memos with a relation of the given type to another memo, e.g. has_relation("blocks").
+	cel.Function("has_relation",
+		cel.Overload("has_relation_string",
+			[]*cel.Type{cel.StringType},
+			cel.BoolType,
+		),
+	),
 }
 
 // ReactionFilterCELAttributes are the CEL attributes for reaction.
@@ -51,6 +93,13 @@ var AttachmentFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("memo_id", cel.StringType),
 }
 
+// InboxFilterCELAttributes are the CEL attributes for inbox.
+var InboxFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("status", cel.StringType),
+	// The type of the inbox message, e.g. `message_type == "MEMO_COMMENT"`, as `type` is reserved in CEL.
+	cel.Variable("message_type", cel.StringType),
+}
+
 // Parse parses the filter string and returns the parsed expression.
 // The filter string should be a CEL expression.
 func Parse(filter string, opts ...cel.EnvOption) (expr *exprv1.ParsedExpr, err error) {
@@ -58,9 +107,50 @@ func Parse(filter string, opts ...cel.EnvOption) (expr *exprv1.ParsedExpr, err e
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create CEL environment")
 	}
-	ast, issues := e.Compile(filter)
+	ast, issues := e.Compile(rewriteInTreeOperator(filter))
 	if issues != nil {
 		return nil, errors.Errorf("failed to compile filter: %v", issues)
 	}
 	return cel.AstToParsedExpr(ast)
 }
+
+// inTreeOperatorRegexp matches the `tag in_tree "work"` operator up to its string literal operand.
+var inTreeOperatorRegexp = regexp.MustCompile(`^tag\s+in_tree\s+("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')`)
+
+// rewriteInTreeOperator rewrites every `tag in_tree "work"` outside of string literals to `in_tree(tag, "work")`,
+// as CEL doesn't support custom infix operators.
+func rewriteInTreeOperator(filter string) string {
+	var builder strings.Builder
+	var quote byte
+	for i := 0; i < len(filter); i++ {
+		c := filter[i]
+		if quote != 0 {
+			builder.WriteByte(c)
+			if c == '\\' && i+1 < len(filter) {
+				i++
+				builder.WriteByte(filter[i])
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+			builder.WriteByte(c)
+			continue
+		}
+		if c == 't' && (i == 0 || !isIdentifierByte(filter[i-1])) {
+			if match := inTreeOperatorRegexp.FindStringSubmatch(filter[i:]); match != nil {
+				builder.WriteString("in_tree(tag, " + match[1] + ")")
+				i += len(match[0]) - 1
+				continue
+			}
+		}
+		builder.WriteByte(c)
+	}
+	return builder.String()
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
@@ -88,6 +88,16 @@ const (
 	Inbox_MEMO_COMMENT Inbox_Type = 1
 	// Version update notification.
 	Inbox_VERSION_UPDATE Inbox_Type = 2
+	// Memo access granted notification.
+	Inbox_MEMO_GRANT Inbox_Type = 3
+	// Memo reminder due notification.
+	Inbox_REMINDER Inbox_Type = 4
+	// Memo mention notification.
+	Inbox_MEMO_MENTION Inbox_Type = 5
+	// Memo reaction notification.
+	Inbox_MEMO_REACTION Inbox_Type = 6
+	// Memo reference notification.
+	Inbox_MEMO_REFERENCE Inbox_Type = 7
 )
 
 // Enum value maps for Inbox_Type.
@@ -96,11 +106,21 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_GRANT",
+		4: "REMINDER",
+		5: "MEMO_MENTION",
+		6: "MEMO_REACTION",
+		7: "MEMO_REFERENCE",
 	}
 	Inbox_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
 		"VERSION_UPDATE":   2,
+		"MEMO_GRANT":       3,
+		"REMINDER":         4,
+		"MEMO_MENTION":     5,
+		"MEMO_REACTION":    6,
+		"MEMO_REFERENCE":   7,
 	}
 )
 
@@ -149,7 +169,15 @@ type Inbox struct {
 	// The type of the inbox notification.
 	Type Inbox_Type `protobuf:"varint,6,opt,name=type,proto3,enum=memos.api.v1.Inbox_Type" json:"type,omitempty"`
 	// Optional. The activity ID associated with this inbox notification.
-	ActivityId    *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	ActivityId *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// Input only. The time to snooze a reminder until, the reminder comes back to the inbox then.
+	// Snoozing archives the inbox notification, set the status to ARCHIVED to dismiss a reminder for good.
+	SnoozeTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=snooze_time,json=snoozeTime,proto3" json:"snooze_time,omitempty"`
+	// Output only. The users aggregated into the notification, the latest sender first.
+	// Reactions and references to the same memo are aggregated into one notification until it's read,
+	// e.g. "N people reacted to your memo".
+	// Format: users/{user}
+	Senders       []string `protobuf:"bytes,9,rep,name=senders,proto3" json:"senders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +261,20 @@ func (x *Inbox) GetActivityId() int32 {
 	return 0
 }
 
+func (x *Inbox) GetSnoozeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnoozeTime
+	}
+	return nil
+}
+
+func (x *Inbox) GetSenders() []string {
+	if x != nil {
+		return x.Senders
+	}
+	return nil
+}
+
 type ListInboxesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose inboxes will be listed.
@@ -246,10 +288,10 @@ type ListInboxesRequest struct {
 	// Optional. A page token, received from a previous `ListInboxes` call.
 	// Provide this to retrieve the subsequent page.
 	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Filter to apply to the list results.
-	// Example: "status=UNREAD" or "type=MEMO_COMMENT"
-	// Supported operators: =, !=
-	// Supported fields: status, type, sender, create_time
+	// Optional. A CEL expression to filter the list results by.
+	// Example: `status == "UNREAD" && message_type in ["MEMO_COMMENT", "MEMO_MENTION"]`
+	// Supported operators: ==, !=, in
+	// Supported fields: status, message_type
 	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	// Optional. The order to sort results by.
 	// Example: "create_time desc" or "status asc"
@@ -331,6 +373,7 @@ type ListInboxesResponse struct {
 	// If this field is omitted, there are no subsequent pages.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	// The total count of inboxes (may be approximate).
+	// Only set on the first page; use GetInboxStats for an up-to-date count.
 	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -496,11 +539,221 @@ func (x *DeleteInboxRequest) GetName() string {
 	return ""
 }
 
+type BatchUpdateInboxesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose inboxes will be updated.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. A CEL expression over status and message_type to select the inboxes to update, all of them if empty.
+	// Example: `message_type == "MEMO_REACTION"`
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Required. The status to set, ARCHIVED to mark the inboxes as read.
+	Status        Inbox_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.Inbox_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateInboxesRequest) Reset() {
+	*x = BatchUpdateInboxesRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateInboxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateInboxesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateInboxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateInboxesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateInboxesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchUpdateInboxesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BatchUpdateInboxesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchUpdateInboxesRequest) GetStatus() Inbox_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Inbox_STATUS_UNSPECIFIED
+}
+
+type BatchUpdateInboxesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of inboxes updated.
+	UpdatedCount  int32 `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateInboxesResponse) Reset() {
+	*x = BatchUpdateInboxesResponse{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateInboxesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateInboxesResponse) ProtoMessage() {}
+
+func (x *BatchUpdateInboxesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateInboxesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateInboxesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchUpdateInboxesResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+type GetInboxStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose inbox stats will be returned.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboxStatsRequest) Reset() {
+	*x = GetInboxStatsRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboxStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboxStatsRequest) ProtoMessage() {}
+
+func (x *GetInboxStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboxStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetInboxStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetInboxStatsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type InboxStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of unread inboxes.
+	UnreadCount int32 `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	// The number of unread inboxes by type, keyed by the type name, e.g. "MEMO_COMMENT".
+	UnreadTypeCount map[string]int32 `protobuf:"bytes,2,rep,name=unread_type_count,json=unreadTypeCount,proto3" json:"unread_type_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InboxStats) Reset() {
+	*x = InboxStats{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxStats) ProtoMessage() {}
+
+func (x *InboxStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxStats.ProtoReflect.Descriptor instead.
+func (*InboxStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InboxStats) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *InboxStats) GetUnreadTypeCount() map[string]int32 {
+	if x != nil {
+		return x.UnreadTypeCount
+	}
+	return nil
+}
+
 var File_api_v1_inbox_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x04\n" +
+	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x05\n" +
 	"\x05Inbox\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
 	"\x06sender\x18\x02 \x01(\tB\x03\xe0A\x03R\x06sender\x12\x1f\n" +
@@ -510,16 +763,25 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"createTime\x121\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.memos.api.v1.Inbox.TypeB\x03\xe0A\x03R\x04type\x12)\n" +
 	"\vactivity_id\x18\a \x01(\x05B\x03\xe0A\x01H\x00R\n" +
-	"activityId\x88\x01\x01\":\n" +
+	"activityId\x88\x01\x01\x12@\n" +
+	"\vsnooze_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x04R\n" +
+	"snoozeTime\x12\x1d\n" +
+	"\asenders\x18\t \x03(\tB\x03\xe0A\x03R\asenders\":\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\"B\n" +
+	"\bARCHIVED\x10\x02\"\x99\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02:>\xeaA;\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"MEMO_GRANT\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x12\n" +
+	"\x0eMEMO_REFERENCE\x10\a:>\xeaA;\n" +
 	"\x12memos.api.v1/Inbox\x12\x0finboxes/{inbox}\x1a\x04name*\ainboxes2\x05inboxB\x0e\n" +
 	"\f_activity_id\"\xca\x01\n" +
 	"\x12ListInboxesRequest\x121\n" +
@@ -542,10 +804,29 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"D\n" +
 	"\x12DeleteInboxRequest\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
-	"\x12memos.api.v1/InboxR\x04name2\x92\x03\n" +
+	"\x12memos.api.v1/InboxR\x04name\"\xa4\x01\n" +
+	"\x19BatchUpdateInboxesRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x1b\n" +
+	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01R\x06filter\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.memos.api.v1.Inbox.StatusB\x03\xe0A\x02R\x06status\"A\n" +
+	"\x1aBatchUpdateInboxesResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"I\n" +
+	"\x14GetInboxStatsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"\xce\x01\n" +
+	"\n" +
+	"InboxStats\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\x12Y\n" +
+	"\x11unread_type_count\x18\x02 \x03(\v2-.memos.api.v1.InboxStats.UnreadTypeCountEntryR\x0funreadTypeCount\x1aB\n" +
+	"\x14UnreadTypeCountEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xd5\x05\n" +
 	"\fInboxService\x12\x85\x01\n" +
 	"\vListInboxes\x12 .memos.api.v1.ListInboxesRequest\x1a!.memos.api.v1.ListInboxesResponse\"1\xdaA\x06parent\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{parent=users/*}/inboxes\x12\x87\x01\n" +
-	"\vUpdateInbox\x12 .memos.api.v1.UpdateInboxRequest\x1a\x13.memos.api.v1.Inbox\"A\xdaA\x11inbox,update_mask\x82\xd3\xe4\x93\x02':\x05inbox2\x1e/api/v1/{inbox.name=inboxes/*}\x12p\n" +
+	"\vUpdateInbox\x12 .memos.api.v1.UpdateInboxRequest\x1a\x13.memos.api.v1.Inbox\"A\xdaA\x11inbox,update_mask\x82\xd3\xe4\x93\x02':\x05inbox2\x1e/api/v1/{inbox.name=inboxes/*}\x12\xb7\x01\n" +
+	"\x12BatchUpdateInboxes\x12'.memos.api.v1.BatchUpdateInboxesRequest\x1a(.memos.api.v1.BatchUpdateInboxesResponse\"N\xdaA\x14parent,filter,status\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{parent=users/*}/inboxes:batchUpdate\x12\x86\x01\n" +
+	"\rGetInboxStats\x12\".memos.api.v1.GetInboxStatsRequest\x1a\x18.memos.api.v1.InboxStats\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/inboxes:stats\x12p\n" +
 	"\vDeleteInbox\x12 .memos.api.v1.DeleteInboxRequest\x1a\x16.google.protobuf.Empty\"'\xdaA\x04name\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/{name=inboxes/*}B\xa9\x01\n" +
 	"\x10com.memos.api.v1B\x11InboxServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
@@ -562,37 +843,49 @@ func file_api_v1_inbox_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_inbox_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_v1_inbox_service_proto_goTypes = []any{
-	(Inbox_Status)(0),             // 0: memos.api.v1.Inbox.Status
-	(Inbox_Type)(0),               // 1: memos.api.v1.Inbox.Type
-	(*Inbox)(nil),                 // 2: memos.api.v1.Inbox
-	(*ListInboxesRequest)(nil),    // 3: memos.api.v1.ListInboxesRequest
-	(*ListInboxesResponse)(nil),   // 4: memos.api.v1.ListInboxesResponse
-	(*UpdateInboxRequest)(nil),    // 5: memos.api.v1.UpdateInboxRequest
-	(*DeleteInboxRequest)(nil),    // 6: memos.api.v1.DeleteInboxRequest
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(Inbox_Status)(0),                  // 0: memos.api.v1.Inbox.Status
+	(Inbox_Type)(0),                    // 1: memos.api.v1.Inbox.Type
+	(*Inbox)(nil),                      // 2: memos.api.v1.Inbox
+	(*ListInboxesRequest)(nil),         // 3: memos.api.v1.ListInboxesRequest
+	(*ListInboxesResponse)(nil),        // 4: memos.api.v1.ListInboxesResponse
+	(*UpdateInboxRequest)(nil),         // 5: memos.api.v1.UpdateInboxRequest
+	(*DeleteInboxRequest)(nil),         // 6: memos.api.v1.DeleteInboxRequest
+	(*BatchUpdateInboxesRequest)(nil),  // 7: memos.api.v1.BatchUpdateInboxesRequest
+	(*BatchUpdateInboxesResponse)(nil), // 8: memos.api.v1.BatchUpdateInboxesResponse
+	(*GetInboxStatsRequest)(nil),       // 9: memos.api.v1.GetInboxStatsRequest
+	(*InboxStats)(nil),                 // 10: memos.api.v1.InboxStats
+	nil,                                // 11: memos.api.v1.InboxStats.UnreadTypeCountEntry
+	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),              // 14: google.protobuf.Empty
 }
 var file_api_v1_inbox_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
-	7, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
-	1, // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
-	2, // 3: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
-	2, // 4: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
-	8, // 5: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3, // 6: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
-	5, // 7: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
-	6, // 8: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
-	4, // 9: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
-	2, // 10: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
-	9, // 11: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
+	12, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
+	12, // 3: memos.api.v1.Inbox.snooze_time:type_name -> google.protobuf.Timestamp
+	2,  // 4: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
+	2,  // 5: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
+	13, // 6: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: memos.api.v1.BatchUpdateInboxesRequest.status:type_name -> memos.api.v1.Inbox.Status
+	11, // 8: memos.api.v1.InboxStats.unread_type_count:type_name -> memos.api.v1.InboxStats.UnreadTypeCountEntry
+	3,  // 9: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
+	5,  // 10: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
+	7,  // 11: memos.api.v1.InboxService.BatchUpdateInboxes:input_type -> memos.api.v1.BatchUpdateInboxesRequest
+	9,  // 12: memos.api.v1.InboxService.GetInboxStats:input_type -> memos.api.v1.GetInboxStatsRequest
+	6,  // 13: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
+	4,  // 14: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
+	2,  // 15: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
+	8,  // 16: memos.api.v1.InboxService.BatchUpdateInboxes:output_type -> memos.api.v1.BatchUpdateInboxesResponse
+	10, // 17: memos.api.v1.InboxService.GetInboxStats:output_type -> memos.api.v1.InboxStats
+	14, // 18: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_inbox_service_proto_init() }
@@ -607,7 +900,7 @@ func file_api_v1_inbox_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_inbox_service_proto_rawDesc), len(file_api_v1_inbox_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
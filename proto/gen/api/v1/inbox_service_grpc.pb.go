@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InboxService_ListInboxes_FullMethodName = "/memos.api.v1.InboxService/ListInboxes"
-	InboxService_UpdateInbox_FullMethodName = "/memos.api.v1.InboxService/UpdateInbox"
-	InboxService_DeleteInbox_FullMethodName = "/memos.api.v1.InboxService/DeleteInbox"
+	InboxService_ListInboxes_FullMethodName        = "/memos.api.v1.InboxService/ListInboxes"
+	InboxService_UpdateInbox_FullMethodName        = "/memos.api.v1.InboxService/UpdateInbox"
+	InboxService_BatchUpdateInboxes_FullMethodName = "/memos.api.v1.InboxService/BatchUpdateInboxes"
+	InboxService_GetInboxStats_FullMethodName      = "/memos.api.v1.InboxService/GetInboxStats"
+	InboxService_DeleteInbox_FullMethodName        = "/memos.api.v1.InboxService/DeleteInbox"
 )
 
 // InboxServiceClient is the client API for InboxService service.
@@ -33,6 +35,10 @@ type InboxServiceClient interface {
 	ListInboxes(ctx context.Context, in *ListInboxesRequest, opts ...grpc.CallOption) (*ListInboxesResponse, error)
 	// UpdateInbox updates an inbox.
 	UpdateInbox(ctx context.Context, in *UpdateInboxRequest, opts ...grpc.CallOption) (*Inbox, error)
+	// BatchUpdateInboxes updates the status of all the inboxes of a user matching a filter, e.g. to mark them all as read.
+	BatchUpdateInboxes(ctx context.Context, in *BatchUpdateInboxesRequest, opts ...grpc.CallOption) (*BatchUpdateInboxesResponse, error)
+	// GetInboxStats returns the unread inbox counts of a user.
+	GetInboxStats(ctx context.Context, in *GetInboxStatsRequest, opts ...grpc.CallOption) (*InboxStats, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(ctx context.Context, in *DeleteInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -65,6 +71,26 @@ func (c *inboxServiceClient) UpdateInbox(ctx context.Context, in *UpdateInboxReq
 	return out, nil
 }
 
+func (c *inboxServiceClient) BatchUpdateInboxes(ctx context.Context, in *BatchUpdateInboxesRequest, opts ...grpc.CallOption) (*BatchUpdateInboxesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateInboxesResponse)
+	err := c.cc.Invoke(ctx, InboxService_BatchUpdateInboxes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) GetInboxStats(ctx context.Context, in *GetInboxStatsRequest, opts ...grpc.CallOption) (*InboxStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxStats)
+	err := c.cc.Invoke(ctx, InboxService_GetInboxStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inboxServiceClient) DeleteInbox(ctx context.Context, in *DeleteInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -83,6 +109,10 @@ type InboxServiceServer interface {
 	ListInboxes(context.Context, *ListInboxesRequest) (*ListInboxesResponse, error)
 	// UpdateInbox updates an inbox.
 	UpdateInbox(context.Context, *UpdateInboxRequest) (*Inbox, error)
+	// BatchUpdateInboxes updates the status of all the inboxes of a user matching a filter, e.g. to mark them all as read.
+	BatchUpdateInboxes(context.Context, *BatchUpdateInboxesRequest) (*BatchUpdateInboxesResponse, error)
+	// GetInboxStats returns the unread inbox counts of a user.
+	GetInboxStats(context.Context, *GetInboxStatsRequest) (*InboxStats, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedInboxServiceServer()
@@ -101,6 +131,12 @@ func (UnimplementedInboxServiceServer) ListInboxes(context.Context, *ListInboxes
 func (UnimplementedInboxServiceServer) UpdateInbox(context.Context, *UpdateInboxRequest) (*Inbox, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInbox not implemented")
 }
+func (UnimplementedInboxServiceServer) BatchUpdateInboxes(context.Context, *BatchUpdateInboxesRequest) (*BatchUpdateInboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateInboxes not implemented")
+}
+func (UnimplementedInboxServiceServer) GetInboxStats(context.Context, *GetInboxStatsRequest) (*InboxStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInboxStats not implemented")
+}
 func (UnimplementedInboxServiceServer) DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInbox not implemented")
 }
@@ -161,6 +197,42 @@ func _InboxService_UpdateInbox_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InboxService_BatchUpdateInboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateInboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).BatchUpdateInboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_BatchUpdateInboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).BatchUpdateInboxes(ctx, req.(*BatchUpdateInboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_GetInboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).GetInboxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_GetInboxStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).GetInboxStats(ctx, req.(*GetInboxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InboxService_DeleteInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInboxRequest)
 	if err := dec(in); err != nil {
@@ -194,6 +266,14 @@ var InboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInbox",
 			Handler:    _InboxService_UpdateInbox_Handler,
 		},
+		{
+			MethodName: "BatchUpdateInboxes",
+			Handler:    _InboxService_BatchUpdateInboxes_Handler,
+		},
+		{
+			MethodName: "GetInboxStats",
+			Handler:    _InboxService_GetInboxStats_Handler,
+		},
 		{
 			MethodName: "DeleteInbox",
 			Handler:    _InboxService_DeleteInbox_Handler,
@@ -32,6 +32,8 @@ const (
 	Visibility_PRIVATE                Visibility = 1
 	Visibility_PROTECTED              Visibility = 2
 	Visibility_PUBLIC                 Visibility = 3
+	// Visible to the members of the groups of the memo.
+	Visibility_GROUP Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -41,12 +43,14 @@ var (
 		1: "PRIVATE",
 		2: "PROTECTED",
 		3: "PUBLIC",
+		4: "GROUP",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"PRIVATE":                1,
 		"PROTECTED":              2,
 		"PUBLIC":                 3,
+		"GROUP":                  4,
 	}
 )
 
@@ -77,6 +81,61 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+type ListMemoChangesResponse_Tombstone_Type int32
+
+const (
+	ListMemoChangesResponse_Tombstone_TYPE_UNSPECIFIED ListMemoChangesResponse_Tombstone_Type = 0
+	ListMemoChangesResponse_Tombstone_MEMO             ListMemoChangesResponse_Tombstone_Type = 1
+	ListMemoChangesResponse_Tombstone_RELATION         ListMemoChangesResponse_Tombstone_Type = 2
+	ListMemoChangesResponse_Tombstone_REACTION         ListMemoChangesResponse_Tombstone_Type = 3
+	ListMemoChangesResponse_Tombstone_ATTACHMENT       ListMemoChangesResponse_Tombstone_Type = 4
+)
+
+// Enum value maps for ListMemoChangesResponse_Tombstone_Type.
+var (
+	ListMemoChangesResponse_Tombstone_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "MEMO",
+		2: "RELATION",
+		3: "REACTION",
+		4: "ATTACHMENT",
+	}
+	ListMemoChangesResponse_Tombstone_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"MEMO":             1,
+		"RELATION":         2,
+		"REACTION":         3,
+		"ATTACHMENT":       4,
+	}
+)
+
+func (x ListMemoChangesResponse_Tombstone_Type) Enum() *ListMemoChangesResponse_Tombstone_Type {
+	p := new(ListMemoChangesResponse_Tombstone_Type)
+	*p = x
+	return p
+}
+
+func (x ListMemoChangesResponse_Tombstone_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMemoChangesResponse_Tombstone_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ListMemoChangesResponse_Tombstone_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x ListMemoChangesResponse_Tombstone_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListMemoChangesResponse_Tombstone_Type.Descriptor instead.
+func (ListMemoChangesResponse_Tombstone_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16, 0, 0}
+}
+
 // The type of the relation.
 type MemoRelation_Type int32
 
@@ -84,6 +143,8 @@ const (
 	MemoRelation_TYPE_UNSPECIFIED MemoRelation_Type = 0
 	MemoRelation_REFERENCE        MemoRelation_Type = 1
 	MemoRelation_COMMENT          MemoRelation_Type = 2
+	// A relation type defined in the workspace memo related setting.
+	MemoRelation_CUSTOM MemoRelation_Type = 3
 )
 
 // Enum value maps for MemoRelation_Type.
@@ -92,11 +153,13 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "REFERENCE",
 		2: "COMMENT",
+		3: "CUSTOM",
 	}
 	MemoRelation_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"REFERENCE":        1,
 		"COMMENT":          2,
+		"CUSTOM":           3,
 	}
 )
 
@@ -111,11 +174,11 @@ func (x MemoRelation_Type) String() string {
 }
 
 func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
 }
 
 func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+	return &file_api_v1_memo_service_proto_enumTypes[2]
 }
 
 func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
@@ -124,7 +187,170 @@ func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemoRelation_Type.Descriptor instead.
 func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type ListMemoRelationsRequest_Direction int32
+
+const (
+	// Relations in both directions.
+	ListMemoRelationsRequest_DIRECTION_UNSPECIFIED ListMemoRelationsRequest_Direction = 0
+	// Relations from the memo to other memos.
+	ListMemoRelationsRequest_OUTGOING ListMemoRelationsRequest_Direction = 1
+	// Relations from other memos to the memo, i.e. backlinks.
+	ListMemoRelationsRequest_INCOMING ListMemoRelationsRequest_Direction = 2
+)
+
+// Enum value maps for ListMemoRelationsRequest_Direction.
+var (
+	ListMemoRelationsRequest_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "OUTGOING",
+		2: "INCOMING",
+	}
+	ListMemoRelationsRequest_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"OUTGOING":              1,
+		"INCOMING":              2,
+	}
+)
+
+func (x ListMemoRelationsRequest_Direction) Enum() *ListMemoRelationsRequest_Direction {
+	p := new(ListMemoRelationsRequest_Direction)
+	*p = x
+	return p
+}
+
+func (x ListMemoRelationsRequest_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMemoRelationsRequest_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ListMemoRelationsRequest_Direction) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[3]
+}
+
+func (x ListMemoRelationsRequest_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest_Direction.Descriptor instead.
+func (ListMemoRelationsRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type MemoRevision_DiffLine_Operation int32
+
+const (
+	MemoRevision_DiffLine_OPERATION_UNSPECIFIED MemoRevision_DiffLine_Operation = 0
+	// The line is present in both versions.
+	MemoRevision_DiffLine_EQUAL MemoRevision_DiffLine_Operation = 1
+	// The line only exists in the current version.
+	MemoRevision_DiffLine_INSERT MemoRevision_DiffLine_Operation = 2
+	// The line only exists in the revision.
+	MemoRevision_DiffLine_DELETE MemoRevision_DiffLine_Operation = 3
+)
+
+// Enum value maps for MemoRevision_DiffLine_Operation.
+var (
+	MemoRevision_DiffLine_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "EQUAL",
+		2: "INSERT",
+		3: "DELETE",
+	}
+	MemoRevision_DiffLine_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"EQUAL":                 1,
+		"INSERT":                2,
+		"DELETE":                3,
+	}
+)
+
+func (x MemoRevision_DiffLine_Operation) Enum() *MemoRevision_DiffLine_Operation {
+	p := new(MemoRevision_DiffLine_Operation)
+	*p = x
+	return p
+}
+
+func (x MemoRevision_DiffLine_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoRevision_DiffLine_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[4].Descriptor()
+}
+
+func (MemoRevision_DiffLine_Operation) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[4]
+}
+
+func (x MemoRevision_DiffLine_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoRevision_DiffLine_Operation.Descriptor instead.
+func (MemoRevision_DiffLine_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41, 0, 0}
+}
+
+// The access a grant gives its user.
+type MemoGrant_Role int32
+
+const (
+	MemoGrant_ROLE_UNSPECIFIED MemoGrant_Role = 0
+	// Can read the memo.
+	MemoGrant_VIEWER MemoGrant_Role = 1
+	// Can read and comment on the memo.
+	MemoGrant_COMMENTER MemoGrant_Role = 2
+	// Can read, comment on and edit the memo.
+	MemoGrant_EDITOR MemoGrant_Role = 3
+)
+
+// Enum value maps for MemoGrant_Role.
+var (
+	MemoGrant_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "COMMENTER",
+		3: "EDITOR",
+	}
+	MemoGrant_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"VIEWER":           1,
+		"COMMENTER":        2,
+		"EDITOR":           3,
+	}
+)
+
+func (x MemoGrant_Role) Enum() *MemoGrant_Role {
+	p := new(MemoGrant_Role)
+	*p = x
+	return p
+}
+
+func (x MemoGrant_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGrant_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[5].Descriptor()
+}
+
+func (MemoGrant_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[5]
+}
+
+func (x MemoGrant_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGrant_Role.Descriptor instead.
+func (MemoGrant_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type Reaction struct {
@@ -252,7 +478,22 @@ type Memo struct {
 	// Output only. The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Output only. The time the memo was moved to the trash.
+	// Only set for memos in the trash.
+	TrashTime *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=trash_time,json=trashTime,proto3" json:"trash_time,omitempty"`
+	// Output only. The excerpt of the memo content matching the `search()` query of the list filter.
+	// Matched terms are wrapped in <mark></mark> tags, and the rest of the text is HTML escaped.
+	Highlight string `protobuf:"bytes,20,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	// The etag of the memo, derived from its update time and editable state.
+	// Send it back in UpdateMemo to fail with ABORTED if the memo has been modified since it was read.
+	Etag string `protobuf:"bytes,21,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional. The groups that can see the memo when its visibility is GROUP.
+	// Format: groups/{group}
+	Groups []string `protobuf:"bytes,22,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Optional. The time the memo is scheduled to become public.
+	// The memo keeps its visibility until then, and the schedule is cleared once it's published.
+	PublishTime   *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +654,41 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetTrashTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrashTime
+	}
+	return nil
+}
+
+func (x *Memo) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+func (x *Memo) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Memo) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
 type Location struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A placeholder text for the location.
@@ -565,6 +841,7 @@ type ListMemosRequest struct {
 	// Optional. The order to sort results by.
 	// Default to "display_time desc".
 	// Example: "display_time desc" or "create_time asc"
+	// Use "relevance" together with a `search("...")` filter to sort by full-text relevance.
 	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	// Optional. Filter to apply to the list results.
 	// Filter is a CEL expression to filter memos.
@@ -719,7 +996,10 @@ type GetMemoRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Optional. The fields to return in the response.
 	// If not specified, all fields are returned.
-	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Optional. The token of a share link of the memo.
+	// A valid token grants read access without authentication, whatever the memo's visibility.
+	ShareToken    string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -768,6 +1048,13 @@ func (x *GetMemoRequest) GetReadMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *GetMemoRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
 type UpdateMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The memo to update.
@@ -887,33 +1174,30 @@ func (x *DeleteMemoRequest) GetForce() bool {
 	return false
 }
 
-type RenameMemoTagRequest struct {
+type ListTrashedMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to rename all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The old tag name to rename.
-	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
-	// Required. The new tag name.
-	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameMemoTagRequest) Reset() {
-	*x = RenameMemoTagRequest{}
+func (x *ListTrashedMemosRequest) Reset() {
+	*x = ListTrashedMemosRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameMemoTagRequest) String() string {
+func (x *ListTrashedMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameMemoTagRequest) ProtoMessage() {}
+func (*ListTrashedMemosRequest) ProtoMessage() {}
 
-func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTrashedMemosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,59 +1209,49 @@ func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrashedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedMemosRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RenameMemoTagRequest) GetParent() string {
-	if x != nil {
-		return x.Parent
-	}
-	return ""
-}
-
-func (x *RenameMemoTagRequest) GetOldTag() string {
+func (x *ListTrashedMemosRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.OldTag
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameMemoTagRequest) GetNewTag() string {
+func (x *ListTrashedMemosRequest) GetPageToken() string {
 	if x != nil {
-		return x.NewTag
+		return x.PageToken
 	}
 	return ""
 }
 
-type DeleteMemoTagRequest struct {
+type ListTrashedMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to delete all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The tag name to delete.
-	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	// Optional. Whether to delete related memos.
-	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The list of memos in the trash.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoTagRequest) Reset() {
-	*x = DeleteMemoTagRequest{}
+func (x *ListTrashedMemosResponse) Reset() {
+	*x = ListTrashedMemosResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoTagRequest) String() string {
+func (x *ListTrashedMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoTagRequest) ProtoMessage() {}
+func (*ListTrashedMemosResponse) ProtoMessage() {}
 
-func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTrashedMemosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,57 +1263,48 @@ func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrashedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedMemosResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteMemoTagRequest) GetParent() string {
+func (x *ListTrashedMemosResponse) GetMemos() []*Memo {
 	if x != nil {
-		return x.Parent
+		return x.Memos
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteMemoTagRequest) GetTag() string {
+func (x *ListTrashedMemosResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Tag
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
-	if x != nil {
-		return x.DeleteRelatedMemos
-	}
-	return false
-}
-
-type SetMemoAttachmentsRequest struct {
+type RestoreMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
+	// Required. The resource name of the memo to restore.
 	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The attachments to set for the memo.
-	Attachments   []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoAttachmentsRequest) Reset() {
-	*x = SetMemoAttachmentsRequest{}
+func (x *RestoreMemoRequest) Reset() {
+	*x = RestoreMemoRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoAttachmentsRequest) String() string {
+func (x *RestoreMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoAttachmentsRequest) ProtoMessage() {}
+func (*RestoreMemoRequest) ProtoMessage() {}
 
-func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreMemoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,52 +1316,38 @@ func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreMemoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SetMemoAttachmentsRequest) GetName() string {
+func (x *RestoreMemoRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
-
-type ListMemoAttachmentsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of attachments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type EmptyTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsRequest) Reset() {
-	*x = ListMemoAttachmentsRequest{}
+func (x *EmptyTrashRequest) Reset() {
+	*x = EmptyTrashRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsRequest) String() string {
+func (x *EmptyTrashRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsRequest) ProtoMessage() {}
+func (*EmptyTrashRequest) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *EmptyTrashRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1108,58 +1359,35 @@ func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmptyTrashRequest.ProtoReflect.Descriptor instead.
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListMemoAttachmentsRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type ListScheduledMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListMemoAttachmentsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-type ListMemoAttachmentsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of attachments.
-	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of attachments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListMemoAttachmentsResponse) Reset() {
-	*x = ListMemoAttachmentsResponse{}
+func (x *ListScheduledMemosRequest) Reset() {
+	*x = ListScheduledMemosRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsResponse) String() string {
+func (x *ListScheduledMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsResponse) ProtoMessage() {}
+func (*ListScheduledMemosRequest) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListScheduledMemosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1171,57 +1399,49 @@ func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScheduledMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMemosRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
+func (x *ListScheduledMemosRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Attachments
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
+func (x *ListScheduledMemosRequest) GetPageToken() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
-	if x != nil {
-		return x.TotalSize
-	}
-	return 0
-}
-
-type MemoRelation struct {
+type ListScheduledMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo in the relation.
-	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// The related memo.
-	RelatedMemo   *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	Type          MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	// The list of scheduled memos, ordered by publish time.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation) Reset() {
-	*x = MemoRelation{}
+func (x *ListScheduledMemosResponse) Reset() {
+	*x = ListScheduledMemosResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation) String() string {
+func (x *ListScheduledMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation) ProtoMessage() {}
+func (*ListScheduledMemosResponse) ProtoMessage() {}
 
-func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+func (x *ListScheduledMemosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1233,57 +1453,51 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
-func (*MemoRelation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScheduledMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMemosResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
-	if x != nil {
-		return x.Memo
-	}
-	return nil
-}
-
-func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+func (x *ListScheduledMemosResponse) GetMemos() []*Memo {
 	if x != nil {
-		return x.RelatedMemo
+		return x.Memos
 	}
 	return nil
 }
 
-func (x *MemoRelation) GetType() MemoRelation_Type {
+func (x *ListScheduledMemosResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Type
+		return x.NextPageToken
 	}
-	return MemoRelation_TYPE_UNSPECIFIED
+	return ""
 }
 
-type SetMemoRelationsRequest struct {
+type ListMemoChangesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The relations to set for the memo.
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Optional. The sync token returned by a previous call.
+	// Leave empty to only get the current sync token, before doing a full sync with ListMemos.
+	// Tokens expire 30 days after they were issued, an expired token fails with FAILED_PRECONDITION and needs a full sync again.
+	SyncToken string `protobuf:"bytes,1,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`
+	// Optional. The maximum number of changes to return.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
+func (x *ListMemoChangesRequest) Reset() {
+	*x = ListMemoChangesRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *ListMemoChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoChangesRequest) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,52 +1509,59 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoChangesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *ListMemoChangesRequest) GetSyncToken() string {
 	if x != nil {
-		return x.Name
+		return x.SyncToken
 	}
 	return ""
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *ListMemoChangesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Relations
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type ListMemoRelationsRequest struct {
+type ListMemoChangesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of relations to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The memos created or updated since the sync token.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The memo relations created since the sync token.
+	Relations []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The reactions created since the sync token.
+	Reactions []*Reaction `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// The attachments created or updated since the sync token.
+	Attachments []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// The resources deleted since the sync token, or no longer visible to the current user.
+	Tombstones []*ListMemoChangesResponse_Tombstone `protobuf:"bytes,5,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	// The token to send as `sync_token` to get the changes after this response.
+	NextSyncToken string `protobuf:"bytes,6,opt,name=next_sync_token,json=nextSyncToken,proto3" json:"next_sync_token,omitempty"`
+	// Whether there are more changes to fetch with `next_sync_token`.
+	HasMore       bool `protobuf:"varint,7,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
+func (x *ListMemoChangesResponse) Reset() {
+	*x = ListMemoChangesResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *ListMemoChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoChangesResponse) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoChangesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,58 +1573,87 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoChangesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *ListMemoChangesResponse) GetMemos() []*Memo {
 	if x != nil {
-		return x.Name
+		return x.Memos
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+func (x *ListMemoChangesResponse) GetRelations() []*MemoRelation {
 	if x != nil {
-		return x.PageSize
+		return x.Relations
 	}
-	return 0
+	return nil
 }
 
-func (x *ListMemoRelationsRequest) GetPageToken() string {
+func (x *ListMemoChangesResponse) GetReactions() []*Reaction {
 	if x != nil {
-		return x.PageToken
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListMemoChangesResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListMemoChangesResponse) GetTombstones() []*ListMemoChangesResponse_Tombstone {
+	if x != nil {
+		return x.Tombstones
+	}
+	return nil
+}
+
+func (x *ListMemoChangesResponse) GetNextSyncToken() string {
+	if x != nil {
+		return x.NextSyncToken
 	}
 	return ""
 }
 
-type ListMemoRelationsResponse struct {
+func (x *ListMemoChangesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type RenameMemoTagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of relations.
-	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of relations.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to rename all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The old tag name to rename.
+	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Required. The new tag name.
+	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *RenameMemoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*RenameMemoTagRequest) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1415,59 +1665,61 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+func (x *RenameMemoTagRequest) GetParent() string {
 	if x != nil {
-		return x.Relations
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+func (x *RenameMemoTagRequest) GetOldTag() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.OldTag
 	}
 	return ""
 }
 
-func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+func (x *RenameMemoTagRequest) GetNewTag() string {
 	if x != nil {
-		return x.TotalSize
+		return x.NewTag
 	}
-	return 0
+	return ""
 }
 
-type CreateMemoCommentRequest struct {
+type DeleteMemoTagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The comment to create.
-	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	// Optional. The comment ID to use.
-	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to delete all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag name to delete.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. Whether to delete related memos entirely.
+	// If true: delete the entire memo
+	// If false: only remove the tag from memo content (default)
+	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
+func (x *DeleteMemoTagRequest) Reset() {
+	*x = DeleteMemoTagRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *DeleteMemoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*DeleteMemoTagRequest) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1479,61 +1731,55 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *DeleteMemoTagRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *Memo {
+func (x *DeleteMemoTagRequest) GetTag() string {
 	if x != nil {
-		return x.Comment
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetCommentId() string {
+func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
 	if x != nil {
-		return x.CommentId
+		return x.DeleteRelatedMemos
 	}
-	return ""
+	return false
 }
 
-type ListMemoCommentsRequest struct {
+type ListTagsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of comments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The order to sort results by.
-	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. The user whose memos to list tags from.
+	// Format: users/{user}. If empty, tags are listed from all memos visible to the current user.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsRequest) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1545,66 +1791,165 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListMemoCommentsRequest) GetName() string {
+func (x *ListTagsRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The top-level tags, sorted by name.
+	Tags          []*TagTreeNode `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListMemoCommentsRequest) GetPageToken() string {
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagTreeNode {
 	if x != nil {
-		return x.PageToken
+		return x.Tags
+	}
+	return nil
+}
+
+// A tag in the tag tree. Tags are split into levels by "/", e.g. "work/projectA/meeting".
+type TagTreeNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The last level of the tag, e.g. "meeting".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The full tag, e.g. "work/projectA/meeting".
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of memos with the tag or any of its child tags.
+	MemoCount int32 `protobuf:"varint,3,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The child tags, sorted by name.
+	Children      []*TagTreeNode `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTreeNode) Reset() {
+	*x = TagTreeNode{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTreeNode) ProtoMessage() {}
+
+func (x *TagTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTreeNode.ProtoReflect.Descriptor instead.
+func (*TagTreeNode) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagTreeNode) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsRequest) GetOrderBy() string {
+func (x *TagTreeNode) GetTag() string {
 	if x != nil {
-		return x.OrderBy
+		return x.Tag
 	}
 	return ""
 }
 
-type ListMemoCommentsResponse struct {
+func (x *TagTreeNode) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *TagTreeNode) GetChildren() []*TagTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type SetMemoAttachmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of comment memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of comments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The attachments to set for the memo.
+	Attachments []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Optional. The etag of the memo as last read by the client.
+	// If set and the memo has been modified since, the request fails with ABORTED.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *SetMemoAttachmentsRequest) Reset() {
+	*x = SetMemoAttachmentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *SetMemoAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*SetMemoAttachmentsRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,38 +1960,38 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *SetMemoAttachmentsRequest) GetName() string {
 	if x != nil {
-		return x.Memos
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.NextPageToken
+		return x.Attachments
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+func (x *SetMemoAttachmentsRequest) GetEtag() string {
 	if x != nil {
-		return x.TotalSize
+		return x.Etag
 	}
-	return 0
+	return ""
 }
 
-type ListMemoReactionsRequest struct {
+type ListMemoAttachmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of reactions to return.
+	// Optional. The maximum number of attachments to return.
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Optional. A page token for pagination.
 	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
@@ -1654,21 +1999,21 @@ type ListMemoReactionsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *ListMemoAttachmentsRequest) Reset() {
+	*x = ListMemoAttachmentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *ListMemoAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*ListMemoAttachmentsRequest) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,59 +2024,59 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *ListMemoAttachmentsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListMemoReactionsRequest) GetPageToken() string {
+func (x *ListMemoAttachmentsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListMemoReactionsResponse struct {
+type ListMemoAttachmentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of reactions.
-	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// The list of attachments.
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	// A token for the next page of results.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of reactions.
+	// The total count of attachments.
 	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *ListMemoAttachmentsResponse) Reset() {
+	*x = ListMemoAttachmentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *ListMemoAttachmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*ListMemoAttachmentsResponse) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,58 +2087,65 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.Reactions
+		return x.Attachments
 	}
 	return nil
 }
 
-func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
 	if x != nil {
 		return x.TotalSize
 	}
 	return 0
 }
 
-type UpsertMemoReactionRequest struct {
+type MemoRelation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The reaction to upsert.
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// The memo in the relation.
+	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The related memo.
+	RelatedMemo *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	Type        MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	// Output only. The line of the memo content that references the related memo, shortened around the reference.
+	// Only set for references written in the memo content, e.g. [[memos/{memo}]] or a link to the memo.
+	ContextSnippet string `protobuf:"bytes,4,opt,name=context_snippet,json=contextSnippet,proto3" json:"context_snippet,omitempty"`
+	// The name of the custom relation type when the type is CUSTOM, e.g. "blocks".
+	CustomType string `protobuf:"bytes,5,opt,name=custom_type,json=customType,proto3" json:"custom_type,omitempty"`
+	// Output only. The label of the custom relation type seen from the related memo, e.g. "blocked by".
+	InverseLabel  string `protobuf:"bytes,6,opt,name=inverse_label,json=inverseLabel,proto3" json:"inverse_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoRelation) Reset() {
+	*x = MemoRelation{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
+func (x *MemoRelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
+func (*MemoRelation) ProtoMessage() {}
 
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,49 +2156,2131 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
+func (*MemoRelation) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
 	if x != nil {
-		return x.Name
+		return x.Memo
 	}
-	return ""
+	return nil
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
 	if x != nil {
-		return x.Reaction
+		return x.RelatedMemo
 	}
 	return nil
 }
 
-type DeleteMemoReactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the reaction to delete.
-	// Format: reactions/{reaction}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MemoRelation) GetType() MemoRelation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoRelation_TYPE_UNSPECIFIED
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *MemoRelation) GetContextSnippet() string {
+	if x != nil {
+		return x.ContextSnippet
+	}
+	return ""
+}
+
+func (x *MemoRelation) GetCustomType() string {
+	if x != nil {
+		return x.CustomType
+	}
+	return ""
+}
+
+func (x *MemoRelation) GetInverseLabel() string {
+	if x != nil {
+		return x.InverseLabel
+	}
+	return ""
+}
+
+type SetMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The relations to set for the memo.
+	Relations []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Optional. The etag of the memo as last read by the client.
+	// If set and the memo has been modified since, the request fails with ABORTED.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoRelationsRequest) ProtoMessage() {}
+
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *SetMemoRelationsRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of relations to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The direction of the relations to list.
+	Direction     ListMemoRelationsRequest_Direction `protobuf:"varint,4,opt,name=direction,proto3,enum=memos.api.v1.ListMemoRelationsRequest_Direction" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetDirection() ListMemoRelationsRequest_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ListMemoRelationsRequest_DIRECTION_UNSPECIFIED
+}
+
+type ListMemoRelationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of relations.
+	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of relations.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsResponse) ProtoMessage() {}
+
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetMemoGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The resource name of the memo to start from.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The filter selecting the memos to start from when no name is set.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The number of hops to walk from the starting memos.
+	// The default is 1 and the maximum is 5.
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Optional. The maximum number of nodes to return.
+	// The default is 100 and the maximum is 1000.
+	MaxNodes      int32 `protobuf:"varint,4,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoGraphRequest) Reset() {
+	*x = GetMemoGraphRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphRequest) ProtoMessage() {}
+
+func (x *GetMemoGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMemoGraphRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMemoGraphRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetMemoGraphRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetMemoGraphRequest) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+type MemoGraph struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos in the graph, the starting memos first.
+	Nodes []*MemoGraph_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The relations between the memos in the graph.
+	Edges []*MemoGraph_Edge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Whether the graph was cut off at max_nodes.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGraph) Reset() {
+	*x = MemoGraph{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGraph) ProtoMessage() {}
+
+func (x *MemoGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGraph.ProtoReflect.Descriptor instead.
+func (*MemoGraph) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MemoGraph) GetNodes() []*MemoGraph_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *MemoGraph) GetEdges() []*MemoGraph_Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *MemoGraph) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The comment to create.
+	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Optional. The comment ID to use.
+	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CreateMemoCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of comments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The order to sort results by.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of comment memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of comments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of reactions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoReactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of reactions.
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of reactions.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UpsertMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The reaction to upsert.
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
+
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpsertMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type DeleteMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the reaction to delete.
+	// Format: reactions/{reaction}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SuggestMemoTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The content of the memo for tag suggestion.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The existing tags for the memo.
+	ExistingTags  []string `protobuf:"bytes,2,rep,name=existing_tags,json=existingTags,proto3" json:"existing_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMemoTagsRequest) Reset() {
+	*x = SuggestMemoTagsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemoTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemoTagsRequest) ProtoMessage() {}
+
+func (x *SuggestMemoTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMemoTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMemoTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SuggestMemoTagsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SuggestMemoTagsRequest) GetExistingTags() []string {
+	if x != nil {
+		return x.ExistingTags
+	}
+	return nil
+}
+
+type TagSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggested tag name.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The reason why this tag is recommended.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSuggestion) Reset() {
+	*x = TagSuggestion{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSuggestion) ProtoMessage() {}
+
+func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSuggestion.ProtoReflect.Descriptor instead.
+func (*TagSuggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TagSuggestion) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagSuggestion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuggestMemoTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggested tags with reasons for the memo.
+	SuggestedTags []*TagSuggestion `protobuf:"bytes,1,rep,name=suggested_tags,json=suggestedTags,proto3" json:"suggested_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestMemoTagsResponse) Reset() {
+	*x = SuggestMemoTagsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemoTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemoTagsResponse) ProtoMessage() {}
+
+func (x *SuggestMemoTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestMemoTagsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMemoTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SuggestMemoTagsResponse) GetSuggestedTags() []*TagSuggestion {
+	if x != nil {
+		return x.SuggestedTags
+	}
+	return nil
+}
+
+type MemoRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the user whose change superseded this revision.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Output only. The time the revision was recorded.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The content of the memo at this revision.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Output only. The visibility of the memo at this revision.
+	Visibility Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Output only. The line diff from this revision to the current memo content.
+	// Only populated by GetMemoRevision.
+	Diff          []*MemoRevision_DiffLine `protobuf:"bytes,6,rep,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevision) Reset() {
+	*x = MemoRevision{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevision) ProtoMessage() {}
+
+func (x *MemoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevision.ProtoReflect.Descriptor instead.
+func (*MemoRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MemoRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *MemoRevision) GetDiff() []*MemoRevision_DiffLine {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type ListMemoRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of revisions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsRequest) Reset() {
+	*x = ListMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListMemoRevisionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMemoRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of revisions, newest first.
+	Revisions []*MemoRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsResponse) Reset() {
+	*x = ListMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsResponse) ProtoMessage() {}
+
+func (x *ListMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMemoRevisionsResponse) GetRevisions() []*MemoRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListMemoRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRevisionRequest) Reset() {
+	*x = GetMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRevisionRequest) ProtoMessage() {}
+
+func (x *GetMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision to restore.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMemoRevisionRequest) Reset() {
+	*x = RestoreMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMemoRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RestoreMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Task struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the task.
+	// Format: memos/{memo}/tasks/{task}, task is the line index of the task in the memo content.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resource name of the memo containing the task.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Output only. The zero-based index of the line of the task in the memo content.
+	LineIndex int32 `protobuf:"varint,3,opt,name=line_index,json=lineIndex,proto3" json:"line_index,omitempty"`
+	// Output only. The text of the task in plain text.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// Output only. Whether the task is checked.
+	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Output only. The due date of the task, written as "due:YYYY-MM-DD" or "📅 YYYY-MM-DD" in the task text.
+	DueTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Task) GetLineIndex() int32 {
+	if x != nil {
+		return x.LineIndex
+	}
+	return 0
+}
+
+func (x *Task) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Task) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Task) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos with matching tasks to list tasks from.
+	// The tasks of a memo are never split across pages.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The filter of the memos to list tasks from, with the same syntax as in ListMemos.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. If set, only list the tasks that are checked or unchecked.
+	Completed     *bool `protobuf:"varint,4,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetCompleted() bool {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return false
+}
+
+type ListTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of tasks, ordered by memo and then by line.
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ToggleTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the task to toggle.
+	// Format: memos/{memo}/tasks/{task}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The etag of the memo as last read by the client.
+	// If set and the memo has been modified since, the request fails with ABORTED.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleTaskRequest) Reset() {
+	*x = ToggleTaskRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleTaskRequest) ProtoMessage() {}
+
+func (x *ToggleTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleTaskRequest.ProtoReflect.Descriptor instead.
+func (*ToggleTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ToggleTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToggleTaskRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type MemoShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the share link.
+	// Format: memos/{memo}/shares/{share}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The unguessable token of the share link.
+	// Pass it as share_token to GetMemo and GetAttachmentBinary.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// The name of the user who created the share link.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. The time the share link stops working.
+	// If not set, the share link works until it is revoked.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoShare) Reset() {
+	*x = MemoShare{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoShare) ProtoMessage() {}
+
+func (x *MemoShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoShare.ProtoReflect.Descriptor instead.
+func (*MemoShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MemoShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MemoShare) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MemoShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type CreateMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The share link to create.
+	MemoShare     *MemoShare `protobuf:"bytes,2,opt,name=memo_share,json=memoShare,proto3" json:"memo_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoShareRequest) Reset() {
+	*x = CreateMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoShareRequest) ProtoMessage() {}
+
+func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateMemoShareRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoShareRequest) GetMemoShare() *MemoShare {
+	if x != nil {
+		return x.MemoShare
+	}
+	return nil
+}
+
+type ListMemoSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesRequest) Reset() {
+	*x = ListMemoSharesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesRequest) ProtoMessage() {}
+
+func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListMemoSharesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share links of the memo, newest first, including expired ones.
+	MemoShares    []*MemoShare `protobuf:"bytes,1,rep,name=memo_shares,json=memoShares,proto3" json:"memo_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesResponse) Reset() {
+	*x = ListMemoSharesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesResponse) ProtoMessage() {}
+
+func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListMemoSharesResponse) GetMemoShares() []*MemoShare {
+	if x != nil {
+		return x.MemoShares
+	}
+	return nil
+}
+
+type RevokeMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the share link.
+	// Format: memos/{memo}/shares/{share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMemoShareRequest) Reset() {
+	*x = RevokeMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMemoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMemoShareRequest) ProtoMessage() {}
+
+func (x *RevokeMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RevokeMemoShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MemoGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the grant, identified by the ID of the granted user.
+	// Format: memos/{memo}/grants/{grant}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The name of the granted user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Required. The access given to the user.
+	Role MemoGrant_Role `protobuf:"varint,3,opt,name=role,proto3,enum=memos.api.v1.MemoGrant_Role" json:"role,omitempty"`
+	// The name of the user who created the grant.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGrant) Reset() {
+	*x = MemoGrant{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGrant) ProtoMessage() {}
+
+func (x *MemoGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGrant.ProtoReflect.Descriptor instead.
+func (*MemoGrant) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MemoGrant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoGrant) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MemoGrant) GetRole() MemoGrant_Role {
+	if x != nil {
+		return x.Role
+	}
+	return MemoGrant_ROLE_UNSPECIFIED
+}
+
+func (x *MemoGrant) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MemoGrant) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListMemoGrantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoGrantsRequest) Reset() {
+	*x = ListMemoGrantsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoGrantsRequest) ProtoMessage() {}
+
+func (x *ListMemoGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMemoGrantsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoGrantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grants of the memo, oldest first.
+	MemoGrants    []*MemoGrant `protobuf:"bytes,1,rep,name=memo_grants,json=memoGrants,proto3" json:"memo_grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoGrantsResponse) Reset() {
+	*x = ListMemoGrantsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoGrantsResponse) ProtoMessage() {}
+
+func (x *ListMemoGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListMemoGrantsResponse) GetMemoGrants() []*MemoGrant {
+	if x != nil {
+		return x.MemoGrants
+	}
+	return nil
+}
+
+type UpsertMemoGrantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The grant to create or update.
+	MemoGrant     *MemoGrant `protobuf:"bytes,2,opt,name=memo_grant,json=memoGrant,proto3" json:"memo_grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoGrantRequest) Reset() {
+	*x = UpsertMemoGrantRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoGrantRequest) ProtoMessage() {}
+
+func (x *UpsertMemoGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoGrantRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpsertMemoGrantRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *UpsertMemoGrantRequest) GetMemoGrant() *MemoGrant {
+	if x != nil {
+		return x.MemoGrant
+	}
+	return nil
+}
+
+type DeleteMemoGrantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the grant.
+	// Format: memos/{memo}/grants/{grant}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoGrantRequest) Reset() {
+	*x = DeleteMemoGrantRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoGrantRequest) ProtoMessage() {}
+
+func (x *DeleteMemoGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoGrantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteMemoGrantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Computed properties of a memo.
+type Memo_Property struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Memo_Property) Reset() {
+	*x = Memo_Property{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *Memo_Property) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*Memo_Property) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *Memo_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,43 +4291,68 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
+func (*Memo_Property) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *DeleteMemoReactionRequest) GetName() string {
+func (x *Memo_Property) GetHasLink() bool {
 	if x != nil {
-		return x.Name
+		return x.HasLink
 	}
-	return ""
+	return false
 }
 
-type SuggestMemoTagsRequest struct {
+func (x *Memo_Property) GetHasTaskList() bool {
+	if x != nil {
+		return x.HasTaskList
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasIncompleteTasks() bool {
+	if x != nil {
+		return x.HasIncompleteTasks
+	}
+	return false
+}
+
+type ListMemoChangesResponse_Tombstone struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The content of the memo for tag suggestion.
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// Optional. The existing tags for the memo.
-	ExistingTags  []string `protobuf:"bytes,2,rep,name=existing_tags,json=existingTags,proto3" json:"existing_tags,omitempty"`
+	// The type of the deleted resource.
+	Type ListMemoChangesResponse_Tombstone_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.ListMemoChangesResponse_Tombstone_Type" json:"type,omitempty"`
+	// The resource name of the deleted memo, reaction or attachment.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The deleted relation. Only set for relations.
+	Relation *MemoRelation `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	// The time the resource was deleted.
+	DeleteTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuggestMemoTagsRequest) Reset() {
-	*x = SuggestMemoTagsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListMemoChangesResponse_Tombstone) Reset() {
+	*x = ListMemoChangesResponse_Tombstone{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuggestMemoTagsRequest) String() string {
+func (x *ListMemoChangesResponse_Tombstone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestMemoTagsRequest) ProtoMessage() {}
+func (*ListMemoChangesResponse_Tombstone) ProtoMessage() {}
 
-func (x *SuggestMemoTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListMemoChangesResponse_Tombstone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,50 +4363,66 @@ func (x *SuggestMemoTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestMemoTagsRequest.ProtoReflect.Descriptor instead.
-func (*SuggestMemoTagsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListMemoChangesResponse_Tombstone.ProtoReflect.Descriptor instead.
+func (*ListMemoChangesResponse_Tombstone) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *SuggestMemoTagsRequest) GetContent() string {
+func (x *ListMemoChangesResponse_Tombstone) GetType() ListMemoChangesResponse_Tombstone_Type {
 	if x != nil {
-		return x.Content
+		return x.Type
+	}
+	return ListMemoChangesResponse_Tombstone_TYPE_UNSPECIFIED
+}
+
+func (x *ListMemoChangesResponse_Tombstone) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SuggestMemoTagsRequest) GetExistingTags() []string {
+func (x *ListMemoChangesResponse_Tombstone) GetRelation() *MemoRelation {
 	if x != nil {
-		return x.ExistingTags
+		return x.Relation
 	}
 	return nil
 }
 
-type TagSuggestion struct {
+func (x *ListMemoChangesResponse_Tombstone) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+// Memo reference in relations.
+type MemoRelation_Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The suggested tag name.
-	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	// The reason why this tag is recommended.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The snippet of the memo content. Plain text only.
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagSuggestion) Reset() {
-	*x = TagSuggestion{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+func (x *MemoRelation_Memo) Reset() {
+	*x = MemoRelation_Memo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagSuggestion) String() string {
+func (x *MemoRelation_Memo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagSuggestion) ProtoMessage() {}
+func (*MemoRelation_Memo) ProtoMessage() {}
 
-func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,48 +4433,55 @@ func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagSuggestion.ProtoReflect.Descriptor instead.
-func (*TagSuggestion) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
+func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25, 0}
 }
 
-func (x *TagSuggestion) GetTag() string {
+func (x *MemoRelation_Memo) GetName() string {
 	if x != nil {
-		return x.Tag
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TagSuggestion) GetReason() string {
+func (x *MemoRelation_Memo) GetSnippet() string {
 	if x != nil {
-		return x.Reason
+		return x.Snippet
 	}
 	return ""
 }
 
-type SuggestMemoTagsResponse struct {
+type MemoGraph_Node struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The suggested tags with reasons for the memo.
-	SuggestedTags []*TagSuggestion `protobuf:"bytes,1,rep,name=suggested_tags,json=suggestedTags,proto3" json:"suggested_tags,omitempty"`
+	// The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The snippet of the memo content. Plain text only.
+	Snippet string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The number of hops from the starting memos.
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Whether the memo has no relations to other memos the user can see.
+	Orphan        bool `protobuf:"varint,4,opt,name=orphan,proto3" json:"orphan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuggestMemoTagsResponse) Reset() {
-	*x = SuggestMemoTagsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+func (x *MemoGraph_Node) Reset() {
+	*x = MemoGraph_Node{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuggestMemoTagsResponse) String() string {
+func (x *MemoGraph_Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestMemoTagsResponse) ProtoMessage() {}
+func (*MemoGraph_Node) ProtoMessage() {}
 
-func (x *SuggestMemoTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+func (x *MemoGraph_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,44 +4492,68 @@ func (x *SuggestMemoTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestMemoTagsResponse.ProtoReflect.Descriptor instead.
-func (*SuggestMemoTagsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use MemoGraph_Node.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Node) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (x *SuggestMemoTagsResponse) GetSuggestedTags() []*TagSuggestion {
+func (x *MemoGraph_Node) GetName() string {
 	if x != nil {
-		return x.SuggestedTags
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// Computed properties of a memo.
-type Memo_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *MemoGraph_Node) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
 }
 
-func (x *Memo_Property) Reset() {
-	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+func (x *MemoGraph_Node) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *MemoGraph_Node) GetOrphan() bool {
+	if x != nil {
+		return x.Orphan
+	}
+	return false
+}
+
+type MemoGraph_Edge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo the relation starts from.
+	// Format: memos/{memo}
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The resource name of the related memo.
+	// Format: memos/{memo}
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The type of the relation.
+	Type          MemoRelation_Type `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoGraph_Edge) Reset() {
+	*x = MemoGraph_Edge{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo_Property) String() string {
+func (x *MemoGraph_Edge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo_Property) ProtoMessage() {}
+func (*MemoGraph_Edge) ProtoMessage() {}
 
-func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+func (x *MemoGraph_Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,66 +4564,57 @@ func (x *Memo_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
-func (*Memo_Property) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *Memo_Property) GetHasLink() bool {
-	if x != nil {
-		return x.HasLink
-	}
-	return false
+// Deprecated: Use MemoGraph_Edge.ProtoReflect.Descriptor instead.
+func (*MemoGraph_Edge) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30, 1}
 }
 
-func (x *Memo_Property) GetHasTaskList() bool {
+func (x *MemoGraph_Edge) GetSource() string {
 	if x != nil {
-		return x.HasTaskList
+		return x.Source
 	}
-	return false
+	return ""
 }
 
-func (x *Memo_Property) GetHasCode() bool {
+func (x *MemoGraph_Edge) GetTarget() string {
 	if x != nil {
-		return x.HasCode
+		return x.Target
 	}
-	return false
+	return ""
 }
 
-func (x *Memo_Property) GetHasIncompleteTasks() bool {
+func (x *MemoGraph_Edge) GetType() MemoRelation_Type {
 	if x != nil {
-		return x.HasIncompleteTasks
+		return x.Type
 	}
-	return false
+	return MemoRelation_TYPE_UNSPECIFIED
 }
 
-// Memo reference in relations.
-type MemoRelation_Memo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Output only. The snippet of the memo content. Plain text only.
-	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+// A single line of a line-based diff.
+type MemoRevision_DiffLine struct {
+	state     protoimpl.MessageState          `protogen:"open.v1"`
+	Operation MemoRevision_DiffLine_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=memos.api.v1.MemoRevision_DiffLine_Operation" json:"operation,omitempty"`
+	// The text of the line without the trailing newline.
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation_Memo) Reset() {
-	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+func (x *MemoRevision_DiffLine) Reset() {
+	*x = MemoRevision_DiffLine{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation_Memo) String() string {
+func (x *MemoRevision_DiffLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation_Memo) ProtoMessage() {}
+func (*MemoRevision_DiffLine) ProtoMessage() {}
 
-func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+func (x *MemoRevision_DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,21 +4625,21 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
-func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use MemoRevision_DiffLine.ProtoReflect.Descriptor instead.
+func (*MemoRevision_DiffLine) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *MemoRelation_Memo) GetName() string {
+func (x *MemoRevision_DiffLine) GetOperation() MemoRevision_DiffLine_Operation {
 	if x != nil {
-		return x.Name
+		return x.Operation
 	}
-	return ""
+	return MemoRevision_DiffLine_OPERATION_UNSPECIFIED
 }
 
-func (x *MemoRelation_Memo) GetSnippet() string {
+func (x *MemoRevision_DiffLine) GetText() string {
 	if x != nil {
-		return x.Snippet
+		return x.Text
 	}
 	return ""
 }
@@ -2162,7 +4659,8 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:K\xeaAH\n" +
-	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\x87\t\n" +
+	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xe4\n" +
+	"\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -2188,7 +4686,13 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12>\n" +
+	"\n" +
+	"trash_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\ttrashTime\x12!\n" +
+	"\thighlight\x18\x14 \x01(\tB\x03\xe0A\x03R\thighlight\x12\x17\n" +
+	"\x04etag\x18\x15 \x01(\tB\x03\xe0A\x01R\x04etag\x12\x1b\n" +
+	"\x06groups\x18\x16 \x03(\tB\x03\xe0A\x01R\x06groups\x12B\n" +
+	"\fpublish_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\vpublishTime\x1a\x96\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
@@ -2219,11 +4723,13 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"}\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xa3\x01\n" +
 	"\x0eGetMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12<\n" +
-	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xac\x01\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\x12$\n" +
+	"\vshare_token\x18\x03 \x01(\tB\x03\xe0A\x01R\n" +
+	"shareToken\"\xac\x01\n" +
 	"\x11UpdateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
@@ -2232,7 +4738,52 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x11DeleteMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"\x85\x01\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"_\n" +
+	"\x17ListTrashedMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"l\n" +
+	"\x18ListTrashedMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"C\n" +
+	"\x12RestoreMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\x13\n" +
+	"\x11EmptyTrashRequest\"a\n" +
+	"\x19ListScheduledMemosRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"n\n" +
+	"\x1aListScheduledMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
+	"\x16ListMemoChangesRequest\x12\"\n" +
+	"\n" +
+	"sync_token\x18\x01 \x01(\tB\x03\xe0A\x01R\tsyncToken\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\"\xb8\x05\n" +
+	"\x17ListMemoChangesResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x128\n" +
+	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x124\n" +
+	"\treactions\x18\x03 \x03(\v2\x16.memos.api.v1.ReactionR\treactions\x12:\n" +
+	"\vattachments\x18\x04 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12O\n" +
+	"\n" +
+	"tombstones\x18\x05 \x03(\v2/.memos.api.v1.ListMemoChangesResponse.TombstoneR\n" +
+	"tombstones\x12&\n" +
+	"\x0fnext_sync_token\x18\x06 \x01(\tR\rnextSyncToken\x12\x19\n" +
+	"\bhas_more\x18\a \x01(\bR\ahasMore\x1a\xb2\x02\n" +
+	"\tTombstone\x12H\n" +
+	"\x04type\x18\x01 \x01(\x0e24.memos.api.v1.ListMemoChangesResponse.Tombstone.TypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\brelation\x18\x03 \x01(\v2\x1a.memos.api.v1.MemoRelationR\brelation\x12;\n" +
+	"\vdelete_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTime\"R\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04MEMO\x10\x01\x12\f\n" +
+	"\bRELATION\x10\x02\x12\f\n" +
+	"\bREACTION\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ATTACHMENT\x10\x04\"\x85\x01\n" +
 	"\x14RenameMemoTagRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x06parent\x12\x1c\n" +
@@ -2242,11 +4793,23 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x06parent\x12\x15\n" +
 	"\x03tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x03tag\x125\n" +
-	"\x14delete_related_memos\x18\x03 \x01(\bB\x03\xe0A\x01R\x12deleteRelatedMemos\"\x8b\x01\n" +
+	"\x14delete_related_memos\x18\x03 \x01(\bB\x03\xe0A\x01R\x12deleteRelatedMemos\"D\n" +
+	"\x0fListTagsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"A\n" +
+	"\x10ListTagsResponse\x12-\n" +
+	"\x04tags\x18\x01 \x03(\v2\x19.memos.api.v1.TagTreeNodeR\x04tags\"\x89\x01\n" +
+	"\vTagTreeNode\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x03 \x01(\x05R\tmemoCount\x125\n" +
+	"\bchildren\x18\x04 \x03(\v2\x19.memos.api.v1.TagTreeNodeR\bchildren\"\xa4\x01\n" +
 	"\x19SetMemoAttachmentsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12?\n" +
-	"\vattachments\x18\x02 \x03(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\vattachments\"\x91\x01\n" +
+	"\vattachments\x18\x02 \x03(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\vattachments\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tB\x03\xe0A\x01R\x04etag\"\x91\x01\n" +
 	"\x1aListMemoAttachmentsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
@@ -2257,34 +4820,65 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xdb\x02\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xe5\x03\n" +
 	"\fMemoRelation\x128\n" +
 	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\x04memo\x12G\n" +
 	"\frelated_memo\x18\x02 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\vrelatedMemo\x128\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x1f.memos.api.v1.MemoRelation.TypeB\x03\xe0A\x02R\x04type\x1aT\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1f.memos.api.v1.MemoRelation.TypeB\x03\xe0A\x02R\x04type\x12,\n" +
+	"\x0fcontext_snippet\x18\x04 \x01(\tB\x03\xe0A\x03R\x0econtextSnippet\x12$\n" +
+	"\vcustom_type\x18\x05 \x01(\tB\x03\xe0A\x01R\n" +
+	"customType\x12(\n" +
+	"\rinverse_label\x18\x06 \x01(\tB\x03\xe0A\x03R\finverseLabel\x1aT\n" +
 	"\x04Memo\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x1d\n" +
-	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"8\n" +
+	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"D\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tREFERENCE\x10\x01\x12\v\n" +
-	"\aCOMMENT\x10\x02\"\x87\x01\n" +
+	"\aCOMMENT\x10\x02\x12\n" +
+	"\n" +
+	"\x06CUSTOM\x10\x03\"\xa0\x01\n" +
 	"\x17SetMemoRelationsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12=\n" +
-	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationB\x03\xe0A\x02R\trelations\"\x8f\x01\n" +
+	"\trelations\x18\x02 \x03(\v2\x1a.memos.api.v1.MemoRelationB\x03\xe0A\x02R\trelations\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tB\x03\xe0A\x01R\x04etag\"\xa8\x02\n" +
 	"\x18ListMemoRelationsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x9c\x01\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12S\n" +
+	"\tdirection\x18\x04 \x01(\x0e20.memos.api.v1.ListMemoRelationsRequest.DirectionB\x03\xe0A\x01R\tdirection\"B\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bOUTGOING\x10\x01\x12\f\n" +
+	"\bINCOMING\x10\x02\"\x9c\x01\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
 	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xa0\x01\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x9e\x01\n" +
+	"\x13GetMemoGraphRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x1b\n" +
+	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x19\n" +
+	"\x05depth\x18\x03 \x01(\x05B\x03\xe0A\x01R\x05depth\x12 \n" +
+	"\tmax_nodes\x18\x04 \x01(\x05B\x03\xe0A\x01R\bmaxNodes\"\xe2\x02\n" +
+	"\tMemoGraph\x122\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1c.memos.api.v1.MemoGraph.NodeR\x05nodes\x122\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1c.memos.api.v1.MemoGraph.EdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\x1ab\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\x12\x16\n" +
+	"\x06orphan\x18\x04 \x01(\bR\x06orphan\x1ak\n" +
+	"\x04Edge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1f.memos.api.v1.MemoRelation.TypeR\x04type\"\xa0\x01\n" +
 	"\x18CreateMemoCommentRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x121\n" +
@@ -2328,14 +4922,135 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x03tag\x18\x01 \x01(\tB\x03\xe0A\x02R\x03tag\x12\x1b\n" +
 	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x02R\x06reason\"]\n" +
 	"\x17SuggestMemoTagsResponse\x12B\n" +
-	"\x0esuggested_tags\x18\x01 \x03(\v2\x1b.memos.api.v1.TagSuggestionR\rsuggestedTags*P\n" +
+	"\x0esuggested_tags\x18\x01 \x03(\v2\x1b.memos.api.v1.TagSuggestionR\rsuggestedTags\"\xd9\x04\n" +
+	"\fMemoRevision\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x123\n" +
+	"\acreator\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\tB\x03\xe0A\x03R\acontent\x12=\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x03R\n" +
+	"visibility\x12<\n" +
+	"\x04diff\x18\x06 \x03(\v2#.memos.api.v1.MemoRevision.DiffLineB\x03\xe0A\x03R\x04diff\x1a\xb6\x01\n" +
+	"\bDiffLine\x12K\n" +
+	"\toperation\x18\x01 \x01(\x0e2-.memos.api.v1.MemoRevision.DiffLine.OperationR\toperation\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"I\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EQUAL\x10\x01\x12\n" +
+	"\n" +
+	"\x06INSERT\x10\x02\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x03:d\xeaAa\n" +
+	"\x19memos.api.v1/MemoRevision\x12!memos/{memo}/revisions/{revision}\x1a\x04name*\rmemoRevisions2\fmemoRevision\"\x93\x01\n" +
+	"\x18ListMemoRevisionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"}\n" +
+	"\x19ListMemoRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"O\n" +
+	"\x16GetMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name\"S\n" +
+	"\x1aRestoreMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name\"\xb0\x02\n" +
+	"\x04Task\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12-\n" +
+	"\x04memo\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04memo\x12\"\n" +
+	"\n" +
+	"line_index\x18\x03 \x01(\x05B\x03\xe0A\x03R\tlineIndex\x12\x17\n" +
+	"\x04text\x18\x04 \x01(\tB\x03\xe0A\x03R\x04text\x12!\n" +
+	"\tcompleted\x18\x05 \x01(\bB\x03\xe0A\x03R\tcompleted\x12:\n" +
+	"\bdue_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\adueTime:D\xeaAA\n" +
+	"\x11memos.api.v1/Task\x12\x19memos/{memo}/tasks/{task}\x1a\x04name*\x05tasks2\x04task\"\xab\x01\n" +
+	"\x10ListTasksRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12&\n" +
+	"\tcompleted\x18\x04 \x01(\bB\x03\xe0A\x01H\x00R\tcompleted\x88\x01\x01B\f\n" +
+	"\n" +
+	"_completed\"e\n" +
+	"\x11ListTasksResponse\x12(\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x12.memos.api.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"[\n" +
+	"\x11ToggleTaskRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/TaskR\x04name\x12\x17\n" +
+	"\x04etag\x18\x02 \x01(\tB\x03\xe0A\x01R\x04etag\"\xe4\x02\n" +
+	"\tMemoShare\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x03R\x05token\x123\n" +
+	"\acreator\x18\x03 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x00R\n" +
+	"expireTime\x88\x01\x01:U\xeaAR\n" +
+	"\x16memos.api.v1/MemoShare\x12\x1bmemos/{memo}/shares/{share}\x1a\x04name*\n" +
+	"memoShares2\tmemoShareB\x0e\n" +
+	"\f_expire_time\"\x88\x01\n" +
+	"\x16CreateMemoShareRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12;\n" +
+	"\n" +
+	"memo_share\x18\x02 \x01(\v2\x17.memos.api.v1.MemoShareB\x03\xe0A\x02R\tmemoShare\"J\n" +
+	"\x15ListMemoSharesRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\"R\n" +
+	"\x16ListMemoSharesResponse\x128\n" +
+	"\vmemo_shares\x18\x01 \x03(\v2\x17.memos.api.v1.MemoShareR\n" +
+	"memoShares\"L\n" +
+	"\x16RevokeMemoShareRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/MemoShareR\x04name\"\xa0\x03\n" +
+	"\tMemoGrant\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12-\n" +
+	"\x04user\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04user\x125\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.memos.api.v1.MemoGrant.RoleB\x03\xe0A\x02R\x04role\x123\n" +
+	"\acreator\x18\x04 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"C\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x01\x12\r\n" +
+	"\tCOMMENTER\x10\x02\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x03:U\xeaAR\n" +
+	"\x16memos.api.v1/MemoGrant\x12\x1bmemos/{memo}/grants/{grant}\x1a\x04name*\n" +
+	"memoGrants2\tmemoGrant\"J\n" +
+	"\x15ListMemoGrantsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\"R\n" +
+	"\x16ListMemoGrantsResponse\x128\n" +
+	"\vmemo_grants\x18\x01 \x03(\v2\x17.memos.api.v1.MemoGrantR\n" +
+	"memoGrants\"\x88\x01\n" +
+	"\x16UpsertMemoGrantRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12;\n" +
+	"\n" +
+	"memo_grant\x18\x02 \x01(\v2\x17.memos.api.v1.MemoGrantB\x03\xe0A\x02R\tmemoGrant\"L\n" +
+	"\x16DeleteMemoGrantRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/MemoGrantR\x04name*[\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\xf7\x11\n" +
+	"\x06PUBLIC\x10\x03\x12\t\n" +
+	"\x05GROUP\x10\x042\xa6$\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12f\n" +
@@ -2344,20 +5059,42 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
 	"\n" +
-	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12\x95\x01\n" +
+	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12\x80\x01\n" +
+	"\x10ListTrashedMemos\x12%.memos.api.v1.ListTrashedMemosRequest\x1a&.memos.api.v1.ListTrashedMemosResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:trashed\x12u\n" +
+	"\vRestoreMemo\x12 .memos.api.v1.RestoreMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:restore\x12k\n" +
+	"\n" +
+	"EmptyTrash\x12\x1f.memos.api.v1.EmptyTrashRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:empty-trash\x12\x88\x01\n" +
+	"\x12ListScheduledMemos\x12'.memos.api.v1.ListScheduledMemosRequest\x1a(.memos.api.v1.ListScheduledMemosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/memos:scheduled\x12}\n" +
+	"\x0fListMemoChanges\x12$.memos.api.v1.ListMemoChangesRequest\x1a%.memos.api.v1.ListMemoChangesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:changes\x12\x95\x01\n" +
 	"\rRenameMemoTag\x12\".memos.api.v1.RenameMemoTagRequest\x1a\x16.google.protobuf.Empty\"H\xdaA\x16parent,old_tag,new_tag\x82\xd3\xe4\x93\x02):\x01*2$/api/v1/{parent=memos/*}/tags:rename\x12\x89\x01\n" +
 	"\rDeleteMemoTag\x12\".memos.api.v1.DeleteMemoTagRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\n" +
-	"parent,tag\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/{parent=memos/*}/tags:delete\x12\x8b\x01\n" +
+	"parent,tag\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/{parent=memos/*}/tags:delete\x12_\n" +
+	"\bListTags\x12\x1d.memos.api.v1.ListTagsRequest\x1a\x1e.memos.api.v1.ListTagsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/tags\x12\x8b\x01\n" +
 	"\x12SetMemoAttachments\x12'.memos.api.v1.SetMemoAttachmentsRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02':\x01*2\"/api/v1/{name=memos/*}/attachments\x12\x9d\x01\n" +
 	"\x13ListMemoAttachments\x12(.memos.api.v1.ListMemoAttachmentsRequest\x1a).memos.api.v1.ListMemoAttachmentsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/attachments\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
-	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x90\x01\n" +
+	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12g\n" +
+	"\fGetMemoGraph\x12!.memos.api.v1.GetMemoGraphRequest\x1a\x17.memos.api.v1.MemoGraph\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/memos:graph\x12\x90\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"?\xdaA\fname,comment\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x80\x01\n" +
 	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/{name=reactions/*}\x12\x85\x01\n" +
-	"\x0fSuggestMemoTags\x12$.memos.api.v1.SuggestMemoTagsRequest\x1a%.memos.api.v1.SuggestMemoTagsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/memos:suggest-tagsB\xa8\x01\n" +
+	"\x0fSuggestMemoTags\x12$.memos.api.v1.SuggestMemoTagsRequest\x1a%.memos.api.v1.SuggestMemoTagsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/memos:suggest-tags\x12\x99\x01\n" +
+	"\x11ListMemoRevisions\x12&.memos.api.v1.ListMemoRevisionsRequest\x1a'.memos.api.v1.ListMemoRevisionsResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=memos/*}/revisions\x12\x86\x01\n" +
+	"\x0fGetMemoRevision\x12$.memos.api.v1.GetMemoRevisionRequest\x1a\x1a.memos.api.v1.MemoRevision\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*/revisions/*}\x12\x91\x01\n" +
+	"\x13RestoreMemoRevision\x12(.memos.api.v1.RestoreMemoRevisionRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*/revisions/*}:restore\x12\x99\x01\n" +
+	"\x0fCreateMemoShare\x12$.memos.api.v1.CreateMemoShareRequest\x1a\x17.memos.api.v1.MemoShare\"G\xdaA\x11parent,memo_share\x82\xd3\xe4\x93\x02-:\n" +
+	"memo_share\"\x1f/api/v1/{parent=memos/*}/shares\x12\x8d\x01\n" +
+	"\x0eListMemoShares\x12#.memos.api.v1.ListMemoSharesRequest\x1a$.memos.api.v1.ListMemoSharesResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/shares\x12\x7f\n" +
+	"\x0fRevokeMemoShare\x12$.memos.api.v1.RevokeMemoShareRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/shares/*}\x12\x8d\x01\n" +
+	"\x0eListMemoGrants\x12#.memos.api.v1.ListMemoGrantsRequest\x1a$.memos.api.v1.ListMemoGrantsResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/grants\x12\x99\x01\n" +
+	"\x0fUpsertMemoGrant\x12$.memos.api.v1.UpsertMemoGrantRequest\x1a\x17.memos.api.v1.MemoGrant\"G\xdaA\x11parent,memo_grant\x82\xd3\xe4\x93\x02-:\n" +
+	"memo_grant\"\x1f/api/v1/{parent=memos/*}/grants\x12\x7f\n" +
+	"\x0fDeleteMemoGrant\x12$.memos.api.v1.DeleteMemoGrantRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/grants/*}\x12c\n" +
+	"\tListTasks\x12\x1e.memos.api.v1.ListTasksRequest\x1a\x1f.memos.api.v1.ListTasksResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12z\n" +
+	"\n" +
+	"ToggleTask\x12\x1f.memos.api.v1.ToggleTaskRequest\x1a\x12.memos.api.v1.Task\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=memos/*/tasks/*}:toggleB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2372,118 +5109,227 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                     // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),              // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                    // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                        // 3: memos.api.v1.Memo
-	(*Location)(nil),                    // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),           // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),            // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),           // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),              // 8: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),           // 9: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),           // 10: memos.api.v1.DeleteMemoRequest
-	(*RenameMemoTagRequest)(nil),        // 11: memos.api.v1.RenameMemoTagRequest
-	(*DeleteMemoTagRequest)(nil),        // 12: memos.api.v1.DeleteMemoTagRequest
-	(*SetMemoAttachmentsRequest)(nil),   // 13: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),  // 14: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil), // 15: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                // 16: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),     // 17: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),    // 18: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),   // 19: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),    // 20: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),     // 21: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),    // 22: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),    // 23: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),   // 24: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),   // 25: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),   // 26: memos.api.v1.DeleteMemoReactionRequest
-	(*SuggestMemoTagsRequest)(nil),      // 27: memos.api.v1.SuggestMemoTagsRequest
-	(*TagSuggestion)(nil),               // 28: memos.api.v1.TagSuggestion
-	(*SuggestMemoTagsResponse)(nil),     // 29: memos.api.v1.SuggestMemoTagsResponse
-	(*Memo_Property)(nil),               // 30: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),           // 31: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
-	(State)(0),                          // 33: memos.api.v1.State
-	(*Node)(nil),                        // 34: memos.api.v1.Node
-	(*Attachment)(nil),                  // 35: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),       // 36: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 37: google.protobuf.Empty
+	(Visibility)(0), // 0: memos.api.v1.Visibility
+	(ListMemoChangesResponse_Tombstone_Type)(0), // 1: memos.api.v1.ListMemoChangesResponse.Tombstone.Type
+	(MemoRelation_Type)(0),                      // 2: memos.api.v1.MemoRelation.Type
+	(ListMemoRelationsRequest_Direction)(0),     // 3: memos.api.v1.ListMemoRelationsRequest.Direction
+	(MemoRevision_DiffLine_Operation)(0),        // 4: memos.api.v1.MemoRevision.DiffLine.Operation
+	(MemoGrant_Role)(0),                         // 5: memos.api.v1.MemoGrant.Role
+	(*Reaction)(nil),                            // 6: memos.api.v1.Reaction
+	(*Memo)(nil),                                // 7: memos.api.v1.Memo
+	(*Location)(nil),                            // 8: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                   // 9: memos.api.v1.CreateMemoRequest
+	(*ListMemosRequest)(nil),                    // 10: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                   // 11: memos.api.v1.ListMemosResponse
+	(*GetMemoRequest)(nil),                      // 12: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                   // 13: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                   // 14: memos.api.v1.DeleteMemoRequest
+	(*ListTrashedMemosRequest)(nil),             // 15: memos.api.v1.ListTrashedMemosRequest
+	(*ListTrashedMemosResponse)(nil),            // 16: memos.api.v1.ListTrashedMemosResponse
+	(*RestoreMemoRequest)(nil),                  // 17: memos.api.v1.RestoreMemoRequest
+	(*EmptyTrashRequest)(nil),                   // 18: memos.api.v1.EmptyTrashRequest
+	(*ListScheduledMemosRequest)(nil),           // 19: memos.api.v1.ListScheduledMemosRequest
+	(*ListScheduledMemosResponse)(nil),          // 20: memos.api.v1.ListScheduledMemosResponse
+	(*ListMemoChangesRequest)(nil),              // 21: memos.api.v1.ListMemoChangesRequest
+	(*ListMemoChangesResponse)(nil),             // 22: memos.api.v1.ListMemoChangesResponse
+	(*RenameMemoTagRequest)(nil),                // 23: memos.api.v1.RenameMemoTagRequest
+	(*DeleteMemoTagRequest)(nil),                // 24: memos.api.v1.DeleteMemoTagRequest
+	(*ListTagsRequest)(nil),                     // 25: memos.api.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                    // 26: memos.api.v1.ListTagsResponse
+	(*TagTreeNode)(nil),                         // 27: memos.api.v1.TagTreeNode
+	(*SetMemoAttachmentsRequest)(nil),           // 28: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),          // 29: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),         // 30: memos.api.v1.ListMemoAttachmentsResponse
+	(*MemoRelation)(nil),                        // 31: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),             // 32: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),            // 33: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),           // 34: memos.api.v1.ListMemoRelationsResponse
+	(*GetMemoGraphRequest)(nil),                 // 35: memos.api.v1.GetMemoGraphRequest
+	(*MemoGraph)(nil),                           // 36: memos.api.v1.MemoGraph
+	(*CreateMemoCommentRequest)(nil),            // 37: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),             // 38: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),            // 39: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),            // 40: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),           // 41: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),           // 42: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),           // 43: memos.api.v1.DeleteMemoReactionRequest
+	(*SuggestMemoTagsRequest)(nil),              // 44: memos.api.v1.SuggestMemoTagsRequest
+	(*TagSuggestion)(nil),                       // 45: memos.api.v1.TagSuggestion
+	(*SuggestMemoTagsResponse)(nil),             // 46: memos.api.v1.SuggestMemoTagsResponse
+	(*MemoRevision)(nil),                        // 47: memos.api.v1.MemoRevision
+	(*ListMemoRevisionsRequest)(nil),            // 48: memos.api.v1.ListMemoRevisionsRequest
+	(*ListMemoRevisionsResponse)(nil),           // 49: memos.api.v1.ListMemoRevisionsResponse
+	(*GetMemoRevisionRequest)(nil),              // 50: memos.api.v1.GetMemoRevisionRequest
+	(*RestoreMemoRevisionRequest)(nil),          // 51: memos.api.v1.RestoreMemoRevisionRequest
+	(*Task)(nil),                                // 52: memos.api.v1.Task
+	(*ListTasksRequest)(nil),                    // 53: memos.api.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                   // 54: memos.api.v1.ListTasksResponse
+	(*ToggleTaskRequest)(nil),                   // 55: memos.api.v1.ToggleTaskRequest
+	(*MemoShare)(nil),                           // 56: memos.api.v1.MemoShare
+	(*CreateMemoShareRequest)(nil),              // 57: memos.api.v1.CreateMemoShareRequest
+	(*ListMemoSharesRequest)(nil),               // 58: memos.api.v1.ListMemoSharesRequest
+	(*ListMemoSharesResponse)(nil),              // 59: memos.api.v1.ListMemoSharesResponse
+	(*RevokeMemoShareRequest)(nil),              // 60: memos.api.v1.RevokeMemoShareRequest
+	(*MemoGrant)(nil),                           // 61: memos.api.v1.MemoGrant
+	(*ListMemoGrantsRequest)(nil),               // 62: memos.api.v1.ListMemoGrantsRequest
+	(*ListMemoGrantsResponse)(nil),              // 63: memos.api.v1.ListMemoGrantsResponse
+	(*UpsertMemoGrantRequest)(nil),              // 64: memos.api.v1.UpsertMemoGrantRequest
+	(*DeleteMemoGrantRequest)(nil),              // 65: memos.api.v1.DeleteMemoGrantRequest
+	(*Memo_Property)(nil),                       // 66: memos.api.v1.Memo.Property
+	(*ListMemoChangesResponse_Tombstone)(nil),   // 67: memos.api.v1.ListMemoChangesResponse.Tombstone
+	(*MemoRelation_Memo)(nil),                   // 68: memos.api.v1.MemoRelation.Memo
+	(*MemoGraph_Node)(nil),                      // 69: memos.api.v1.MemoGraph.Node
+	(*MemoGraph_Edge)(nil),                      // 70: memos.api.v1.MemoGraph.Edge
+	(*MemoRevision_DiffLine)(nil),               // 71: memos.api.v1.MemoRevision.DiffLine
+	(*timestamppb.Timestamp)(nil),               // 72: google.protobuf.Timestamp
+	(State)(0),                                  // 73: memos.api.v1.State
+	(*Node)(nil),                                // 74: memos.api.v1.Node
+	(*Attachment)(nil),                          // 75: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),               // 76: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 77: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	32, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	33, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	32, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	32, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	32, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	34, // 5: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
+	72, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	73, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	72, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	72, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	72, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	74, // 5: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
 	0,  // 6: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	35, // 7: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	16, // 8: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 9: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	30, // 10: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 11: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 12: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	33, // 13: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 14: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	36, // 15: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
-	3,  // 16: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	36, // 17: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 18: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	35, // 19: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	31, // 20: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	31, // 21: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 22: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	16, // 23: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	16, // 24: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 25: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 26: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 27: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 28: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	28, // 29: memos.api.v1.SuggestMemoTagsResponse.suggested_tags:type_name -> memos.api.v1.TagSuggestion
-	5,  // 30: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 31: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 32: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 33: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	10, // 34: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	11, // 35: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
-	12, // 36: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
-	13, // 37: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	14, // 38: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	17, // 39: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	18, // 40: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	20, // 41: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	21, // 42: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	23, // 43: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	25, // 44: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	26, // 45: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	27, // 46: memos.api.v1.MemoService.SuggestMemoTags:input_type -> memos.api.v1.SuggestMemoTagsRequest
-	3,  // 47: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 48: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 49: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 50: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	37, // 51: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	37, // 52: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
-	37, // 53: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
-	37, // 54: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	15, // 55: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	37, // 56: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	19, // 57: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 58: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	22, // 59: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	24, // 60: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 61: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	37, // 62: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	29, // 63: memos.api.v1.MemoService.SuggestMemoTags:output_type -> memos.api.v1.SuggestMemoTagsResponse
-	47, // [47:64] is the sub-list for method output_type
-	30, // [30:47] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	75, // 7: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	31, // 8: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	6,  // 9: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	66, // 10: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	8,  // 11: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	72, // 12: memos.api.v1.Memo.trash_time:type_name -> google.protobuf.Timestamp
+	72, // 13: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	7,  // 14: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	73, // 15: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	7,  // 16: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	76, // 17: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
+	7,  // 18: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	76, // 19: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 20: memos.api.v1.ListTrashedMemosResponse.memos:type_name -> memos.api.v1.Memo
+	7,  // 21: memos.api.v1.ListScheduledMemosResponse.memos:type_name -> memos.api.v1.Memo
+	7,  // 22: memos.api.v1.ListMemoChangesResponse.memos:type_name -> memos.api.v1.Memo
+	31, // 23: memos.api.v1.ListMemoChangesResponse.relations:type_name -> memos.api.v1.MemoRelation
+	6,  // 24: memos.api.v1.ListMemoChangesResponse.reactions:type_name -> memos.api.v1.Reaction
+	75, // 25: memos.api.v1.ListMemoChangesResponse.attachments:type_name -> memos.api.v1.Attachment
+	67, // 26: memos.api.v1.ListMemoChangesResponse.tombstones:type_name -> memos.api.v1.ListMemoChangesResponse.Tombstone
+	27, // 27: memos.api.v1.ListTagsResponse.tags:type_name -> memos.api.v1.TagTreeNode
+	27, // 28: memos.api.v1.TagTreeNode.children:type_name -> memos.api.v1.TagTreeNode
+	75, // 29: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	75, // 30: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	68, // 31: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	68, // 32: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	2,  // 33: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	31, // 34: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	3,  // 35: memos.api.v1.ListMemoRelationsRequest.direction:type_name -> memos.api.v1.ListMemoRelationsRequest.Direction
+	31, // 36: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	69, // 37: memos.api.v1.MemoGraph.nodes:type_name -> memos.api.v1.MemoGraph.Node
+	70, // 38: memos.api.v1.MemoGraph.edges:type_name -> memos.api.v1.MemoGraph.Edge
+	7,  // 39: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	7,  // 40: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	6,  // 41: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	6,  // 42: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	45, // 43: memos.api.v1.SuggestMemoTagsResponse.suggested_tags:type_name -> memos.api.v1.TagSuggestion
+	72, // 44: memos.api.v1.MemoRevision.create_time:type_name -> google.protobuf.Timestamp
+	0,  // 45: memos.api.v1.MemoRevision.visibility:type_name -> memos.api.v1.Visibility
+	71, // 46: memos.api.v1.MemoRevision.diff:type_name -> memos.api.v1.MemoRevision.DiffLine
+	47, // 47: memos.api.v1.ListMemoRevisionsResponse.revisions:type_name -> memos.api.v1.MemoRevision
+	72, // 48: memos.api.v1.Task.due_time:type_name -> google.protobuf.Timestamp
+	52, // 49: memos.api.v1.ListTasksResponse.tasks:type_name -> memos.api.v1.Task
+	72, // 50: memos.api.v1.MemoShare.create_time:type_name -> google.protobuf.Timestamp
+	72, // 51: memos.api.v1.MemoShare.expire_time:type_name -> google.protobuf.Timestamp
+	56, // 52: memos.api.v1.CreateMemoShareRequest.memo_share:type_name -> memos.api.v1.MemoShare
+	56, // 53: memos.api.v1.ListMemoSharesResponse.memo_shares:type_name -> memos.api.v1.MemoShare
+	5,  // 54: memos.api.v1.MemoGrant.role:type_name -> memos.api.v1.MemoGrant.Role
+	72, // 55: memos.api.v1.MemoGrant.create_time:type_name -> google.protobuf.Timestamp
+	61, // 56: memos.api.v1.ListMemoGrantsResponse.memo_grants:type_name -> memos.api.v1.MemoGrant
+	61, // 57: memos.api.v1.UpsertMemoGrantRequest.memo_grant:type_name -> memos.api.v1.MemoGrant
+	1,  // 58: memos.api.v1.ListMemoChangesResponse.Tombstone.type:type_name -> memos.api.v1.ListMemoChangesResponse.Tombstone.Type
+	31, // 59: memos.api.v1.ListMemoChangesResponse.Tombstone.relation:type_name -> memos.api.v1.MemoRelation
+	72, // 60: memos.api.v1.ListMemoChangesResponse.Tombstone.delete_time:type_name -> google.protobuf.Timestamp
+	2,  // 61: memos.api.v1.MemoGraph.Edge.type:type_name -> memos.api.v1.MemoRelation.Type
+	4,  // 62: memos.api.v1.MemoRevision.DiffLine.operation:type_name -> memos.api.v1.MemoRevision.DiffLine.Operation
+	9,  // 63: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	10, // 64: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	12, // 65: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	13, // 66: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	14, // 67: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	15, // 68: memos.api.v1.MemoService.ListTrashedMemos:input_type -> memos.api.v1.ListTrashedMemosRequest
+	17, // 69: memos.api.v1.MemoService.RestoreMemo:input_type -> memos.api.v1.RestoreMemoRequest
+	18, // 70: memos.api.v1.MemoService.EmptyTrash:input_type -> memos.api.v1.EmptyTrashRequest
+	19, // 71: memos.api.v1.MemoService.ListScheduledMemos:input_type -> memos.api.v1.ListScheduledMemosRequest
+	21, // 72: memos.api.v1.MemoService.ListMemoChanges:input_type -> memos.api.v1.ListMemoChangesRequest
+	23, // 73: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	24, // 74: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
+	25, // 75: memos.api.v1.MemoService.ListTags:input_type -> memos.api.v1.ListTagsRequest
+	28, // 76: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	29, // 77: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	32, // 78: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	33, // 79: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	35, // 80: memos.api.v1.MemoService.GetMemoGraph:input_type -> memos.api.v1.GetMemoGraphRequest
+	37, // 81: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	38, // 82: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	40, // 83: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	42, // 84: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	43, // 85: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	44, // 86: memos.api.v1.MemoService.SuggestMemoTags:input_type -> memos.api.v1.SuggestMemoTagsRequest
+	48, // 87: memos.api.v1.MemoService.ListMemoRevisions:input_type -> memos.api.v1.ListMemoRevisionsRequest
+	50, // 88: memos.api.v1.MemoService.GetMemoRevision:input_type -> memos.api.v1.GetMemoRevisionRequest
+	51, // 89: memos.api.v1.MemoService.RestoreMemoRevision:input_type -> memos.api.v1.RestoreMemoRevisionRequest
+	57, // 90: memos.api.v1.MemoService.CreateMemoShare:input_type -> memos.api.v1.CreateMemoShareRequest
+	58, // 91: memos.api.v1.MemoService.ListMemoShares:input_type -> memos.api.v1.ListMemoSharesRequest
+	60, // 92: memos.api.v1.MemoService.RevokeMemoShare:input_type -> memos.api.v1.RevokeMemoShareRequest
+	62, // 93: memos.api.v1.MemoService.ListMemoGrants:input_type -> memos.api.v1.ListMemoGrantsRequest
+	64, // 94: memos.api.v1.MemoService.UpsertMemoGrant:input_type -> memos.api.v1.UpsertMemoGrantRequest
+	65, // 95: memos.api.v1.MemoService.DeleteMemoGrant:input_type -> memos.api.v1.DeleteMemoGrantRequest
+	53, // 96: memos.api.v1.MemoService.ListTasks:input_type -> memos.api.v1.ListTasksRequest
+	55, // 97: memos.api.v1.MemoService.ToggleTask:input_type -> memos.api.v1.ToggleTaskRequest
+	7,  // 98: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	11, // 99: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	7,  // 100: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	7,  // 101: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	77, // 102: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	16, // 103: memos.api.v1.MemoService.ListTrashedMemos:output_type -> memos.api.v1.ListTrashedMemosResponse
+	7,  // 104: memos.api.v1.MemoService.RestoreMemo:output_type -> memos.api.v1.Memo
+	77, // 105: memos.api.v1.MemoService.EmptyTrash:output_type -> google.protobuf.Empty
+	20, // 106: memos.api.v1.MemoService.ListScheduledMemos:output_type -> memos.api.v1.ListScheduledMemosResponse
+	22, // 107: memos.api.v1.MemoService.ListMemoChanges:output_type -> memos.api.v1.ListMemoChangesResponse
+	77, // 108: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
+	77, // 109: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
+	26, // 110: memos.api.v1.MemoService.ListTags:output_type -> memos.api.v1.ListTagsResponse
+	77, // 111: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	30, // 112: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	77, // 113: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	34, // 114: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	36, // 115: memos.api.v1.MemoService.GetMemoGraph:output_type -> memos.api.v1.MemoGraph
+	7,  // 116: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	39, // 117: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	41, // 118: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	6,  // 119: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	77, // 120: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	46, // 121: memos.api.v1.MemoService.SuggestMemoTags:output_type -> memos.api.v1.SuggestMemoTagsResponse
+	49, // 122: memos.api.v1.MemoService.ListMemoRevisions:output_type -> memos.api.v1.ListMemoRevisionsResponse
+	47, // 123: memos.api.v1.MemoService.GetMemoRevision:output_type -> memos.api.v1.MemoRevision
+	7,  // 124: memos.api.v1.MemoService.RestoreMemoRevision:output_type -> memos.api.v1.Memo
+	56, // 125: memos.api.v1.MemoService.CreateMemoShare:output_type -> memos.api.v1.MemoShare
+	59, // 126: memos.api.v1.MemoService.ListMemoShares:output_type -> memos.api.v1.ListMemoSharesResponse
+	77, // 127: memos.api.v1.MemoService.RevokeMemoShare:output_type -> google.protobuf.Empty
+	63, // 128: memos.api.v1.MemoService.ListMemoGrants:output_type -> memos.api.v1.ListMemoGrantsResponse
+	61, // 129: memos.api.v1.MemoService.UpsertMemoGrant:output_type -> memos.api.v1.MemoGrant
+	77, // 130: memos.api.v1.MemoService.DeleteMemoGrant:output_type -> google.protobuf.Empty
+	54, // 131: memos.api.v1.MemoService.ListTasks:output_type -> memos.api.v1.ListTasksResponse
+	52, // 132: memos.api.v1.MemoService.ToggleTask:output_type -> memos.api.v1.Task
+	98, // [98:133] is the sub-list for method output_type
+	63, // [63:98] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2495,13 +5341,15 @@ func file_api_v1_memo_service_proto_init() {
 	file_api_v1_common_proto_init()
 	file_api_v1_markdown_service_proto_init()
 	file_api_v1_memo_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[50].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   30,
+			NumEnums:      6,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
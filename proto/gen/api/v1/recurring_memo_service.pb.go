@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: api/v1/recurring_memo_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecurringMemo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the recurring memo.
+	// Format: recurringMemos/{recurring_memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The creator of the recurring memo, who owns the memos it creates.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The state of the recurring memo. Archived recurring memos are paused.
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// Required. The cron schedule, e.g. "0 9 * * 1-5" for every weekday at 9:00.
+	// The schedule uses the server time zone unless prefixed with "CRON_TZ=", e.g. "CRON_TZ=Europe/Paris 0 9 * * 1-5".
+	Schedule string `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// The template to create memos from.
+	// Format: users/{user}/templates/{template} or workspace/templates/{template}
+	Template string `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+	// The content to create memos with when there's no template.
+	// Placeholders like {{date}} are filled in as in templates.
+	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	// The values of the template variables, keyed by variable name.
+	Variables map[string]string `protobuf:"bytes,7,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The visibility of the created memos.
+	// Falls back to the visibility of the template, then to the default visibility of the user.
+	Visibility Visibility `protobuf:"varint,8,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. The scheduled time of the last run.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// Output only. The scheduled time of the next run, unset while paused.
+	NextRunTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	// Output only. The number of runs that didn't create a memo, e.g. while the server was down.
+	MissedRuns    int32 `protobuf:"varint,13,opt,name=missed_runs,json=missedRuns,proto3" json:"missed_runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringMemo) Reset() {
+	*x = RecurringMemo{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringMemo) ProtoMessage() {}
+
+func (x *RecurringMemo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringMemo.ProtoReflect.Descriptor instead.
+func (*RecurringMemo) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecurringMemo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringMemo) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *RecurringMemo) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *RecurringMemo) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *RecurringMemo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *RecurringMemo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RecurringMemo) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *RecurringMemo) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *RecurringMemo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RecurringMemo) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *RecurringMemo) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *RecurringMemo) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *RecurringMemo) GetMissedRuns() int32 {
+	if x != nil {
+		return x.MissedRuns
+	}
+	return 0
+}
+
+type ListRecurringMemosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringMemosRequest) Reset() {
+	*x = ListRecurringMemosRequest{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringMemosRequest) ProtoMessage() {}
+
+func (x *ListRecurringMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListRecurringMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of recurring memos.
+	RecurringMemos []*RecurringMemo `protobuf:"bytes,1,rep,name=recurring_memos,json=recurringMemos,proto3" json:"recurring_memos,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRecurringMemosResponse) Reset() {
+	*x = ListRecurringMemosResponse{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringMemosResponse) ProtoMessage() {}
+
+func (x *ListRecurringMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecurringMemosResponse) GetRecurringMemos() []*RecurringMemo {
+	if x != nil {
+		return x.RecurringMemos
+	}
+	return nil
+}
+
+type GetRecurringMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the recurring memo.
+	// Format: recurringMemos/{recurring_memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecurringMemoRequest) Reset() {
+	*x = GetRecurringMemoRequest{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringMemoRequest) ProtoMessage() {}
+
+func (x *GetRecurringMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecurringMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetRecurringMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecurringMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateRecurringMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The recurring memo to create.
+	RecurringMemo *RecurringMemo `protobuf:"bytes,1,opt,name=recurring_memo,json=recurringMemo,proto3" json:"recurring_memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringMemoRequest) Reset() {
+	*x = CreateRecurringMemoRequest{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringMemoRequest) ProtoMessage() {}
+
+func (x *CreateRecurringMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringMemoRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRecurringMemoRequest) GetRecurringMemo() *RecurringMemo {
+	if x != nil {
+		return x.RecurringMemo
+	}
+	return nil
+}
+
+type UpdateRecurringMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The recurring memo to update.
+	RecurringMemo *RecurringMemo `protobuf:"bytes,1,opt,name=recurring_memo,json=recurringMemo,proto3" json:"recurring_memo,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecurringMemoRequest) Reset() {
+	*x = UpdateRecurringMemoRequest{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecurringMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringMemoRequest) ProtoMessage() {}
+
+func (x *UpdateRecurringMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRecurringMemoRequest) GetRecurringMemo() *RecurringMemo {
+	if x != nil {
+		return x.RecurringMemo
+	}
+	return nil
+}
+
+func (x *UpdateRecurringMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteRecurringMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the recurring memo.
+	// Format: recurringMemos/{recurring_memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringMemoRequest) Reset() {
+	*x = DeleteRecurringMemoRequest{}
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringMemoRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_recurring_memo_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_recurring_memo_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecurringMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_recurring_memo_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_recurring_memo_service_proto_rawDesc = "" +
+	"\n" +
+	"#api/v1/recurring_memo_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x06\n" +
+	"\rRecurringMemo\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x12.\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1f\n" +
+	"\bschedule\x18\x04 \x01(\tB\x03\xe0A\x02R\bschedule\x12\x1f\n" +
+	"\btemplate\x18\x05 \x01(\tB\x03\xe0A\x01R\btemplate\x12\x1d\n" +
+	"\acontent\x18\x06 \x01(\tB\x03\xe0A\x01R\acontent\x12M\n" +
+	"\tvariables\x18\a \x03(\v2*.memos.api.v1.RecurringMemo.VariablesEntryB\x03\xe0A\x01R\tvariables\x12=\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\x12@\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12C\n" +
+	"\rlast_run_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12C\n" +
+	"\rnext_run_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime\x12$\n" +
+	"\vmissed_runs\x18\r \x01(\x05B\x03\xe0A\x03R\n" +
+	"missedRuns\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:e\xeaAb\n" +
+	"\x1amemos.api.v1/RecurringMemo\x12\x1frecurringMemos/{recurring_memo}\x1a\x04name*\x0erecurringMemos2\rrecurringMemo\"\x1b\n" +
+	"\x19ListRecurringMemosRequest\"b\n" +
+	"\x1aListRecurringMemosResponse\x12D\n" +
+	"\x0frecurring_memos\x18\x01 \x03(\v2\x1b.memos.api.v1.RecurringMemoR\x0erecurringMemos\"Q\n" +
+	"\x17GetRecurringMemoRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/RecurringMemoR\x04name\"e\n" +
+	"\x1aCreateRecurringMemoRequest\x12G\n" +
+	"\x0erecurring_memo\x18\x01 \x01(\v2\x1b.memos.api.v1.RecurringMemoB\x03\xe0A\x02R\rrecurringMemo\"\xa7\x01\n" +
+	"\x1aUpdateRecurringMemoRequest\x12G\n" +
+	"\x0erecurring_memo\x18\x01 \x01(\v2\x1b.memos.api.v1.RecurringMemoB\x03\xe0A\x02R\rrecurringMemo\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"T\n" +
+	"\x1aDeleteRecurringMemoRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/RecurringMemoR\x04name2\x97\x06\n" +
+	"\x14RecurringMemoService\x12\x87\x01\n" +
+	"\x12ListRecurringMemos\x12'.memos.api.v1.ListRecurringMemosRequest\x1a(.memos.api.v1.ListRecurringMemosResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/recurringMemos\x12\x86\x01\n" +
+	"\x10GetRecurringMemo\x12%.memos.api.v1.GetRecurringMemoRequest\x1a\x1b.memos.api.v1.RecurringMemo\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=recurringMemos/*}\x12\x9d\x01\n" +
+	"\x13CreateRecurringMemo\x12(.memos.api.v1.CreateRecurringMemoRequest\x1a\x1b.memos.api.v1.RecurringMemo\"?\xdaA\x0erecurring_memo\x82\xd3\xe4\x93\x02(:\x0erecurring_memo\"\x16/api/v1/recurringMemos\x12\xc1\x01\n" +
+	"\x13UpdateRecurringMemo\x12(.memos.api.v1.UpdateRecurringMemoRequest\x1a\x1b.memos.api.v1.RecurringMemo\"c\xdaA\x1arecurring_memo,update_mask\x82\xd3\xe4\x93\x02@:\x0erecurring_memo2./api/v1/{recurring_memo.name=recurringMemos/*}\x12\x87\x01\n" +
+	"\x13DeleteRecurringMemo\x12(.memos.api.v1.DeleteRecurringMemoRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=recurringMemos/*}B\xb1\x01\n" +
+	"\x10com.memos.api.v1B\x19RecurringMemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_recurring_memo_service_proto_rawDescOnce sync.Once
+	file_api_v1_recurring_memo_service_proto_rawDescData []byte
+)
+
+func file_api_v1_recurring_memo_service_proto_rawDescGZIP() []byte {
+	file_api_v1_recurring_memo_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_recurring_memo_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_recurring_memo_service_proto_rawDesc), len(file_api_v1_recurring_memo_service_proto_rawDesc)))
+	})
+	return file_api_v1_recurring_memo_service_proto_rawDescData
+}
+
+var file_api_v1_recurring_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_recurring_memo_service_proto_goTypes = []any{
+	(*RecurringMemo)(nil),              // 0: memos.api.v1.RecurringMemo
+	(*ListRecurringMemosRequest)(nil),  // 1: memos.api.v1.ListRecurringMemosRequest
+	(*ListRecurringMemosResponse)(nil), // 2: memos.api.v1.ListRecurringMemosResponse
+	(*GetRecurringMemoRequest)(nil),    // 3: memos.api.v1.GetRecurringMemoRequest
+	(*CreateRecurringMemoRequest)(nil), // 4: memos.api.v1.CreateRecurringMemoRequest
+	(*UpdateRecurringMemoRequest)(nil), // 5: memos.api.v1.UpdateRecurringMemoRequest
+	(*DeleteRecurringMemoRequest)(nil), // 6: memos.api.v1.DeleteRecurringMemoRequest
+	nil,                                // 7: memos.api.v1.RecurringMemo.VariablesEntry
+	(State)(0),                         // 8: memos.api.v1.State
+	(Visibility)(0),                    // 9: memos.api.v1.Visibility
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 11: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),              // 12: google.protobuf.Empty
+}
+var file_api_v1_recurring_memo_service_proto_depIdxs = []int32{
+	8,  // 0: memos.api.v1.RecurringMemo.state:type_name -> memos.api.v1.State
+	7,  // 1: memos.api.v1.RecurringMemo.variables:type_name -> memos.api.v1.RecurringMemo.VariablesEntry
+	9,  // 2: memos.api.v1.RecurringMemo.visibility:type_name -> memos.api.v1.Visibility
+	10, // 3: memos.api.v1.RecurringMemo.create_time:type_name -> google.protobuf.Timestamp
+	10, // 4: memos.api.v1.RecurringMemo.update_time:type_name -> google.protobuf.Timestamp
+	10, // 5: memos.api.v1.RecurringMemo.last_run_time:type_name -> google.protobuf.Timestamp
+	10, // 6: memos.api.v1.RecurringMemo.next_run_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: memos.api.v1.ListRecurringMemosResponse.recurring_memos:type_name -> memos.api.v1.RecurringMemo
+	0,  // 8: memos.api.v1.CreateRecurringMemoRequest.recurring_memo:type_name -> memos.api.v1.RecurringMemo
+	0,  // 9: memos.api.v1.UpdateRecurringMemoRequest.recurring_memo:type_name -> memos.api.v1.RecurringMemo
+	11, // 10: memos.api.v1.UpdateRecurringMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 11: memos.api.v1.RecurringMemoService.ListRecurringMemos:input_type -> memos.api.v1.ListRecurringMemosRequest
+	3,  // 12: memos.api.v1.RecurringMemoService.GetRecurringMemo:input_type -> memos.api.v1.GetRecurringMemoRequest
+	4,  // 13: memos.api.v1.RecurringMemoService.CreateRecurringMemo:input_type -> memos.api.v1.CreateRecurringMemoRequest
+	5,  // 14: memos.api.v1.RecurringMemoService.UpdateRecurringMemo:input_type -> memos.api.v1.UpdateRecurringMemoRequest
+	6,  // 15: memos.api.v1.RecurringMemoService.DeleteRecurringMemo:input_type -> memos.api.v1.DeleteRecurringMemoRequest
+	2,  // 16: memos.api.v1.RecurringMemoService.ListRecurringMemos:output_type -> memos.api.v1.ListRecurringMemosResponse
+	0,  // 17: memos.api.v1.RecurringMemoService.GetRecurringMemo:output_type -> memos.api.v1.RecurringMemo
+	0,  // 18: memos.api.v1.RecurringMemoService.CreateRecurringMemo:output_type -> memos.api.v1.RecurringMemo
+	0,  // 19: memos.api.v1.RecurringMemoService.UpdateRecurringMemo:output_type -> memos.api.v1.RecurringMemo
+	12, // 20: memos.api.v1.RecurringMemoService.DeleteRecurringMemo:output_type -> google.protobuf.Empty
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_recurring_memo_service_proto_init() }
+func file_api_v1_recurring_memo_service_proto_init() {
+	if File_api_v1_recurring_memo_service_proto != nil {
+		return
+	}
+	file_api_v1_common_proto_init()
+	file_api_v1_memo_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_recurring_memo_service_proto_rawDesc), len(file_api_v1_recurring_memo_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_recurring_memo_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_recurring_memo_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_recurring_memo_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_recurring_memo_service_proto = out.File
+	file_api_v1_recurring_memo_service_proto_goTypes = nil
+	file_api_v1_recurring_memo_service_proto_depIdxs = nil
+}
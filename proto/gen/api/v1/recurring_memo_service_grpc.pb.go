@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/recurring_memo_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecurringMemoService_ListRecurringMemos_FullMethodName  = "/memos.api.v1.RecurringMemoService/ListRecurringMemos"
+	RecurringMemoService_GetRecurringMemo_FullMethodName    = "/memos.api.v1.RecurringMemoService/GetRecurringMemo"
+	RecurringMemoService_CreateRecurringMemo_FullMethodName = "/memos.api.v1.RecurringMemoService/CreateRecurringMemo"
+	RecurringMemoService_UpdateRecurringMemo_FullMethodName = "/memos.api.v1.RecurringMemoService/UpdateRecurringMemo"
+	RecurringMemoService_DeleteRecurringMemo_FullMethodName = "/memos.api.v1.RecurringMemoService/DeleteRecurringMemo"
+)
+
+// RecurringMemoServiceClient is the client API for RecurringMemoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringMemoServiceClient interface {
+	// ListRecurringMemos lists the recurring memos of the current user.
+	ListRecurringMemos(ctx context.Context, in *ListRecurringMemosRequest, opts ...grpc.CallOption) (*ListRecurringMemosResponse, error)
+	// GetRecurringMemo gets a recurring memo.
+	GetRecurringMemo(ctx context.Context, in *GetRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// CreateRecurringMemo creates a recurring memo for the current user.
+	CreateRecurringMemo(ctx context.Context, in *CreateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// UpdateRecurringMemo updates a recurring memo.
+	UpdateRecurringMemo(ctx context.Context, in *UpdateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// DeleteRecurringMemo deletes a recurring memo. Memos it created are kept.
+	DeleteRecurringMemo(ctx context.Context, in *DeleteRecurringMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type recurringMemoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringMemoServiceClient(cc grpc.ClientConnInterface) RecurringMemoServiceClient {
+	return &recurringMemoServiceClient{cc}
+}
+
+func (c *recurringMemoServiceClient) ListRecurringMemos(ctx context.Context, in *ListRecurringMemosRequest, opts ...grpc.CallOption) (*ListRecurringMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringMemosResponse)
+	err := c.cc.Invoke(ctx, RecurringMemoService_ListRecurringMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) GetRecurringMemo(ctx context.Context, in *GetRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_GetRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) CreateRecurringMemo(ctx context.Context, in *CreateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_CreateRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) UpdateRecurringMemo(ctx context.Context, in *UpdateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_UpdateRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) DeleteRecurringMemo(ctx context.Context, in *DeleteRecurringMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecurringMemoService_DeleteRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringMemoServiceServer is the server API for RecurringMemoService service.
+// All implementations must embed UnimplementedRecurringMemoServiceServer
+// for forward compatibility.
+type RecurringMemoServiceServer interface {
+	// ListRecurringMemos lists the recurring memos of the current user.
+	ListRecurringMemos(context.Context, *ListRecurringMemosRequest) (*ListRecurringMemosResponse, error)
+	// GetRecurringMemo gets a recurring memo.
+	GetRecurringMemo(context.Context, *GetRecurringMemoRequest) (*RecurringMemo, error)
+	// CreateRecurringMemo creates a recurring memo for the current user.
+	CreateRecurringMemo(context.Context, *CreateRecurringMemoRequest) (*RecurringMemo, error)
+	// UpdateRecurringMemo updates a recurring memo.
+	UpdateRecurringMemo(context.Context, *UpdateRecurringMemoRequest) (*RecurringMemo, error)
+	// DeleteRecurringMemo deletes a recurring memo. Memos it created are kept.
+	DeleteRecurringMemo(context.Context, *DeleteRecurringMemoRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRecurringMemoServiceServer()
+}
+
+// UnimplementedRecurringMemoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecurringMemoServiceServer struct{}
+
+func (UnimplementedRecurringMemoServiceServer) ListRecurringMemos(context.Context, *ListRecurringMemosRequest) (*ListRecurringMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringMemos not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) GetRecurringMemo(context.Context, *GetRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) CreateRecurringMemo(context.Context, *CreateRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) UpdateRecurringMemo(context.Context, *UpdateRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) DeleteRecurringMemo(context.Context, *DeleteRecurringMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) mustEmbedUnimplementedRecurringMemoServiceServer() {}
+func (UnimplementedRecurringMemoServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeRecurringMemoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringMemoServiceServer will
+// result in compilation errors.
+type UnsafeRecurringMemoServiceServer interface {
+	mustEmbedUnimplementedRecurringMemoServiceServer()
+}
+
+func RegisterRecurringMemoServiceServer(s grpc.ServiceRegistrar, srv RecurringMemoServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecurringMemoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecurringMemoService_ServiceDesc, srv)
+}
+
+func _RecurringMemoService_ListRecurringMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).ListRecurringMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_ListRecurringMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).ListRecurringMemos(ctx, req.(*ListRecurringMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_GetRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).GetRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_GetRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).GetRecurringMemo(ctx, req.(*GetRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_CreateRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).CreateRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_CreateRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).CreateRecurringMemo(ctx, req.(*CreateRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_UpdateRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).UpdateRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_UpdateRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).UpdateRecurringMemo(ctx, req.(*UpdateRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_DeleteRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).DeleteRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_DeleteRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).DeleteRecurringMemo(ctx, req.(*DeleteRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringMemoService_ServiceDesc is the grpc.ServiceDesc for RecurringMemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecurringMemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.RecurringMemoService",
+	HandlerType: (*RecurringMemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecurringMemos",
+			Handler:    _RecurringMemoService_ListRecurringMemos_Handler,
+		},
+		{
+			MethodName: "GetRecurringMemo",
+			Handler:    _RecurringMemoService_GetRecurringMemo_Handler,
+		},
+		{
+			MethodName: "CreateRecurringMemo",
+			Handler:    _RecurringMemoService_CreateRecurringMemo_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringMemo",
+			Handler:    _RecurringMemoService_UpdateRecurringMemo_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringMemo",
+			Handler:    _RecurringMemoService_DeleteRecurringMemo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/recurring_memo_service.proto",
+}
@@ -36,6 +36,10 @@ const (
 	WorkspaceSetting_MEMO_RELATED WorkspaceSetting_Key = 3
 	// AI is the key for AI settings.
 	WorkspaceSetting_AI WorkspaceSetting_Key = 4
+	// SMTP is the key for the SMTP server settings used to send email.
+	WorkspaceSetting_SMTP WorkspaceSetting_Key = 5
+	// EMAIL_INGEST is the key for the settings of creating memos by email.
+	WorkspaceSetting_EMAIL_INGEST WorkspaceSetting_Key = 6
 )
 
 // Enum value maps for WorkspaceSetting_Key.
@@ -46,6 +50,8 @@ var (
 		2: "STORAGE",
 		3: "MEMO_RELATED",
 		4: "AI",
+		5: "SMTP",
+		6: "EMAIL_INGEST",
 	}
 	WorkspaceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
@@ -53,6 +59,8 @@ var (
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
 		"AI":              4,
+		"SMTP":            5,
+		"EMAIL_INGEST":    6,
 	}
 )
 
@@ -139,6 +147,59 @@ func (WorkspaceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []i
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
+// Connection security.
+type WorkspaceSetting_SmtpSetting_Security int32
+
+const (
+	// STARTTLS is required, sending fails if the server doesn't support it.
+	WorkspaceSetting_SmtpSetting_SECURITY_UNSPECIFIED WorkspaceSetting_SmtpSetting_Security = 0
+	// Implicit TLS, usually on port 465.
+	WorkspaceSetting_SmtpSetting_TLS WorkspaceSetting_SmtpSetting_Security = 1
+	// The connection is never encrypted, credentials and emails are sent in cleartext.
+	WorkspaceSetting_SmtpSetting_NONE WorkspaceSetting_SmtpSetting_Security = 2
+)
+
+// Enum value maps for WorkspaceSetting_SmtpSetting_Security.
+var (
+	WorkspaceSetting_SmtpSetting_Security_name = map[int32]string{
+		0: "SECURITY_UNSPECIFIED",
+		1: "TLS",
+		2: "NONE",
+	}
+	WorkspaceSetting_SmtpSetting_Security_value = map[string]int32{
+		"SECURITY_UNSPECIFIED": 0,
+		"TLS":                  1,
+		"NONE":                 2,
+	}
+)
+
+func (x WorkspaceSetting_SmtpSetting_Security) Enum() *WorkspaceSetting_SmtpSetting_Security {
+	p := new(WorkspaceSetting_SmtpSetting_Security)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_SmtpSetting_Security) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_SmtpSetting_Security) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceSetting_SmtpSetting_Security) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[2]
+}
+
+func (x WorkspaceSetting_SmtpSetting_Security) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_SmtpSetting_Security.Descriptor instead.
+func (WorkspaceSetting_SmtpSetting_Security) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 4, 0}
+}
+
 // Workspace profile message containing basic workspace information.
 type WorkspaceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -262,6 +323,8 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_StorageSetting_
 	//	*WorkspaceSetting_MemoRelatedSetting_
 	//	*WorkspaceSetting_AiSetting_
+	//	*WorkspaceSetting_SmtpSetting_
+	//	*WorkspaceSetting_EmailIngestSetting_
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -347,6 +410,24 @@ func (x *WorkspaceSetting) GetAiSetting() *WorkspaceSetting_AiSetting {
 	return nil
 }
 
+func (x *WorkspaceSetting) GetSmtpSetting() *WorkspaceSetting_SmtpSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SmtpSetting_); ok {
+			return x.SmtpSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmailIngestSetting() *WorkspaceSetting_EmailIngestSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmailIngestSetting_); ok {
+			return x.EmailIngestSetting
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -367,6 +448,14 @@ type WorkspaceSetting_AiSetting_ struct {
 	AiSetting *WorkspaceSetting_AiSetting `protobuf:"bytes,5,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
 }
 
+type WorkspaceSetting_SmtpSetting_ struct {
+	SmtpSetting *WorkspaceSetting_SmtpSetting `protobuf:"bytes,6,opt,name=smtp_setting,json=smtpSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmailIngestSetting_ struct {
+	EmailIngestSetting *WorkspaceSetting_EmailIngestSetting `protobuf:"bytes,7,opt,name=email_ingest_setting,json=emailIngestSetting,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_GeneralSetting_) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_StorageSetting_) isWorkspaceSetting_Value() {}
@@ -375,6 +464,10 @@ func (*WorkspaceSetting_MemoRelatedSetting_) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AiSetting_) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_SmtpSetting_) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmailIngestSetting_) isWorkspaceSetting_Value() {}
+
 // Request message for GetWorkspaceSetting method.
 type GetWorkspaceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -912,7 +1005,12 @@ type WorkspaceSetting_MemoRelatedSetting struct {
 	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
 	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
 	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is the number of days memos stay in the trash before being permanently deleted.
+	// Default is 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,11,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// relation_types is the list of custom memo relation types.
+	RelationTypes []*WorkspaceSetting_MemoRelatedSetting_RelationType `protobuf:"bytes,12,rep,name=relation_types,json=relationTypes,proto3" json:"relation_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1010,6 +1108,20 @@ func (x *WorkspaceSetting_MemoRelatedSetting) GetNsfwTags() []string {
 	return nil
 }
 
+func (x *WorkspaceSetting_MemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting) GetRelationTypes() []*WorkspaceSetting_MemoRelatedSetting_RelationType {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
 // AI configuration settings for workspace AI features.
 type WorkspaceSetting_AiSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1101,6 +1213,170 @@ func (x *WorkspaceSetting_AiSetting) GetTagRecommendation() *WorkspaceSetting_Ta
 	return nil
 }
 
+// SMTP server settings used to send notifications, password resets and invitations by email.
+type WorkspaceSetting_SmtpSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enable_email enables sending email.
+	EnableEmail bool `protobuf:"varint,1,opt,name=enable_email,json=enableEmail,proto3" json:"enable_email,omitempty"`
+	// host is the host of the SMTP server.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// port is the port of the SMTP server.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// username is the username to authenticate with, no authentication if empty.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password to authenticate with.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// security is how the connection to the SMTP server is secured.
+	Security WorkspaceSetting_SmtpSetting_Security `protobuf:"varint,6,opt,name=security,proto3,enum=memos.api.v1.WorkspaceSetting_SmtpSetting_Security" json:"security,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,7,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName      string `protobuf:"bytes,8,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_SmtpSetting) Reset() {
+	*x = WorkspaceSetting_SmtpSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_SmtpSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_SmtpSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_SmtpSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_SmtpSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_SmtpSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetEnableEmail() bool {
+	if x != nil {
+		return x.EnableEmail
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetSecurity() WorkspaceSetting_SmtpSetting_Security {
+	if x != nil {
+		return x.Security
+	}
+	return WorkspaceSetting_SmtpSetting_SECURITY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_SmtpSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+// Settings of creating memos by email, when the SMTP listener is enabled.
+type WorkspaceSetting_EmailIngestSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// domain is the domain of the ingest addresses, the host of the instance URL if empty.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// max_message_size_mb is the max size of received emails in megabytes, attachments included.
+	MaxMessageSizeMb int64 `protobuf:"varint,2,opt,name=max_message_size_mb,json=maxMessageSizeMb,proto3" json:"max_message_size_mb,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_EmailIngestSetting) Reset() {
+	*x = WorkspaceSetting_EmailIngestSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_EmailIngestSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_EmailIngestSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_EmailIngestSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_EmailIngestSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_EmailIngestSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *WorkspaceSetting_EmailIngestSetting) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_EmailIngestSetting) GetMaxMessageSizeMb() int64 {
+	if x != nil {
+		return x.MaxMessageSizeMb
+	}
+	return 0
+}
+
 // Tag recommendation configuration.
 type WorkspaceSetting_TagRecommendationConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1116,7 +1392,7 @@ type WorkspaceSetting_TagRecommendationConfig struct {
 
 func (x *WorkspaceSetting_TagRecommendationConfig) Reset() {
 	*x = WorkspaceSetting_TagRecommendationConfig{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1404,7 @@ func (x *WorkspaceSetting_TagRecommendationConfig) String() string {
 func (*WorkspaceSetting_TagRecommendationConfig) ProtoMessage() {}
 
 func (x *WorkspaceSetting_TagRecommendationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1417,7 @@ func (x *WorkspaceSetting_TagRecommendationConfig) ProtoReflect() protoreflect.M
 
 // Deprecated: Use WorkspaceSetting_TagRecommendationConfig.ProtoReflect.Descriptor instead.
 func (*WorkspaceSetting_TagRecommendationConfig) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 4}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 6}
 }
 
 func (x *WorkspaceSetting_TagRecommendationConfig) GetEnabled() bool {
@@ -1178,7 +1454,7 @@ type WorkspaceSetting_GeneralSetting_CustomProfile struct {
 
 func (x *WorkspaceSetting_GeneralSetting_CustomProfile) Reset() {
 	*x = WorkspaceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1466,7 @@ func (x *WorkspaceSetting_GeneralSetting_CustomProfile) String() string {
 func (*WorkspaceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
 
 func (x *WorkspaceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1526,7 @@ type WorkspaceSetting_StorageSetting_S3Config struct {
 
 func (x *WorkspaceSetting_StorageSetting_S3Config) Reset() {
 	*x = WorkspaceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1262,7 +1538,7 @@ func (x *WorkspaceSetting_StorageSetting_S3Config) String() string {
 func (*WorkspaceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
 func (x *WorkspaceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,6 +1596,61 @@ func (x *WorkspaceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
 	return false
 }
 
+type WorkspaceSetting_MemoRelatedSetting_RelationType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the relation type seen from the memo, e.g. "blocks".
+	// Lowercase letters, digits and dashes only.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// inverse_label is the label of the relation seen from the related memo, e.g. "blocked by".
+	InverseLabel  string `protobuf:"bytes,2,opt,name=inverse_label,json=inverseLabel,proto3" json:"inverse_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_RelationType) Reset() {
+	*x = WorkspaceSetting_MemoRelatedSetting_RelationType{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_RelationType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_MemoRelatedSetting_RelationType) ProtoMessage() {}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_RelationType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_MemoRelatedSetting_RelationType.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_MemoRelatedSetting_RelationType) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 2, 0}
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_RelationType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_MemoRelatedSetting_RelationType) GetInverseLabel() string {
+	if x != nil {
+		return x.InverseLabel
+	}
+	return ""
+}
+
 var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_service_proto_rawDesc = "" +
@@ -1330,14 +1661,16 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12!\n" +
 	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\"\x1c\n" +
-	"\x1aGetWorkspaceProfileRequest\"\xfa\x14\n" +
+	"\x1aGetWorkspaceProfileRequest\"\xe6\x1b\n" +
 	"\x10WorkspaceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12X\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2-.memos.api.v1.WorkspaceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12X\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2-.memos.api.v1.WorkspaceSetting.StorageSettingH\x00R\x0estorageSetting\x12e\n" +
 	"\x14memo_related_setting\x18\x04 \x01(\v21.memos.api.v1.WorkspaceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12I\n" +
 	"\n" +
-	"ai_setting\x18\x05 \x01(\v2(.memos.api.v1.WorkspaceSetting.AiSettingH\x00R\taiSetting\x1a\xf9\x04\n" +
+	"ai_setting\x18\x05 \x01(\v2(.memos.api.v1.WorkspaceSetting.AiSettingH\x00R\taiSetting\x12O\n" +
+	"\fsmtp_setting\x18\x06 \x01(\v2*.memos.api.v1.WorkspaceSetting.SmtpSettingH\x00R\vsmtpSetting\x12e\n" +
+	"\x14email_ingest_setting\x18\a \x01(\v21.memos.api.v1.WorkspaceSetting.EmailIngestSettingH\x00R\x12emailIngestSetting\x1a\xf9\x04\n" +
 	"\x0eGeneralSetting\x12\x14\n" +
 	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
@@ -1369,7 +1702,7 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\x1a\xd8\x03\n" +
+	"\x02S3\x10\x03\x1a\xba\x05\n" +
 	"\x12MemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -1380,24 +1713,48 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\x1a\x82\x02\n" +
+	" \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\v \x01(\x05R\x12trashRetentionDays\x12e\n" +
+	"\x0erelation_types\x18\f \x03(\v2>.memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RelationTypeR\rrelationTypes\x1aG\n" +
+	"\fRelationType\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rinverse_label\x18\x02 \x01(\tR\finverseLabel\x1a\x82\x02\n" +
 	"\tAiSetting\x12\x1b\n" +
 	"\tenable_ai\x18\x01 \x01(\bR\benableAi\x12\x19\n" +
 	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x17\n" +
 	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x14\n" +
 	"\x05model\x18\x04 \x01(\tR\x05model\x12'\n" +
 	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\x12e\n" +
-	"\x12tag_recommendation\x18\x06 \x01(\v26.memos.api.v1.WorkspaceSetting.TagRecommendationConfigR\x11tagRecommendation\x1a\x88\x01\n" +
+	"\x12tag_recommendation\x18\x06 \x01(\v26.memos.api.v1.WorkspaceSetting.TagRecommendationConfigR\x11tagRecommendation\x1a\xd6\x02\n" +
+	"\vSmtpSetting\x12!\n" +
+	"\fenable_email\x18\x01 \x01(\bR\venableEmail\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12O\n" +
+	"\bsecurity\x18\x06 \x01(\x0e23.memos.api.v1.WorkspaceSetting.SmtpSetting.SecurityR\bsecurity\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\b \x01(\tR\bfromName\"7\n" +
+	"\bSecurity\x12\x18\n" +
+	"\x14SECURITY_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03TLS\x10\x01\x12\b\n" +
+	"\x04NONE\x10\x02\x1a[\n" +
+	"\x12EmailIngestSetting\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12-\n" +
+	"\x13max_message_size_mb\x18\x02 \x01(\x03R\x10maxMessageSizeMb\x1a\x88\x01\n" +
 	"\x17TagRecommendationConfig\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
 	"\rsystem_prompt\x18\x02 \x01(\tR\fsystemPrompt\x12.\n" +
-	"\x13requests_per_minute\x18\x03 \x01(\x05R\x11requestsPerMinute\"N\n" +
+	"\x13requests_per_minute\x18\x03 \x01(\x05R\x11requestsPerMinute\"j\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x03\x12\x06\n" +
-	"\x02AI\x10\x04:f\xeaAc\n" +
+	"\x02AI\x10\x04\x12\b\n" +
+	"\x04SMTP\x10\x05\x12\x10\n" +
+	"\fEMAIL_INGEST\x10\x06:f\xeaAc\n" +
 	"\x1eapi.memos.dev/WorkspaceSetting\x12\x1cworkspace/settings/{setting}*\x11workspaceSettings2\x10workspaceSettingB\a\n" +
 	"\x05value\"X\n" +
 	"\x1aGetWorkspaceSettingRequest\x12:\n" +
@@ -1440,55 +1797,63 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_v1_workspace_service_proto_goTypes = []any{
-	(WorkspaceSetting_Key)(0),                             // 0: memos.api.v1.WorkspaceSetting.Key
-	(WorkspaceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
-	(*WorkspaceProfile)(nil),                              // 2: memos.api.v1.WorkspaceProfile
-	(*GetWorkspaceProfileRequest)(nil),                    // 3: memos.api.v1.GetWorkspaceProfileRequest
-	(*WorkspaceSetting)(nil),                              // 4: memos.api.v1.WorkspaceSetting
-	(*GetWorkspaceSettingRequest)(nil),                    // 5: memos.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateWorkspaceSettingRequest
-	(*GetDefaultTagRecommendationPromptRequest)(nil),      // 7: memos.api.v1.GetDefaultTagRecommendationPromptRequest
-	(*GetDefaultTagRecommendationPromptResponse)(nil),     // 8: memos.api.v1.GetDefaultTagRecommendationPromptResponse
-	(*TestAiConnectionRequest)(nil),                       // 9: memos.api.v1.TestAiConnectionRequest
-	(*TestAiConnectionResponse)(nil),                      // 10: memos.api.v1.TestAiConnectionResponse
-	(*WorkspaceSetting_GeneralSetting)(nil),               // 11: memos.api.v1.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_StorageSetting)(nil),               // 12: memos.api.v1.WorkspaceSetting.StorageSetting
-	(*WorkspaceSetting_MemoRelatedSetting)(nil),           // 13: memos.api.v1.WorkspaceSetting.MemoRelatedSetting
-	(*WorkspaceSetting_AiSetting)(nil),                    // 14: memos.api.v1.WorkspaceSetting.AiSetting
-	(*WorkspaceSetting_TagRecommendationConfig)(nil),      // 15: memos.api.v1.WorkspaceSetting.TagRecommendationConfig
-	(*WorkspaceSetting_GeneralSetting_CustomProfile)(nil), // 16: memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
-	(*WorkspaceSetting_StorageSetting_S3Config)(nil),      // 17: memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
-	(*fieldmaskpb.FieldMask)(nil),                         // 18: google.protobuf.FieldMask
+	(WorkspaceSetting_Key)(0),                                // 0: memos.api.v1.WorkspaceSetting.Key
+	(WorkspaceSetting_StorageSetting_StorageType)(0),         // 1: memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
+	(WorkspaceSetting_SmtpSetting_Security)(0),               // 2: memos.api.v1.WorkspaceSetting.SmtpSetting.Security
+	(*WorkspaceProfile)(nil),                                 // 3: memos.api.v1.WorkspaceProfile
+	(*GetWorkspaceProfileRequest)(nil),                       // 4: memos.api.v1.GetWorkspaceProfileRequest
+	(*WorkspaceSetting)(nil),                                 // 5: memos.api.v1.WorkspaceSetting
+	(*GetWorkspaceSettingRequest)(nil),                       // 6: memos.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),                    // 7: memos.api.v1.UpdateWorkspaceSettingRequest
+	(*GetDefaultTagRecommendationPromptRequest)(nil),         // 8: memos.api.v1.GetDefaultTagRecommendationPromptRequest
+	(*GetDefaultTagRecommendationPromptResponse)(nil),        // 9: memos.api.v1.GetDefaultTagRecommendationPromptResponse
+	(*TestAiConnectionRequest)(nil),                          // 10: memos.api.v1.TestAiConnectionRequest
+	(*TestAiConnectionResponse)(nil),                         // 11: memos.api.v1.TestAiConnectionResponse
+	(*WorkspaceSetting_GeneralSetting)(nil),                  // 12: memos.api.v1.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_StorageSetting)(nil),                  // 13: memos.api.v1.WorkspaceSetting.StorageSetting
+	(*WorkspaceSetting_MemoRelatedSetting)(nil),              // 14: memos.api.v1.WorkspaceSetting.MemoRelatedSetting
+	(*WorkspaceSetting_AiSetting)(nil),                       // 15: memos.api.v1.WorkspaceSetting.AiSetting
+	(*WorkspaceSetting_SmtpSetting)(nil),                     // 16: memos.api.v1.WorkspaceSetting.SmtpSetting
+	(*WorkspaceSetting_EmailIngestSetting)(nil),              // 17: memos.api.v1.WorkspaceSetting.EmailIngestSetting
+	(*WorkspaceSetting_TagRecommendationConfig)(nil),         // 18: memos.api.v1.WorkspaceSetting.TagRecommendationConfig
+	(*WorkspaceSetting_GeneralSetting_CustomProfile)(nil),    // 19: memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
+	(*WorkspaceSetting_StorageSetting_S3Config)(nil),         // 20: memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
+	(*WorkspaceSetting_MemoRelatedSetting_RelationType)(nil), // 21: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RelationType
+	(*fieldmaskpb.FieldMask)(nil),                            // 22: google.protobuf.FieldMask
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	11, // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting
-	12, // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting
-	13, // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting
-	14, // 3: memos.api.v1.WorkspaceSetting.ai_setting:type_name -> memos.api.v1.WorkspaceSetting.AiSetting
-	4,  // 4: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
-	18, // 5: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16, // 6: memos.api.v1.WorkspaceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
-	1,  // 7: memos.api.v1.WorkspaceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
-	17, // 8: memos.api.v1.WorkspaceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
-	15, // 9: memos.api.v1.WorkspaceSetting.AiSetting.tag_recommendation:type_name -> memos.api.v1.WorkspaceSetting.TagRecommendationConfig
-	3,  // 10: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
-	5,  // 11: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
-	6,  // 12: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
-	7,  // 13: memos.api.v1.WorkspaceService.GetDefaultTagRecommendationPrompt:input_type -> memos.api.v1.GetDefaultTagRecommendationPromptRequest
-	9,  // 14: memos.api.v1.WorkspaceService.TestAiConnection:input_type -> memos.api.v1.TestAiConnectionRequest
-	2,  // 15: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
-	4,  // 16: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	4,  // 17: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	8,  // 18: memos.api.v1.WorkspaceService.GetDefaultTagRecommendationPrompt:output_type -> memos.api.v1.GetDefaultTagRecommendationPromptResponse
-	10, // 19: memos.api.v1.WorkspaceService.TestAiConnection:output_type -> memos.api.v1.TestAiConnectionResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	12, // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting
+	13, // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting
+	14, // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting
+	15, // 3: memos.api.v1.WorkspaceSetting.ai_setting:type_name -> memos.api.v1.WorkspaceSetting.AiSetting
+	16, // 4: memos.api.v1.WorkspaceSetting.smtp_setting:type_name -> memos.api.v1.WorkspaceSetting.SmtpSetting
+	17, // 5: memos.api.v1.WorkspaceSetting.email_ingest_setting:type_name -> memos.api.v1.WorkspaceSetting.EmailIngestSetting
+	5,  // 6: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
+	22, // 7: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 8: memos.api.v1.WorkspaceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceSetting.GeneralSetting.CustomProfile
+	1,  // 9: memos.api.v1.WorkspaceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.StorageType
+	20, // 10: memos.api.v1.WorkspaceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceSetting.StorageSetting.S3Config
+	21, // 11: memos.api.v1.WorkspaceSetting.MemoRelatedSetting.relation_types:type_name -> memos.api.v1.WorkspaceSetting.MemoRelatedSetting.RelationType
+	18, // 12: memos.api.v1.WorkspaceSetting.AiSetting.tag_recommendation:type_name -> memos.api.v1.WorkspaceSetting.TagRecommendationConfig
+	2,  // 13: memos.api.v1.WorkspaceSetting.SmtpSetting.security:type_name -> memos.api.v1.WorkspaceSetting.SmtpSetting.Security
+	4,  // 14: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
+	6,  // 15: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
+	7,  // 16: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
+	8,  // 17: memos.api.v1.WorkspaceService.GetDefaultTagRecommendationPrompt:input_type -> memos.api.v1.GetDefaultTagRecommendationPromptRequest
+	10, // 18: memos.api.v1.WorkspaceService.TestAiConnection:input_type -> memos.api.v1.TestAiConnectionRequest
+	3,  // 19: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
+	5,  // 20: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	5,  // 21: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	9,  // 22: memos.api.v1.WorkspaceService.GetDefaultTagRecommendationPrompt:output_type -> memos.api.v1.GetDefaultTagRecommendationPromptResponse
+	11, // 23: memos.api.v1.WorkspaceService.TestAiConnection:output_type -> memos.api.v1.TestAiConnectionResponse
+	19, // [19:24] is the sub-list for method output_type
+	14, // [14:19] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -1501,14 +1866,16 @@ func file_api_v1_workspace_service_proto_init() {
 		(*WorkspaceSetting_StorageSetting_)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting_)(nil),
 		(*WorkspaceSetting_AiSetting_)(nil),
+		(*WorkspaceSetting_SmtpSetting_)(nil),
+		(*WorkspaceSetting_EmailIngestSetting_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
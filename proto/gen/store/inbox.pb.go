@@ -27,6 +27,11 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	InboxMessage_MEMO_COMMENT     InboxMessage_Type = 1
 	InboxMessage_VERSION_UPDATE   InboxMessage_Type = 2
+	InboxMessage_MEMO_GRANT       InboxMessage_Type = 3
+	InboxMessage_REMINDER         InboxMessage_Type = 4
+	InboxMessage_MEMO_MENTION     InboxMessage_Type = 5
+	InboxMessage_MEMO_REACTION    InboxMessage_Type = 6
+	InboxMessage_MEMO_REFERENCE   InboxMessage_Type = 7
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -35,11 +40,21 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_GRANT",
+		4: "REMINDER",
+		5: "MEMO_MENTION",
+		6: "MEMO_REACTION",
+		7: "MEMO_REFERENCE",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
 		"VERSION_UPDATE":   2,
+		"MEMO_GRANT":       3,
+		"REMINDER":         4,
+		"MEMO_MENTION":     5,
+		"MEMO_REACTION":    6,
+		"MEMO_REFERENCE":   7,
 	}
 )
 
@@ -71,9 +86,14 @@ func (InboxMessage_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type InboxMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
-	ActivityId    *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	ActivityId *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The users whose activities are aggregated into the message, the latest sender first.
+	// Reactions and references to the same memo are aggregated into one message until it's read.
+	SenderIds []int32 `protobuf:"varint,3,rep,packed,name=sender_ids,json=senderIds,proto3" json:"sender_ids,omitempty"`
+	// The memo the message is about, set for reactions and references so that their messages are found without the activities.
+	MemoId        *int32 `protobuf:"varint,4,opt,name=memo_id,json=memoId,proto3,oneof" json:"memo_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,20 +142,45 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetSenderIds() []int32 {
+	if x != nil {
+		return x.SenderIds
+	}
+	return nil
+}
+
+func (x *InboxMessage) GetMemoId() int32 {
+	if x != nil && x.MemoId != nil {
+		return *x.MemoId
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xbc\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xdd\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\"B\n" +
+	"activityId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"sender_ids\x18\x03 \x03(\x05R\tsenderIds\x12\x1c\n" +
+	"\amemo_id\x18\x04 \x01(\x05H\x01R\x06memoId\x88\x01\x01\"\x99\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
-	"\f_activity_idB\x95\x01\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"MEMO_GRANT\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x12\n" +
+	"\x0eMEMO_REFERENCE\x10\aB\x0e\n" +
+	"\f_activity_idB\n" +
+	"\n" +
+	"\b_memo_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: store/recurring_memo.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecurringMemoPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the template to create memos from,
+	// e.g. "users/1/templates/abc" or "workspace/templates/abc".
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// The content to create memos with when there's no template, with placeholders like {{date}}.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The values of the template variables, keyed by variable name.
+	Variables     map[string]string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringMemoPayload) Reset() {
+	*x = RecurringMemoPayload{}
+	mi := &file_store_recurring_memo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringMemoPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringMemoPayload) ProtoMessage() {}
+
+func (x *RecurringMemoPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_recurring_memo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringMemoPayload.ProtoReflect.Descriptor instead.
+func (*RecurringMemoPayload) Descriptor() ([]byte, []int) {
+	return file_store_recurring_memo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecurringMemoPayload) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *RecurringMemoPayload) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RecurringMemoPayload) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+var File_store_recurring_memo_proto protoreflect.FileDescriptor
+
+const file_store_recurring_memo_proto_rawDesc = "" +
+	"\n" +
+	"\x1astore/recurring_memo.proto\x12\vmemos.store\"\xda\x01\n" +
+	"\x14RecurringMemoPayload\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12N\n" +
+	"\tvariables\x18\x03 \x03(\v20.memos.store.RecurringMemoPayload.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x9d\x01\n" +
+	"\x0fcom.memos.storeB\x12RecurringMemoProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_recurring_memo_proto_rawDescOnce sync.Once
+	file_store_recurring_memo_proto_rawDescData []byte
+)
+
+func file_store_recurring_memo_proto_rawDescGZIP() []byte {
+	file_store_recurring_memo_proto_rawDescOnce.Do(func() {
+		file_store_recurring_memo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_recurring_memo_proto_rawDesc), len(file_store_recurring_memo_proto_rawDesc)))
+	})
+	return file_store_recurring_memo_proto_rawDescData
+}
+
+var file_store_recurring_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_recurring_memo_proto_goTypes = []any{
+	(*RecurringMemoPayload)(nil), // 0: memos.store.RecurringMemoPayload
+	nil,                          // 1: memos.store.RecurringMemoPayload.VariablesEntry
+}
+var file_store_recurring_memo_proto_depIdxs = []int32{
+	1, // 0: memos.store.RecurringMemoPayload.variables:type_name -> memos.store.RecurringMemoPayload.VariablesEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_recurring_memo_proto_init() }
+func file_store_recurring_memo_proto_init() {
+	if File_store_recurring_memo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_recurring_memo_proto_rawDesc), len(file_store_recurring_memo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_recurring_memo_proto_goTypes,
+		DependencyIndexes: file_store_recurring_memo_proto_depIdxs,
+		MessageInfos:      file_store_recurring_memo_proto_msgTypes,
+	}.Build()
+	File_store_recurring_memo_proto = out.File
+	file_store_recurring_memo_proto_goTypes = nil
+	file_store_recurring_memo_proto_depIdxs = nil
+}
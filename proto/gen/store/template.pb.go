@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: store/template.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the template.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title of the template.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The content of the template, with placeholders like {{date}} or {{project}}.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of memos created from the template, e.g. "PRIVATE".
+	// Memos use the default visibility when empty.
+	Visibility    string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoTemplate) Reset() {
+	*x = MemoTemplate{}
+	mi := &file_store_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoTemplate) ProtoMessage() {}
+
+func (x *MemoTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoTemplate.ProtoReflect.Descriptor instead.
+func (*MemoTemplate) Descriptor() ([]byte, []int) {
+	return file_store_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+var File_store_template_proto protoreflect.FileDescriptor
+
+const file_store_template_proto_rawDesc = "" +
+	"\n" +
+	"\x14store/template.proto\x12\vmemos.store\"n\n" +
+	"\fMemoTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibilityB\x98\x01\n" +
+	"\x0fcom.memos.storeB\rTemplateProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
+
+var (
+	file_store_template_proto_rawDescOnce sync.Once
+	file_store_template_proto_rawDescData []byte
+)
+
+func file_store_template_proto_rawDescGZIP() []byte {
+	file_store_template_proto_rawDescOnce.Do(func() {
+		file_store_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_template_proto_rawDesc), len(file_store_template_proto_rawDesc)))
+	})
+	return file_store_template_proto_rawDescData
+}
+
+var file_store_template_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_template_proto_goTypes = []any{
+	(*MemoTemplate)(nil), // 0: memos.store.MemoTemplate
+}
+var file_store_template_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_store_template_proto_init() }
+func file_store_template_proto_init() {
+	if File_store_template_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_template_proto_rawDesc), len(file_store_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_template_proto_goTypes,
+		DependencyIndexes: file_store_template_proto_depIdxs,
+		MessageInfos:      file_store_template_proto_msgTypes,
+	}.Build()
+	File_store_template_proto = out.File
+	file_store_template_proto_goTypes = nil
+	file_store_template_proto_depIdxs = nil
+}
@@ -36,6 +36,14 @@ const (
 	UserSetting_SHORTCUTS UserSetting_Key = 4
 	// The webhooks of the user.
 	UserSetting_WEBHOOKS UserSetting_Key = 5
+	// The tag metadata of the user.
+	UserSetting_TAGS UserSetting_Key = 6
+	// The memo templates of the user.
+	UserSetting_TEMPLATES UserSetting_Key = 7
+	// The notification preferences of the user.
+	UserSetting_NOTIFICATIONS UserSetting_Key = 8
+	// The email ingest settings of the user.
+	UserSetting_EMAIL_INGEST UserSetting_Key = 9
 )
 
 // Enum value maps for UserSetting_Key.
@@ -47,6 +55,10 @@ var (
 		3: "ACCESS_TOKENS",
 		4: "SHORTCUTS",
 		5: "WEBHOOKS",
+		6: "TAGS",
+		7: "TEMPLATES",
+		8: "NOTIFICATIONS",
+		9: "EMAIL_INGEST",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
@@ -55,6 +67,10 @@ var (
 		"ACCESS_TOKENS":   3,
 		"SHORTCUTS":       4,
 		"WEBHOOKS":        5,
+		"TAGS":            6,
+		"TEMPLATES":       7,
+		"NOTIFICATIONS":   8,
+		"EMAIL_INGEST":    9,
 	}
 )
 
@@ -85,6 +101,107 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type NotificationsUserSetting_Channel int32
+
+const (
+	NotificationsUserSetting_CHANNEL_UNSPECIFIED NotificationsUserSetting_Channel = 0
+	NotificationsUserSetting_INBOX               NotificationsUserSetting_Channel = 1
+	NotificationsUserSetting_EMAIL               NotificationsUserSetting_Channel = 2
+	NotificationsUserSetting_PUSH                NotificationsUserSetting_Channel = 3
+)
+
+// Enum value maps for NotificationsUserSetting_Channel.
+var (
+	NotificationsUserSetting_Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "INBOX",
+		2: "EMAIL",
+		3: "PUSH",
+	}
+	NotificationsUserSetting_Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"INBOX":               1,
+		"EMAIL":               2,
+		"PUSH":                3,
+	}
+)
+
+func (x NotificationsUserSetting_Channel) Enum() *NotificationsUserSetting_Channel {
+	p := new(NotificationsUserSetting_Channel)
+	*p = x
+	return p
+}
+
+func (x NotificationsUserSetting_Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationsUserSetting_Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationsUserSetting_Channel) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x NotificationsUserSetting_Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationsUserSetting_Channel.Descriptor instead.
+func (NotificationsUserSetting_Channel) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type NotificationsUserSetting_DigestFrequency int32
+
+const (
+	NotificationsUserSetting_DIGEST_FREQUENCY_UNSPECIFIED NotificationsUserSetting_DigestFrequency = 0
+	NotificationsUserSetting_DAILY                        NotificationsUserSetting_DigestFrequency = 1
+	NotificationsUserSetting_WEEKLY                       NotificationsUserSetting_DigestFrequency = 2
+)
+
+// Enum value maps for NotificationsUserSetting_DigestFrequency.
+var (
+	NotificationsUserSetting_DigestFrequency_name = map[int32]string{
+		0: "DIGEST_FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	NotificationsUserSetting_DigestFrequency_value = map[string]int32{
+		"DIGEST_FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                        1,
+		"WEEKLY":                       2,
+	}
+)
+
+func (x NotificationsUserSetting_DigestFrequency) Enum() *NotificationsUserSetting_DigestFrequency {
+	p := new(NotificationsUserSetting_DigestFrequency)
+	*p = x
+	return p
+}
+
+func (x NotificationsUserSetting_DigestFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationsUserSetting_DigestFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationsUserSetting_DigestFrequency) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[2]
+}
+
+func (x NotificationsUserSetting_DigestFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationsUserSetting_DigestFrequency.Descriptor instead.
+func (NotificationsUserSetting_DigestFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 1}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +213,10 @@ type UserSetting struct {
 	//	*UserSetting_AccessTokens
 	//	*UserSetting_Shortcuts
 	//	*UserSetting_Webhooks
+	//	*UserSetting_Tags
+	//	*UserSetting_Templates
+	//	*UserSetting_Notifications
+	//	*UserSetting_EmailIngest
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +318,42 @@ func (x *UserSetting) GetWebhooks() *WebhooksUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetTags() *TagsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Tags); ok {
+			return x.Tags
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTemplates() *TemplatesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Templates); ok {
+			return x.Templates
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotifications() *NotificationsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Notifications); ok {
+			return x.Notifications
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetEmailIngest() *EmailIngestUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_EmailIngest); ok {
+			return x.EmailIngest
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +378,22 @@ type UserSetting_Webhooks struct {
 	Webhooks *WebhooksUserSetting `protobuf:"bytes,7,opt,name=webhooks,proto3,oneof"`
 }
 
+type UserSetting_Tags struct {
+	Tags *TagsUserSetting `protobuf:"bytes,8,opt,name=tags,proto3,oneof"`
+}
+
+type UserSetting_Templates struct {
+	Templates *TemplatesUserSetting `protobuf:"bytes,9,opt,name=templates,proto3,oneof"`
+}
+
+type UserSetting_Notifications struct {
+	Notifications *NotificationsUserSetting `protobuf:"bytes,10,opt,name=notifications,proto3,oneof"`
+}
+
+type UserSetting_EmailIngest struct {
+	EmailIngest *EmailIngestUserSetting `protobuf:"bytes,11,opt,name=email_ingest,json=emailIngest,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Sessions) isUserSetting_Value() {}
@@ -231,6 +404,14 @@ func (*UserSetting_Shortcuts) isUserSetting_Value() {}
 
 func (*UserSetting_Webhooks) isUserSetting_Value() {}
 
+func (*UserSetting_Tags) isUserSetting_Value() {}
+
+func (*UserSetting_Templates) isUserSetting_Value() {}
+
+func (*UserSetting_Notifications) isUserSetting_Value() {}
+
+func (*UserSetting_EmailIngest) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -471,6 +652,223 @@ func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
 	return nil
 }
 
+type TagsUserSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagsUserSetting_Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsUserSetting) Reset() {
+	*x = TagsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsUserSetting) ProtoMessage() {}
+
+func (x *TagsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsUserSetting.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TagsUserSetting) GetTags() []*TagsUserSetting_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TemplatesUserSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*MemoTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesUserSetting) Reset() {
+	*x = TemplatesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesUserSetting) ProtoMessage() {}
+
+func (x *TemplatesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesUserSetting.ProtoReflect.Descriptor instead.
+func (*TemplatesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TemplatesUserSetting) GetTemplates() []*MemoTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type NotificationsUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The delivery preferences by notification type.
+	// Notifications of a type without a preference are delivered to the inbox only.
+	Preferences []*NotificationsUserSetting_Preference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	// The endpoint push notifications are posted to.
+	PushUrl string `protobuf:"bytes,3,opt,name=push_url,json=pushUrl,proto3" json:"push_url,omitempty"`
+	// How often the unread inbox items are emailed as a digest, never if unspecified.
+	DigestFrequency NotificationsUserSetting_DigestFrequency `protobuf:"varint,4,opt,name=digest_frequency,json=digestFrequency,proto3,enum=memos.store.NotificationsUserSetting_DigestFrequency" json:"digest_frequency,omitempty"`
+	// The time the last digest was sent, so that the digests that came due while the server was down are sent at startup.
+	LastDigestTs  int64 `protobuf:"varint,5,opt,name=last_digest_ts,json=lastDigestTs,proto3" json:"last_digest_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsUserSetting) Reset() {
+	*x = NotificationsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsUserSetting) ProtoMessage() {}
+
+func (x *NotificationsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NotificationsUserSetting) GetPreferences() []*NotificationsUserSetting_Preference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *NotificationsUserSetting) GetPushUrl() string {
+	if x != nil {
+		return x.PushUrl
+	}
+	return ""
+}
+
+func (x *NotificationsUserSetting) GetDigestFrequency() NotificationsUserSetting_DigestFrequency {
+	if x != nil {
+		return x.DigestFrequency
+	}
+	return NotificationsUserSetting_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *NotificationsUserSetting) GetLastDigestTs() int64 {
+	if x != nil {
+		return x.LastDigestTs
+	}
+	return 0
+}
+
+type EmailIngestUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token emails are authenticated with, as the ingest address or the SMTP password.
+	// Empty if creating memos by email is disabled.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The senders allowed to send to the ingest address, either addresses or domains like @example.com.
+	// Only the email address of the user is allowed if empty.
+	AllowedSenders []string `protobuf:"bytes,2,rep,name=allowed_senders,json=allowedSenders,proto3" json:"allowed_senders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EmailIngestUserSetting) Reset() {
+	*x = EmailIngestUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailIngestUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailIngestUserSetting) ProtoMessage() {}
+
+func (x *EmailIngestUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailIngestUserSetting.ProtoReflect.Descriptor instead.
+func (*EmailIngestUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EmailIngestUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *EmailIngestUserSetting) GetAllowedSenders() []string {
+	if x != nil {
+		return x.AllowedSenders
+	}
+	return nil
+}
+
 type SessionsUserSetting_Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique session identifier.
@@ -488,7 +886,7 @@ type SessionsUserSetting_Session struct {
 
 func (x *SessionsUserSetting_Session) Reset() {
 	*x = SessionsUserSetting_Session{}
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +898,7 @@ func (x *SessionsUserSetting_Session) String() string {
 func (*SessionsUserSetting_Session) ProtoMessage() {}
 
 func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +960,7 @@ type SessionsUserSetting_ClientInfo struct {
 
 func (x *SessionsUserSetting_ClientInfo) Reset() {
 	*x = SessionsUserSetting_ClientInfo{}
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +972,7 @@ func (x *SessionsUserSetting_ClientInfo) String() string {
 func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
 
 func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +1036,7 @@ type AccessTokensUserSetting_AccessToken struct {
 
 func (x *AccessTokensUserSetting_AccessToken) Reset() {
 	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +1048,7 @@ func (x *AccessTokensUserSetting_AccessToken) String() string {
 func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
 
 func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +1089,7 @@ type ShortcutsUserSetting_Shortcut struct {
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1101,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1152,7 @@ type WebhooksUserSetting_Webhook struct {
 
 func (x *WebhooksUserSetting_Webhook) Reset() {
 	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1164,7 @@ func (x *WebhooksUserSetting_Webhook) String() string {
 func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
 
 func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,11 +1201,145 @@ func (x *WebhooksUserSetting_Webhook) GetUrl() string {
 	return ""
 }
 
+type TagsUserSetting_Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag without the leading "#", e.g. "work/meeting".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The display color of the tag, e.g. "#3b82f6".
+	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	// A description of the tag.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Other tags that mean the same as this tag, e.g. "k8s" for "kubernetes".
+	Aliases []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// Whether the tag is pinned to the top of the tag list.
+	Pinned        bool `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsUserSetting_Tag) Reset() {
+	*x = TagsUserSetting_Tag{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsUserSetting_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsUserSetting_Tag) ProtoMessage() {}
+
+func (x *TagsUserSetting_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsUserSetting_Tag.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting_Tag) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *TagsUserSetting_Tag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *TagsUserSetting_Tag) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type NotificationsUserSetting_Preference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	// The channels the notifications of the type are delivered through, none mutes the type.
+	Channels      []NotificationsUserSetting_Channel `protobuf:"varint,2,rep,packed,name=channels,proto3,enum=memos.store.NotificationsUserSetting_Channel" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsUserSetting_Preference) Reset() {
+	*x = NotificationsUserSetting_Preference{}
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsUserSetting_Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsUserSetting_Preference) ProtoMessage() {}
+
+func (x *NotificationsUserSetting_Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsUserSetting_Preference.ProtoReflect.Descriptor instead.
+func (*NotificationsUserSetting_Preference) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *NotificationsUserSetting_Preference) GetType() InboxMessage_Type {
+	if x != nil {
+		return x.Type
+	}
+	return InboxMessage_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationsUserSetting_Preference) GetChannels() []NotificationsUserSetting_Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11store/inbox.proto\x1a\x14store/template.proto\"\xe2\x06\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -815,14 +1347,23 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bsessions\x18\x04 \x01(\v2 .memos.store.SessionsUserSettingH\x00R\bsessions\x12K\n" +
 	"\raccess_tokens\x18\x05 \x01(\v2$.memos.store.AccessTokensUserSettingH\x00R\faccessTokens\x12A\n" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
-	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\"e\n" +
+	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x122\n" +
+	"\x04tags\x18\b \x01(\v2\x1c.memos.store.TagsUserSettingH\x00R\x04tags\x12A\n" +
+	"\ttemplates\x18\t \x01(\v2!.memos.store.TemplatesUserSettingH\x00R\ttemplates\x12M\n" +
+	"\rnotifications\x18\n" +
+	" \x01(\v2%.memos.store.NotificationsUserSettingH\x00R\rnotifications\x12H\n" +
+	"\femail_ingest\x18\v \x01(\v2#.memos.store.EmailIngestUserSettingH\x00R\vemailIngest\"\xa3\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
-	"\bWEBHOOKS\x10\x05B\a\n" +
+	"\bWEBHOOKS\x10\x05\x12\b\n" +
+	"\x04TAGS\x10\x06\x12\r\n" +
+	"\tTEMPLATES\x10\a\x12\x11\n" +
+	"\rNOTIFICATIONS\x10\b\x12\x10\n" +
+	"\fEMAIL_INGEST\x10\tB\a\n" +
 	"\x05value\"k\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
@@ -864,7 +1405,39 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xcb\x01\n" +
+	"\x0fTagsUserSetting\x124\n" +
+	"\x04tags\x18\x01 \x03(\v2 .memos.store.TagsUserSetting.TagR\x04tags\x1a\x81\x01\n" +
+	"\x03Tag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aaliases\x18\x04 \x03(\tR\aaliases\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\"O\n" +
+	"\x14TemplatesUserSetting\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.memos.store.MemoTemplateR\ttemplates\"\xb5\x04\n" +
+	"\x18NotificationsUserSetting\x12R\n" +
+	"\vpreferences\x18\x02 \x03(\v20.memos.store.NotificationsUserSetting.PreferenceR\vpreferences\x12\x19\n" +
+	"\bpush_url\x18\x03 \x01(\tR\apushUrl\x12`\n" +
+	"\x10digest_frequency\x18\x04 \x01(\x0e25.memos.store.NotificationsUserSetting.DigestFrequencyR\x0fdigestFrequency\x12$\n" +
+	"\x0elast_digest_ts\x18\x05 \x01(\x03R\flastDigestTs\x1a\x8b\x01\n" +
+	"\n" +
+	"Preference\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12I\n" +
+	"\bchannels\x18\x02 \x03(\x0e2-.memos.store.NotificationsUserSetting.ChannelR\bchannels\"B\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05INBOX\x10\x01\x12\t\n" +
+	"\x05EMAIL\x10\x02\x12\b\n" +
+	"\x04PUSH\x10\x03\"J\n" +
+	"\x0fDigestFrequency\x12 \n" +
+	"\x1cDIGEST_FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02J\x04\b\x01\x10\x02\"W\n" +
+	"\x16EmailIngestUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fallowed_senders\x18\x02 \x03(\tR\x0eallowedSendersB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -879,42 +1452,62 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_store_user_setting_proto_goTypes = []any{
-	(UserSetting_Key)(0),                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                  // 2: memos.store.GeneralUserSetting
-	(*SessionsUserSetting)(nil),                 // 3: memos.store.SessionsUserSetting
-	(*AccessTokensUserSetting)(nil),             // 4: memos.store.AccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                 // 6: memos.store.WebhooksUserSetting
-	(*SessionsUserSetting_Session)(nil),         // 7: memos.store.SessionsUserSetting.Session
-	(*SessionsUserSetting_ClientInfo)(nil),      // 8: memos.store.SessionsUserSetting.ClientInfo
-	(*AccessTokensUserSetting_AccessToken)(nil), // 9: memos.store.AccessTokensUserSetting.AccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(UserSetting_Key)(0),                          // 0: memos.store.UserSetting.Key
+	(NotificationsUserSetting_Channel)(0),         // 1: memos.store.NotificationsUserSetting.Channel
+	(NotificationsUserSetting_DigestFrequency)(0), // 2: memos.store.NotificationsUserSetting.DigestFrequency
+	(*UserSetting)(nil),                           // 3: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                    // 4: memos.store.GeneralUserSetting
+	(*SessionsUserSetting)(nil),                   // 5: memos.store.SessionsUserSetting
+	(*AccessTokensUserSetting)(nil),               // 6: memos.store.AccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                  // 7: memos.store.ShortcutsUserSetting
+	(*WebhooksUserSetting)(nil),                   // 8: memos.store.WebhooksUserSetting
+	(*TagsUserSetting)(nil),                       // 9: memos.store.TagsUserSetting
+	(*TemplatesUserSetting)(nil),                  // 10: memos.store.TemplatesUserSetting
+	(*NotificationsUserSetting)(nil),              // 11: memos.store.NotificationsUserSetting
+	(*EmailIngestUserSetting)(nil),                // 12: memos.store.EmailIngestUserSetting
+	(*SessionsUserSetting_Session)(nil),           // 13: memos.store.SessionsUserSetting.Session
+	(*SessionsUserSetting_ClientInfo)(nil),        // 14: memos.store.SessionsUserSetting.ClientInfo
+	(*AccessTokensUserSetting_AccessToken)(nil),   // 15: memos.store.AccessTokensUserSetting.AccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),         // 16: memos.store.ShortcutsUserSetting.Shortcut
+	(*WebhooksUserSetting_Webhook)(nil),           // 17: memos.store.WebhooksUserSetting.Webhook
+	(*TagsUserSetting_Tag)(nil),                   // 18: memos.store.TagsUserSetting.Tag
+	(*NotificationsUserSetting_Preference)(nil),   // 19: memos.store.NotificationsUserSetting.Preference
+	(*MemoTemplate)(nil),                          // 20: memos.store.MemoTemplate
+	(*timestamppb.Timestamp)(nil),                 // 21: google.protobuf.Timestamp
+	(InboxMessage_Type)(0),                        // 22: memos.store.InboxMessage.Type
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	3,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
-	4,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	5,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	7,  // 6: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
-	9,  // 7: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	4,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	5,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
+	6,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	7,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	8,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	9,  // 6: memos.store.UserSetting.tags:type_name -> memos.store.TagsUserSetting
+	10, // 7: memos.store.UserSetting.templates:type_name -> memos.store.TemplatesUserSetting
+	11, // 8: memos.store.UserSetting.notifications:type_name -> memos.store.NotificationsUserSetting
+	12, // 9: memos.store.UserSetting.email_ingest:type_name -> memos.store.EmailIngestUserSetting
+	13, // 10: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
+	15, // 11: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	16, // 12: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	17, // 13: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	18, // 14: memos.store.TagsUserSetting.tags:type_name -> memos.store.TagsUserSetting.Tag
+	20, // 15: memos.store.TemplatesUserSetting.templates:type_name -> memos.store.MemoTemplate
+	19, // 16: memos.store.NotificationsUserSetting.preferences:type_name -> memos.store.NotificationsUserSetting.Preference
+	2,  // 17: memos.store.NotificationsUserSetting.digest_frequency:type_name -> memos.store.NotificationsUserSetting.DigestFrequency
+	21, // 18: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
+	21, // 19: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
+	14, // 20: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
+	22, // 21: memos.store.NotificationsUserSetting.Preference.type:type_name -> memos.store.InboxMessage.Type
+	1,  // 22: memos.store.NotificationsUserSetting.Preference.channels:type_name -> memos.store.NotificationsUserSetting.Channel
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -922,20 +1515,26 @@ func file_store_user_setting_proto_init() {
 	if File_store_user_setting_proto != nil {
 		return
 	}
+	file_store_inbox_proto_init()
+	file_store_template_proto_init()
 	file_store_user_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*UserSetting_General)(nil),
 		(*UserSetting_Sessions)(nil),
 		(*UserSetting_AccessTokens)(nil),
 		(*UserSetting_Shortcuts)(nil),
 		(*UserSetting_Webhooks)(nil),
+		(*UserSetting_Tags)(nil),
+		(*UserSetting_Templates)(nil),
+		(*UserSetting_Notifications)(nil),
+		(*UserSetting_EmailIngest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
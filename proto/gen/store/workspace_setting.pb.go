@@ -35,6 +35,12 @@ const (
 	WorkspaceSettingKey_MEMO_RELATED WorkspaceSettingKey = 4
 	// AI is the key for AI settings.
 	WorkspaceSettingKey_AI WorkspaceSettingKey = 5
+	// TEMPLATE is the key for the memo templates shared with all users.
+	WorkspaceSettingKey_TEMPLATE WorkspaceSettingKey = 6
+	// SMTP is the key for the SMTP server settings used to send email.
+	WorkspaceSettingKey_SMTP WorkspaceSettingKey = 7
+	// EMAIL_INGEST is the key for the settings of creating memos by email.
+	WorkspaceSettingKey_EMAIL_INGEST WorkspaceSettingKey = 8
 )
 
 // Enum value maps for WorkspaceSettingKey.
@@ -46,6 +52,9 @@ var (
 		3: "STORAGE",
 		4: "MEMO_RELATED",
 		5: "AI",
+		6: "TEMPLATE",
+		7: "SMTP",
+		8: "EMAIL_INGEST",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
 		"WORKSPACE_SETTING_KEY_UNSPECIFIED": 0,
@@ -54,6 +63,9 @@ var (
 		"STORAGE":                           3,
 		"MEMO_RELATED":                      4,
 		"AI":                                5,
+		"TEMPLATE":                          6,
+		"SMTP":                              7,
+		"EMAIL_INGEST":                      8,
 	}
 )
 
@@ -139,6 +151,58 @@ func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type WorkspaceSMTPSetting_Security int32
+
+const (
+	// STARTTLS is required, sending fails if the server doesn't support it.
+	WorkspaceSMTPSetting_SECURITY_UNSPECIFIED WorkspaceSMTPSetting_Security = 0
+	// TLS connects with implicit TLS.
+	WorkspaceSMTPSetting_TLS WorkspaceSMTPSetting_Security = 1
+	// NONE never encrypts the connection, credentials and emails are sent in cleartext.
+	WorkspaceSMTPSetting_NONE WorkspaceSMTPSetting_Security = 2
+)
+
+// Enum value maps for WorkspaceSMTPSetting_Security.
+var (
+	WorkspaceSMTPSetting_Security_name = map[int32]string{
+		0: "SECURITY_UNSPECIFIED",
+		1: "TLS",
+		2: "NONE",
+	}
+	WorkspaceSMTPSetting_Security_value = map[string]int32{
+		"SECURITY_UNSPECIFIED": 0,
+		"TLS":                  1,
+		"NONE":                 2,
+	}
+)
+
+func (x WorkspaceSMTPSetting_Security) Enum() *WorkspaceSMTPSetting_Security {
+	p := new(WorkspaceSMTPSetting_Security)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSMTPSetting_Security) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSMTPSetting_Security) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceSMTPSetting_Security) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x WorkspaceSMTPSetting_Security) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSMTPSetting_Security.Descriptor instead.
+func (WorkspaceSMTPSetting_Security) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type WorkspaceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   WorkspaceSettingKey    `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.WorkspaceSettingKey" json:"key,omitempty"`
@@ -149,6 +213,9 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_StorageSetting
 	//	*WorkspaceSetting_MemoRelatedSetting
 	//	*WorkspaceSetting_AiSetting
+	//	*WorkspaceSetting_TemplateSetting
+	//	*WorkspaceSetting_SmtpSetting
+	//	*WorkspaceSetting_EmailIngestSetting
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -243,6 +310,33 @@ func (x *WorkspaceSetting) GetAiSetting() *WorkspaceAISetting {
 	return nil
 }
 
+func (x *WorkspaceSetting) GetTemplateSetting() *WorkspaceTemplateSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_TemplateSetting); ok {
+			return x.TemplateSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetSmtpSetting() *WorkspaceSMTPSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SmtpSetting); ok {
+			return x.SmtpSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmailIngestSetting() *WorkspaceEmailIngestSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmailIngestSetting); ok {
+			return x.EmailIngestSetting
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -267,6 +361,18 @@ type WorkspaceSetting_AiSetting struct {
 	AiSetting *WorkspaceAISetting `protobuf:"bytes,6,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
 }
 
+type WorkspaceSetting_TemplateSetting struct {
+	TemplateSetting *WorkspaceTemplateSetting `protobuf:"bytes,7,opt,name=template_setting,json=templateSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_SmtpSetting struct {
+	SmtpSetting *WorkspaceSMTPSetting `protobuf:"bytes,8,opt,name=smtp_setting,json=smtpSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmailIngestSetting struct {
+	EmailIngestSetting *WorkspaceEmailIngestSetting `protobuf:"bytes,9,opt,name=email_ingest_setting,json=emailIngestSetting,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_BasicSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
@@ -277,6 +383,12 @@ func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_AiSetting) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_TemplateSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_SmtpSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmailIngestSetting) isWorkspaceSetting_Value() {}
+
 type WorkspaceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for workspace. Mainly used for session management.
@@ -696,7 +808,11 @@ type WorkspaceMemoRelatedSetting struct {
 	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
 	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
 	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is the number of days memos stay in the trash before being permanently deleted.
+	TrashRetentionDays int32 `protobuf:"varint,11,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// relation_types is the list of custom memo relation types.
+	RelationTypes []*WorkspaceMemoRelatedSetting_RelationType `protobuf:"bytes,12,rep,name=relation_types,json=relationTypes,proto3" json:"relation_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -794,6 +910,20 @@ func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
 	return nil
 }
 
+func (x *WorkspaceMemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetRelationTypes() []*WorkspaceMemoRelatedSetting_RelationType {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
 type WorkspaceAISetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// enable_ai enables AI features.
@@ -947,11 +1077,272 @@ func (x *TagRecommendationConfig) GetRequestsPerMinute() int32 {
 	return 0
 }
 
+type WorkspaceTemplateSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// templates is the list of memo templates shared with all users.
+	Templates     []*MemoTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceTemplateSetting) Reset() {
+	*x = WorkspaceTemplateSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceTemplateSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTemplateSetting) ProtoMessage() {}
+
+func (x *WorkspaceTemplateSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTemplateSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplateSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceTemplateSetting) GetTemplates() []*MemoTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type WorkspaceSMTPSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enable_email enables sending email.
+	EnableEmail bool `protobuf:"varint,1,opt,name=enable_email,json=enableEmail,proto3" json:"enable_email,omitempty"`
+	// host is the host of the SMTP server.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// port is the port of the SMTP server.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// username is the username to authenticate with, no authentication if empty.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password to authenticate with.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// security is how the connection to the SMTP server is secured.
+	Security WorkspaceSMTPSetting_Security `protobuf:"varint,6,opt,name=security,proto3,enum=memos.store.WorkspaceSMTPSetting_Security" json:"security,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,7,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName      string `protobuf:"bytes,8,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSMTPSetting) Reset() {
+	*x = WorkspaceSMTPSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSMTPSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSMTPSetting) ProtoMessage() {}
+
+func (x *WorkspaceSMTPSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSMTPSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSMTPSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceSMTPSetting) GetEnableEmail() bool {
+	if x != nil {
+		return x.EnableEmail
+	}
+	return false
+}
+
+func (x *WorkspaceSMTPSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *WorkspaceSMTPSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WorkspaceSMTPSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WorkspaceSMTPSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WorkspaceSMTPSetting) GetSecurity() WorkspaceSMTPSetting_Security {
+	if x != nil {
+		return x.Security
+	}
+	return WorkspaceSMTPSetting_SECURITY_UNSPECIFIED
+}
+
+func (x *WorkspaceSMTPSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *WorkspaceSMTPSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+type WorkspaceEmailIngestSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// domain is the domain of the ingest addresses, the host of the instance URL if empty.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// max_message_size_mb is the max size of received emails in megabytes, attachments included.
+	MaxMessageSizeMb int64 `protobuf:"varint,2,opt,name=max_message_size_mb,json=maxMessageSizeMb,proto3" json:"max_message_size_mb,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceEmailIngestSetting) Reset() {
+	*x = WorkspaceEmailIngestSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceEmailIngestSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceEmailIngestSetting) ProtoMessage() {}
+
+func (x *WorkspaceEmailIngestSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceEmailIngestSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceEmailIngestSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceEmailIngestSetting) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailIngestSetting) GetMaxMessageSizeMb() int64 {
+	if x != nil {
+		return x.MaxMessageSizeMb
+	}
+	return 0
+}
+
+type WorkspaceMemoRelatedSetting_RelationType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the relation type seen from the memo, e.g. "blocks".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// inverse_label is the label of the relation seen from the related memo, e.g. "blocked by".
+	InverseLabel  string `protobuf:"bytes,2,opt,name=inverse_label,json=inverseLabel,proto3" json:"inverse_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMemoRelatedSetting_RelationType) Reset() {
+	*x = WorkspaceMemoRelatedSetting_RelationType{}
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMemoRelatedSetting_RelationType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMemoRelatedSetting_RelationType) ProtoMessage() {}
+
+func (x *WorkspaceMemoRelatedSetting_RelationType) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMemoRelatedSetting_RelationType.ProtoReflect.Descriptor instead.
+func (*WorkspaceMemoRelatedSetting_RelationType) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *WorkspaceMemoRelatedSetting_RelationType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceMemoRelatedSetting_RelationType) GetInverseLabel() string {
+	if x != nil {
+		return x.InverseLabel
+	}
+	return ""
+}
+
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 const file_store_workspace_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\xdc\x03\n" +
+	"\x1dstore/workspace_setting.proto\x12\vmemos.store\x1a\x14store/template.proto\"\xd6\x05\n" +
 	"\x10WorkspaceSetting\x122\n" +
 	"\x03key\x18\x01 \x01(\x0e2 .memos.store.WorkspaceSettingKeyR\x03key\x12I\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2\".memos.store.WorkspaceBasicSettingH\x00R\fbasicSetting\x12O\n" +
@@ -959,7 +1350,10 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2$.memos.store.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12\\\n" +
 	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12@\n" +
 	"\n" +
-	"ai_setting\x18\x06 \x01(\v2\x1f.memos.store.WorkspaceAISettingH\x00R\taiSettingB\a\n" +
+	"ai_setting\x18\x06 \x01(\v2\x1f.memos.store.WorkspaceAISettingH\x00R\taiSetting\x12R\n" +
+	"\x10template_setting\x18\a \x01(\v2%.memos.store.WorkspaceTemplateSettingH\x00R\x0ftemplateSetting\x12F\n" +
+	"\fsmtp_setting\x18\b \x01(\v2!.memos.store.WorkspaceSMTPSettingH\x00R\vsmtpSetting\x12\\\n" +
+	"\x14email_ingest_setting\x18\t \x01(\v2(.memos.store.WorkspaceEmailIngestSettingH\x00R\x12emailIngestSettingB\a\n" +
 	"\x05value\"]\n" +
 	"\x15WorkspaceBasicSetting\x12\x1d\n" +
 	"\n" +
@@ -996,7 +1390,7 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xe1\x03\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xba\x05\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -1007,7 +1401,12 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\"\xf9\x01\n" +
+	" \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\v \x01(\x05R\x12trashRetentionDays\x12\\\n" +
+	"\x0erelation_types\x18\f \x03(\v25.memos.store.WorkspaceMemoRelatedSetting.RelationTypeR\rrelationTypes\x1aG\n" +
+	"\fRelationType\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rinverse_label\x18\x02 \x01(\tR\finverseLabel\"\xf9\x01\n" +
 	"\x12WorkspaceAISetting\x12\x1b\n" +
 	"\tenable_ai\x18\x01 \x01(\bR\benableAi\x12\x19\n" +
 	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x17\n" +
@@ -1018,14 +1417,36 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x17TagRecommendationConfig\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
 	"\rsystem_prompt\x18\x02 \x01(\tR\fsystemPrompt\x12.\n" +
-	"\x13requests_per_minute\x18\x03 \x01(\x05R\x11requestsPerMinute*{\n" +
+	"\x13requests_per_minute\x18\x03 \x01(\x05R\x11requestsPerMinute\"S\n" +
+	"\x18WorkspaceTemplateSetting\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.memos.store.MemoTemplateR\ttemplates\"\xd6\x02\n" +
+	"\x14WorkspaceSMTPSetting\x12!\n" +
+	"\fenable_email\x18\x01 \x01(\bR\venableEmail\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12F\n" +
+	"\bsecurity\x18\x06 \x01(\x0e2*.memos.store.WorkspaceSMTPSetting.SecurityR\bsecurity\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\b \x01(\tR\bfromName\"7\n" +
+	"\bSecurity\x12\x18\n" +
+	"\x14SECURITY_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03TLS\x10\x01\x12\b\n" +
+	"\x04NONE\x10\x02\"d\n" +
+	"\x1bWorkspaceEmailIngestSetting\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12-\n" +
+	"\x13max_message_size_mb\x18\x02 \x01(\x03R\x10maxMessageSizeMb*\xa5\x01\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x04\x12\x06\n" +
-	"\x02AI\x10\x05B\xa0\x01\n" +
+	"\x02AI\x10\x05\x12\f\n" +
+	"\bTEMPLATE\x10\x06\x12\b\n" +
+	"\x04SMTP\x10\a\x12\x10\n" +
+	"\fEMAIL_INGEST\x10\bB\xa0\x01\n" +
 	"\x0fcom.memos.storeB\x15WorkspaceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -1040,37 +1461,49 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                 // 0: memos.store.WorkspaceSettingKey
-	(WorkspaceStorageSetting_StorageType)(0), // 1: memos.store.WorkspaceStorageSetting.StorageType
-	(*WorkspaceSetting)(nil),                 // 2: memos.store.WorkspaceSetting
-	(*WorkspaceBasicSetting)(nil),            // 3: memos.store.WorkspaceBasicSetting
-	(*WorkspaceGeneralSetting)(nil),          // 4: memos.store.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 5: memos.store.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 6: memos.store.WorkspaceStorageSetting
-	(*StorageS3Config)(nil),                  // 7: memos.store.StorageS3Config
-	(*WorkspaceMemoRelatedSetting)(nil),      // 8: memos.store.WorkspaceMemoRelatedSetting
-	(*WorkspaceAISetting)(nil),               // 9: memos.store.WorkspaceAISetting
-	(*TagRecommendationConfig)(nil),          // 10: memos.store.TagRecommendationConfig
+	(WorkspaceSettingKey)(0),                         // 0: memos.store.WorkspaceSettingKey
+	(WorkspaceStorageSetting_StorageType)(0),         // 1: memos.store.WorkspaceStorageSetting.StorageType
+	(WorkspaceSMTPSetting_Security)(0),               // 2: memos.store.WorkspaceSMTPSetting.Security
+	(*WorkspaceSetting)(nil),                         // 3: memos.store.WorkspaceSetting
+	(*WorkspaceBasicSetting)(nil),                    // 4: memos.store.WorkspaceBasicSetting
+	(*WorkspaceGeneralSetting)(nil),                  // 5: memos.store.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                   // 6: memos.store.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                  // 7: memos.store.WorkspaceStorageSetting
+	(*StorageS3Config)(nil),                          // 8: memos.store.StorageS3Config
+	(*WorkspaceMemoRelatedSetting)(nil),              // 9: memos.store.WorkspaceMemoRelatedSetting
+	(*WorkspaceAISetting)(nil),                       // 10: memos.store.WorkspaceAISetting
+	(*TagRecommendationConfig)(nil),                  // 11: memos.store.TagRecommendationConfig
+	(*WorkspaceTemplateSetting)(nil),                 // 12: memos.store.WorkspaceTemplateSetting
+	(*WorkspaceSMTPSetting)(nil),                     // 13: memos.store.WorkspaceSMTPSetting
+	(*WorkspaceEmailIngestSetting)(nil),              // 14: memos.store.WorkspaceEmailIngestSetting
+	(*WorkspaceMemoRelatedSetting_RelationType)(nil), // 15: memos.store.WorkspaceMemoRelatedSetting.RelationType
+	(*MemoTemplate)(nil),                             // 16: memos.store.MemoTemplate
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
-	3,  // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
-	4,  // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
-	6,  // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
-	8,  // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
-	9,  // 5: memos.store.WorkspaceSetting.ai_setting:type_name -> memos.store.WorkspaceAISetting
-	5,  // 6: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
-	1,  // 7: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
-	7,  // 8: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	10, // 9: memos.store.WorkspaceAISetting.tag_recommendation:type_name -> memos.store.TagRecommendationConfig
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,  // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
+	5,  // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
+	7,  // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
+	9,  // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
+	10, // 5: memos.store.WorkspaceSetting.ai_setting:type_name -> memos.store.WorkspaceAISetting
+	12, // 6: memos.store.WorkspaceSetting.template_setting:type_name -> memos.store.WorkspaceTemplateSetting
+	13, // 7: memos.store.WorkspaceSetting.smtp_setting:type_name -> memos.store.WorkspaceSMTPSetting
+	14, // 8: memos.store.WorkspaceSetting.email_ingest_setting:type_name -> memos.store.WorkspaceEmailIngestSetting
+	6,  // 9: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
+	1,  // 10: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
+	8,  // 11: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	15, // 12: memos.store.WorkspaceMemoRelatedSetting.relation_types:type_name -> memos.store.WorkspaceMemoRelatedSetting.RelationType
+	11, // 13: memos.store.WorkspaceAISetting.tag_recommendation:type_name -> memos.store.TagRecommendationConfig
+	16, // 14: memos.store.WorkspaceTemplateSetting.templates:type_name -> memos.store.MemoTemplate
+	2,  // 15: memos.store.WorkspaceSMTPSetting.security:type_name -> memos.store.WorkspaceSMTPSetting.Security
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -1078,20 +1511,24 @@ func file_store_workspace_setting_proto_init() {
 	if File_store_workspace_setting_proto != nil {
 		return
 	}
+	file_store_template_proto_init()
 	file_store_workspace_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*WorkspaceSetting_BasicSetting)(nil),
 		(*WorkspaceSetting_GeneralSetting)(nil),
 		(*WorkspaceSetting_StorageSetting)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
 		(*WorkspaceSetting_AiSetting)(nil),
+		(*WorkspaceSetting_TemplateSetting)(nil),
+		(*WorkspaceSetting_SmtpSetting)(nil),
+		(*WorkspaceSetting_EmailIngestSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,227 @@
+// Package ingest creates memos from the emails users send or forward to the embedded SMTP listener.
+package ingest
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// fallbackMaxMessageSize is the max size of messages when the workspace setting can't be read.
+const fallbackMaxMessageSize = 10 << 20
+
+// MemoCreator creates the memo of an email the same way the API creates memos.
+type MemoCreator interface {
+	CreateMemoFromEmail(ctx context.Context, user *store.User, message *email.ReceivedMessage) error
+}
+
+// Service receives emails and creates memos for the users they're sent to.
+// Emails are authenticated with the ingest token of the user, either as the local part of the
+// recipient address or as the SMTP password along with the username, which needs a TLS certificate in the profile.
+type Service struct {
+	Profile     *profile.Profile
+	Store       *store.Store
+	MemoCreator MemoCreator
+
+	server *email.Server
+}
+
+func NewService(profile *profile.Profile, store *store.Store, memoCreator MemoCreator) (*Service, error) {
+	s := &Service{
+		Profile:     profile,
+		Store:       store,
+		MemoCreator: memoCreator,
+	}
+	domain := "localhost"
+	if instanceURL, err := url.Parse(profile.InstanceURL); err == nil && instanceURL.Hostname() != "" {
+		domain = instanceURL.Hostname()
+	}
+	s.server = email.NewServer(domain, s)
+	if profile.SMTPTLSCert != "" || profile.SMTPTLSKey != "" {
+		certificate, err := tls.LoadX509KeyPair(profile.SMTPTLSCert, profile.SMTPTLSKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load SMTP TLS certificate")
+		}
+		s.server.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+	return s, nil
+}
+
+// Serve receives emails on the listener until the service is closed.
+func (s *Service) Serve(ctx context.Context, listener net.Listener) error {
+	return s.server.Serve(ctx, listener)
+}
+
+// Close stops receiving emails.
+func (s *Service) Close() error {
+	return s.server.Close()
+}
+
+// Authenticate checks that the password is the ingest token of the user.
+func (s *Service) Authenticate(ctx context.Context, username, password string) error {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal {
+		return &email.Error{Code: 535, Message: "Authentication credentials invalid"}
+	}
+	emailIngestSetting, err := s.Store.GetUserEmailIngestSetting(ctx, user.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user email ingest setting")
+	}
+	if !matchToken(emailIngestSetting.Token, password) {
+		return &email.Error{Code: 535, Message: "Authentication credentials invalid"}
+	}
+	return nil
+}
+
+func (s *Service) MaxMessageSize(ctx context.Context) int64 {
+	workspaceEmailIngestSetting, err := s.Store.GetWorkspaceEmailIngestSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace email ingest setting", "err", err)
+		return fallbackMaxMessageSize
+	}
+	return workspaceEmailIngestSetting.MaxMessageSizeMb << 20
+}
+
+// CheckRecipient accepts the ingest addresses of users, or any recipient once authenticated.
+func (s *Service) CheckRecipient(ctx context.Context, envelope *email.Envelope, recipient string) error {
+	if envelope.Username != "" {
+		return nil
+	}
+	user, _, err := s.getUserByAddress(ctx, recipient)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return &email.Error{Code: 550, Message: "No such user here"}
+	}
+	return nil
+}
+
+// Deliver creates a memo of the email for the authenticated user, or for the users of the recipient addresses
+// if the envelope sender is allowed by all of them. The From header isn't checked, as anyone can set it to anything.
+func (s *Service) Deliver(ctx context.Context, envelope *email.Envelope) error {
+	message, err := email.ParseMessage(envelope.Data)
+	if err != nil {
+		return &email.Error{Code: 554, Message: "Invalid message"}
+	}
+
+	users := []*store.User{}
+	if envelope.Username != "" {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &envelope.Username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus != store.Normal {
+			return &email.Error{Code: 550, Message: "No such user here"}
+		}
+		users = append(users, user)
+	} else {
+		for _, recipient := range envelope.To {
+			user, emailIngestSetting, err := s.getUserByAddress(ctx, recipient)
+			if err != nil {
+				return err
+			}
+			if user == nil || containsUser(users, user.ID) {
+				continue
+			}
+			if !isAllowedSender(user, emailIngestSetting.AllowedSenders, envelope.From) {
+				return &email.Error{Code: 550, Message: "Sender not allowed"}
+			}
+			users = append(users, user)
+		}
+	}
+
+	for _, user := range users {
+		if err := s.MemoCreator.CreateMemoFromEmail(ctx, user, message); err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				return &email.Error{Code: 554, Message: "Transaction failed: " + status.Convert(err).Message()}
+			}
+			return errors.Wrapf(err, "failed to create memo for user %d", user.ID)
+		}
+	}
+	return nil
+}
+
+// getUserByAddress returns the user whose ingest token is the local part of the address, if any.
+func (s *Service) getUserByAddress(ctx context.Context, address string) (*store.User, *storepb.EmailIngestUserSetting, error) {
+	at := strings.LastIndex(address, "@")
+	if at <= 0 {
+		return nil, nil, nil
+	}
+	token := strings.ToLower(address[:at])
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key:              storepb.UserSetting_EMAIL_INGEST,
+		EmailIngestToken: &token,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to find user email ingest setting")
+	}
+	for _, userSetting := range userSettings {
+		emailIngestSetting := userSetting.GetEmailIngest()
+		if !matchToken(emailIngestSetting.GetToken(), token) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus != store.Normal {
+			return nil, nil, nil
+		}
+		return user, emailIngestSetting, nil
+	}
+	return nil, nil, nil
+}
+
+func matchToken(token, candidate string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(candidate)) == 1
+}
+
+// isAllowedSender checks the sender against the allowed senders of the user, or against the user's email if there are none.
+func isAllowedSender(user *store.User, allowedSenders []string, sender string) bool {
+	sender = strings.ToLower(sender)
+	if sender == "" {
+		return false
+	}
+	if len(allowedSenders) == 0 {
+		return user.Email != "" && strings.EqualFold(user.Email, sender)
+	}
+	for _, allowed := range allowedSenders {
+		if strings.HasPrefix(allowed, "@") {
+			if strings.HasSuffix(sender, allowed) {
+				return true
+			}
+		} else if sender == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUser(users []*store.User, userID int32) bool {
+	for _, user := range users {
+		if user.ID == userID {
+			return true
+		}
+	}
+	return false
+}
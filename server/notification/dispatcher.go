@@ -0,0 +1,285 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// configurableTypes are the notification types users can choose the delivery channels of.
+var configurableTypes = []storepb.InboxMessage_Type{
+	storepb.InboxMessage_MEMO_COMMENT,
+	storepb.InboxMessage_MEMO_MENTION,
+	storepb.InboxMessage_MEMO_REACTION,
+	storepb.InboxMessage_MEMO_REFERENCE,
+	storepb.InboxMessage_REMINDER,
+}
+
+const (
+	// queueSize is the number of deliveries waiting to be sent, deliveries beyond it are dropped.
+	queueSize = 256
+	// workerCount is the number of deliveries sent at the same time.
+	workerCount = 4
+	// contentLength is the maximum number of characters of the memo content in a message.
+	contentLength = 200
+)
+
+// Notification is an event a user is notified of.
+type Notification struct {
+	Type       storepb.InboxMessage_Type
+	SenderID   int32
+	ReceiverID int32
+	// MemoID is the memo the notification is about, e.g. the comment of a comment notification.
+	MemoID int32
+	// Content replaces the content of the memo in the message, e.g. the text of a reminder.
+	Content string
+}
+
+// Message is a notification as delivered through the email and push channels, and in digests.
+type Message struct {
+	Type     storepb.InboxMessage_Type
+	Sender   *store.User
+	Receiver *store.User
+	// Setting is the notifications setting of the receiver.
+	Setting *storepb.NotificationsUserSetting
+	Title   string
+	Content string
+	// Memo is the resource name of the memo, e.g. memos/abc.
+	Memo       string
+	CreateTime time.Time
+}
+
+// Sender delivers messages through a channel.
+type Sender interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+type delivery struct {
+	channel  storepb.NotificationsUserSetting_Channel
+	message  *Message
+	attempts int
+}
+
+// Dispatcher routes notifications through the channels their receivers chose for their type.
+// Inbox deliveries are saved before Dispatch returns. Email and push deliveries are best-effort:
+// they are sent in the background by workerCount workers and retried when they fail, but they are only kept in memory.
+// The ones waiting to be sent or retried are lost when the server stops,
+// and new deliveries and retries are dropped, logged and counted while queueSize of them are waiting.
+type Dispatcher struct {
+	Store *store.Store
+	// InstanceURL is the URL links in messages point to.
+	InstanceURL string
+	// MaxAttempts is the number of times a delivery is tried before it's dropped.
+	MaxAttempts int
+	// RetryInterval is the delay before the first retry, doubled for every retry after it.
+	RetryInterval time.Duration
+
+	senders map[storepb.NotificationsUserSetting_Channel]Sender
+	queue   chan *delivery
+	dropped atomic.Int64
+}
+
+func NewDispatcher(store *store.Store, instanceURL string) *Dispatcher {
+	return &Dispatcher{
+		Store:         store,
+		InstanceURL:   instanceURL,
+		MaxAttempts:   5,
+		RetryInterval: 30 * time.Second,
+		senders: map[storepb.NotificationsUserSetting_Channel]Sender{
+			storepb.NotificationsUserSetting_EMAIL: NewEmailSender(store, instanceURL),
+			storepb.NotificationsUserSetting_PUSH:  NewPushSender(),
+		},
+		queue: make(chan *delivery, queueSize),
+	}
+}
+
+// RegisterSender sets the sender of the channel.
+// Deliveries through a channel without a sender are skipped.
+func (d *Dispatcher) RegisterSender(channel storepb.NotificationsUserSetting_Channel, sender Sender) {
+	d.senders[channel] = sender
+}
+
+// GetChannels returns the channels the notifications of the type are delivered through.
+func GetChannels(setting *storepb.NotificationsUserSetting, notificationType storepb.InboxMessage_Type) []storepb.NotificationsUserSetting_Channel {
+	for _, preference := range setting.GetPreferences() {
+		if preference.Type == notificationType {
+			return preference.Channels
+		}
+	}
+	return []storepb.NotificationsUserSetting_Channel{storepb.NotificationsUserSetting_INBOX}
+}
+
+// Dispatch delivers the notification through the channels the receiver chose for its type.
+// The inbox delivery is done by deliverToInbox before returning, the other deliveries are queued.
+func (d *Dispatcher) Dispatch(ctx context.Context, notification *Notification, deliverToInbox func() error) error {
+	setting, err := d.Store.GetUserNotificationsSetting(ctx, notification.ReceiverID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user notifications setting")
+	}
+
+	var message *Message
+	for _, channel := range GetChannels(setting, notification.Type) {
+		if channel == storepb.NotificationsUserSetting_INBOX {
+			if err := deliverToInbox(); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := d.senders[channel]; !ok {
+			slog.Debug("skip notification delivery without sender", "channel", channel.String())
+			continue
+		}
+		if message == nil {
+			if message, err = BuildMessage(ctx, d.Store, notification, setting); err != nil {
+				// The inbox delivery may already be saved, so failing to build the message only skips the channel.
+				slog.Error("failed to build notification message", "err", err, "channel", channel.String(), "receiverID", notification.ReceiverID)
+				continue
+			}
+		}
+		d.enqueue(&delivery{channel: channel, message: message})
+	}
+	return nil
+}
+
+// Run sends the queued deliveries with workerCount workers until the context is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-d.queue:
+					d.deliver(ctx, delivery)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Dropped returns the number of deliveries dropped because the queue was full.
+func (d *Dispatcher) Dropped() int64 {
+	return d.dropped.Load()
+}
+
+func (d *Dispatcher) enqueue(delivery *delivery) {
+	select {
+	case d.queue <- delivery:
+	default:
+		dropped := d.dropped.Add(1)
+		slog.Error("notification queue is full, dropping delivery", "channel", delivery.channel.String(), "receiverID", delivery.message.Receiver.ID, "queueSize", queueSize, "dropped", dropped)
+	}
+}
+
+// deliver sends the delivery, and queues it again after a backoff if it fails.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *delivery) {
+	err := d.senders[delivery.channel].Send(ctx, delivery.message)
+	if err == nil {
+		return
+	}
+	delivery.attempts++
+	if delivery.attempts >= d.MaxAttempts {
+		slog.Error("failed to deliver notification", "err", err, "channel", delivery.channel.String(), "receiverID", delivery.message.Receiver.ID, "attempts", delivery.attempts)
+		return
+	}
+	slog.Warn("failed to deliver notification, retrying", "err", err, "channel", delivery.channel.String(), "receiverID", delivery.message.Receiver.ID, "attempts", delivery.attempts)
+	time.AfterFunc(d.RetryInterval<<(delivery.attempts-1), func() {
+		if ctx.Err() == nil {
+			d.enqueue(delivery)
+		}
+	})
+}
+
+// BuildMessage looks up the users and the memo of the notification to build its message.
+// Notifications without a memo have no memo content or link.
+func BuildMessage(ctx context.Context, stores *store.Store, notification *Notification, setting *storepb.NotificationsUserSetting) (*Message, error) {
+	receiver, err := stores.GetUser(ctx, &store.FindUser{ID: &notification.ReceiverID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get receiver")
+	}
+	if receiver == nil {
+		return nil, errors.Errorf("receiver %d not found", notification.ReceiverID)
+	}
+	sender, err := stores.GetUser(ctx, &store.FindUser{ID: &notification.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	if sender == nil {
+		return nil, errors.Errorf("sender %d not found", notification.SenderID)
+	}
+
+	content, memoName := notification.Content, ""
+	if notification.MemoID != 0 {
+		memo, err := stores.GetMemo(ctx, &store.FindMemo{ID: &notification.MemoID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if memo == nil {
+			return nil, errors.Errorf("memo %d not found", notification.MemoID)
+		}
+		if content == "" {
+			content = memo.Content
+		}
+		memoName = fmt.Sprintf("memos/%s", memo.UID)
+	}
+	if runes := []rune(content); len(runes) > contentLength {
+		content = string(runes[:contentLength]) + "…"
+	}
+	return &Message{
+		Type:       notification.Type,
+		Sender:     sender,
+		Receiver:   receiver,
+		Setting:    setting,
+		Title:      getTitle(notification.Type, sender, content),
+		Content:    content,
+		Memo:       memoName,
+		CreateTime: time.Now(),
+	}, nil
+}
+
+// GetLink returns the link to the path of the web app, e.g. memos/abc.
+func GetLink(instanceURL, path string) string {
+	return strings.TrimSuffix(instanceURL, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+func getTitle(notificationType storepb.InboxMessage_Type, sender *store.User, content string) string {
+	name := sender.Nickname
+	if name == "" {
+		name = sender.Username
+	}
+	switch notificationType {
+	case storepb.InboxMessage_MEMO_COMMENT:
+		return fmt.Sprintf("%s commented on your memo", name)
+	case storepb.InboxMessage_MEMO_MENTION:
+		return fmt.Sprintf("%s mentioned you in a memo", name)
+	case storepb.InboxMessage_MEMO_REACTION:
+		return fmt.Sprintf("%s reacted to your memo", name)
+	case storepb.InboxMessage_MEMO_REFERENCE:
+		return fmt.Sprintf("%s referenced your memo", name)
+	case storepb.InboxMessage_MEMO_GRANT:
+		return fmt.Sprintf("%s shared a memo with you", name)
+	case storepb.InboxMessage_REMINDER:
+		return fmt.Sprintf("Reminder: %s", content)
+	default:
+		return fmt.Sprintf("New notification from %s", name)
+	}
+}
+
+// IsConfigurableType returns whether users can choose the delivery channels of the type.
+func IsConfigurableType(notificationType storepb.InboxMessage_Type) bool {
+	return slices.Contains(configurableTypes, notificationType)
+}
@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// ErrEmailDisabled is returned when sending email isn't enabled in the workspace.
+var ErrEmailDisabled = errors.New("sending email is not enabled")
+
+// SendEmail sends the message through the SMTP server of the workspace.
+func SendEmail(ctx context.Context, stores *store.Store, message *email.Message) error {
+	smtpSetting, err := stores.GetWorkspaceSMTPSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace SMTP setting")
+	}
+	if !smtpSetting.EnableEmail {
+		return ErrEmailDisabled
+	}
+	return email.Send(convertSMTPSetting(smtpSetting), message)
+}
+
+func convertSMTPSetting(setting *storepb.WorkspaceSMTPSetting) *email.Config {
+	security := email.SecurityStartTLS
+	switch setting.Security {
+	case storepb.WorkspaceSMTPSetting_TLS:
+		security = email.SecurityTLS
+	case storepb.WorkspaceSMTPSetting_NONE:
+		security = email.SecurityNone
+	}
+	return &email.Config{
+		Host:      setting.Host,
+		Port:      int(setting.Port),
+		Username:  setting.Username,
+		Password:  setting.Password,
+		Security:  security,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+	}
+}
+
+// EmailSender emails messages to the email address of the receiver.
+type EmailSender struct {
+	Store       *store.Store
+	InstanceURL string
+}
+
+func NewEmailSender(store *store.Store, instanceURL string) *EmailSender {
+	return &EmailSender{
+		Store:       store,
+		InstanceURL: instanceURL,
+	}
+}
+
+// Send emails the message, unless the receiver has no email address or sending email isn't enabled.
+func (s *EmailSender) Send(ctx context.Context, message *Message) error {
+	if message.Receiver.Email == "" {
+		return nil
+	}
+	data := &email.NotificationData{
+		Title:   message.Title,
+		Content: message.Content,
+	}
+	if message.Memo != "" {
+		data.Link = GetLink(s.InstanceURL, message.Memo)
+	}
+	emailMessage, err := email.NewMessage([]string{message.Receiver.Email}, message.Title, email.TemplateNotification, data)
+	if err != nil {
+		return err
+	}
+	if err := SendEmail(ctx, s.Store, emailMessage); err != nil && !errors.Is(err, ErrEmailDisabled) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// pushTimeout is the timeout of push requests.
+const pushTimeout = 30 * time.Second
+
+// deniedPushNetworks are the special-purpose networks push endpoints can't be in, on top of the addresses that aren't global unicast.
+// See the IANA IPv4 and IPv6 special-purpose address registries.
+var deniedPushNetworks = parseCIDRs(
+	"0.0.0.0/8",       // "This network"
+	"10.0.0.0/8",      // Private-use
+	"100.64.0.0/10",   // Shared address space
+	"127.0.0.0/8",     // Loopback
+	"169.254.0.0/16",  // Link-local
+	"172.16.0.0/12",   // Private-use
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // Documentation
+	"192.88.99.0/24",  // 6to4 relay anycast
+	"192.168.0.0/16",  // Private-use
+	"198.18.0.0/15",   // Benchmarking
+	"198.51.100.0/24", // Documentation
+	"203.0.113.0/24",  // Documentation
+	"224.0.0.0/4",     // Multicast
+	"240.0.0.0/4",     // Reserved, including the limited broadcast address
+	"64:ff9b::/96",    // IPv4/IPv6 translation
+	"64:ff9b:1::/48",  // Local-use IPv4/IPv6 translation
+	"100::/64",        // Discard-only
+	"2001::/23",       // IETF protocol assignments
+	"2001:db8::/32",   // Documentation
+	"2002::/16",       // 6to4
+	"fc00::/7",        // Unique-local
+	"fe80::/10",       // Link-local
+	"ff00::/8",        // Multicast
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// PushPayload is the JSON body posted to push endpoints.
+type PushPayload struct {
+	// The notification type, e.g. MEMO_COMMENT.
+	Type string `json:"type"`
+	// The resource name of the sender. Format: users/{user}
+	Sender     string    `json:"sender"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	Memo       string    `json:"memo"`
+	CreateTime time.Time `json:"createTime"`
+}
+
+// PushSender posts messages to the push endpoint of the receiver.
+type PushSender struct {
+	// Client sends the push requests.
+	Client *http.Client
+}
+
+// NewPushSender returns a sender that only connects to public addresses,
+// so that users can't make the server send requests to its internal network through their push endpoints.
+func NewPushSender() *PushSender {
+	dialer := &net.Dialer{
+		Timeout: pushTimeout,
+		Control: checkPushAddress,
+	}
+	return &PushSender{
+		Client: &http.Client{
+			Timeout: pushTimeout,
+			// Without a proxy, the checked address is the one of the endpoint, including after redirects.
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: pushTimeout,
+			},
+		},
+	}
+}
+
+// checkPushAddress refuses connections to addresses that aren't global unicast or are in deniedPushNetworks.
+// It's checked when dialing, after the host is resolved, so that hosts resolving to internal addresses are refused too.
+func checkPushAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicAddress(ip) {
+		return errors.Errorf("push endpoint address %s is not allowed", host)
+	}
+	return nil
+}
+
+func isPublicAddress(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() {
+		return false
+	}
+	for _, network := range deniedPushNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *PushSender) Send(ctx context.Context, message *Message) error {
+	url := message.Setting.GetPushUrl()
+	if url == "" {
+		return nil
+	}
+	if err := ValidatePushURL(url); err != nil {
+		return err
+	}
+	body, err := json.Marshal(&PushPayload{
+		Type:       message.Type.String(),
+		Sender:     fmt.Sprintf("users/%d", message.Sender.ID),
+		Title:      message.Title,
+		Content:    message.Content,
+		Memo:       message.Memo,
+		CreateTime: message.CreateTime,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal push payload")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct push request to %s", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post push notification to %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("failed to post push notification to %s, status code: %d, response body: %s", url, resp.StatusCode, b)
+	}
+	return nil
+}
+
+// ValidatePushURL checks that the push endpoint is an HTTP or HTTPS URL.
+func ValidatePushURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid push endpoint %q", rawURL)
+	}
+	return nil
+}
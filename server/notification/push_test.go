@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{address: "93.184.216.34", want: true},
+		{address: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{address: "0.0.0.0", want: false},
+		{address: "0.1.2.3", want: false},
+		{address: "10.0.0.1", want: false},
+		{address: "100.64.0.1", want: false},
+		{address: "127.0.0.1", want: false},
+		{address: "169.254.169.254", want: false},
+		{address: "172.16.0.1", want: false},
+		{address: "192.168.1.1", want: false},
+		{address: "198.18.0.1", want: false},
+		{address: "224.0.0.1", want: false},
+		{address: "255.255.255.255", want: false},
+		{address: "::1", want: false},
+		{address: "::ffff:10.0.0.1", want: false},
+		{address: "64:ff9b::a00:1", want: false},
+		{address: "fd00::1", want: false},
+		{address: "fe80::1", want: false},
+		{address: "ff02::1", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, isPublicAddress(net.ParseIP(test.address)), test.address)
+	}
+}
@@ -6,6 +6,8 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.IdentityProviderService/ListIdentityProviders": true,
 	"/memos.api.v1.AuthService/CreateSession":                     true,
 	"/memos.api.v1.AuthService/GetCurrentSession":                 true,
+	"/memos.api.v1.AuthService/RequestPasswordReset":              true,
+	"/memos.api.v1.AuthService/ResetPassword":                     true,
 	"/memos.api.v1.UserService/CreateUser":                        true,
 	"/memos.api.v1.UserService/GetUser":                           true,
 	"/memos.api.v1.UserService/GetUserAvatar":                     true,
@@ -14,6 +16,10 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/ListMemoChanges":                   true,
+	"/memos.api.v1.MemoService/GetMemoGraph":                      true,
+	"/memos.api.v1.MemoService/ListTasks":                         true,
+	"/memos.api.v1.MemoService/ListTags":                          true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
 	"/memos.api.v1.AttachmentService/GetAttachmentBinary":         true,
 }
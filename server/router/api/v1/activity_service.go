@@ -75,6 +75,16 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Type {
 	case store.ActivityTypeMemoComment:
 		activityType = v1pb.Activity_MEMO_COMMENT
+	case store.ActivityTypeMemoGrant:
+		activityType = v1pb.Activity_MEMO_GRANT
+	case store.ActivityTypeMemoReminder:
+		activityType = v1pb.Activity_MEMO_REMINDER
+	case store.ActivityTypeMemoMention:
+		activityType = v1pb.Activity_MEMO_MENTION
+	case store.ActivityTypeMemoReaction:
+		activityType = v1pb.Activity_MEMO_REACTION
+	case store.ActivityTypeMemoReference:
+		activityType = v1pb.Activity_MEMO_REFERENCE
 	default:
 		activityType = v1pb.Activity_TYPE_UNSPECIFIED
 	}
@@ -118,6 +128,9 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
 		}
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo does not exist")
+		}
 		v2Payload.Payload = &v1pb.ActivityPayload_MemoComment{
 			MemoComment: &v1pb.ActivityMemoCommentPayload{
 				Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
@@ -125,5 +138,106 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			},
 		}
 	}
+	if payload.MemoGrant != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoGrant.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoGrant{
+			MemoGrant: &v1pb.ActivityMemoGrantPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				Role: payload.MemoGrant.Role,
+			},
+		}
+	}
+	if payload.MemoReminder != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReminder.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReminder{
+			MemoReminder: &v1pb.ActivityMemoReminderPayload{
+				Memo:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				DueTime: timestamppb.New(time.Unix(payload.MemoReminder.DueTs, 0)),
+				Content: payload.MemoReminder.Content,
+			},
+		}
+	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				User: fmt.Sprintf("%s%d", UserNamePrefix, payload.MemoMention.UserId),
+			},
+		}
+	}
+	if payload.MemoReaction != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReaction.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReaction{
+			MemoReaction: &v1pb.ActivityMemoReactionPayload{
+				Memo:         fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				ReactionType: payload.MemoReaction.ReactionType,
+			},
+		}
+	}
+	if payload.MemoReference != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReference.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReference.RelatedMemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
+		}
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo does not exist")
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReference{
+			MemoReference: &v1pb.ActivityMemoReferencePayload{
+				Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				RelatedMemo: fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID),
+			},
+		}
+	}
 	return v2Payload, nil
 }
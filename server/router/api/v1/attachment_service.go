@@ -134,21 +134,18 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 		pageSize = 1000
 	}
 
-	// Parse page token for offset
-	offset := 0
-	if request.PageToken != "" {
-		// Simple implementation: page token is the offset as string
-		// In production, you might want to use encrypted tokens
-		if parsed, err := fmt.Sscanf(request.PageToken, "%d", &offset); err != nil || parsed != 1 {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
-		}
-	}
-
 	findAttachment := &store.FindAttachment{
 		CreatorID: &user.ID,
-		Limit:     &pageSize,
-		Offset:    &offset,
 	}
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		findAttachment.Cursor = convertCursorToStore(pageToken.Cursor)
+	}
+	pageSizePlusOne := pageSize + 1
+	findAttachment.Limit = &pageSizePlusOne
 
 	// Basic filter support for common cases
 	if request.Filter != "" {
@@ -167,6 +164,19 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
 	}
 
+	nextPageToken := ""
+	if len(attachments) == pageSizePlusOne {
+		attachments = attachments[:pageSize]
+		lastAttachment := attachments[len(attachments)-1]
+		nextPageToken, err = getCursorPageToken(pageSize, &store.Cursor{
+			Timestamp: lastAttachment.UpdatedTs,
+			ID:        lastAttachment.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+
 	// Apply type filter if specified
 	if request.Filter != "" && strings.HasPrefix(request.Filter, "type=") {
 		filterType := strings.TrimPrefix(request.Filter, "type=")
@@ -189,10 +199,7 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 	// In a full implementation, you'd want a separate count query
 	response.TotalSize = int32(len(response.Attachments))
 
-	// Set next page token if we got the full page size (indicating there might be more)
-	if len(attachments) == pageSize {
-		response.NextPageToken = fmt.Sprintf("%d", offset+pageSize)
-	}
+	response.NextPageToken = nextPageToken
 
 	return response, nil
 }
@@ -235,7 +242,14 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", attachment.MemoID)
 		}
-		if memo != nil && memo.Visibility != store.Public {
+		shared := false
+		if memo != nil {
+			// A valid share token of the memo grants access to its attachments without authentication.
+			if shared, err = s.isMemoSharedWithToken(ctx, memo.ID, request.ShareToken); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check share token: %v", err)
+			}
+		}
+		if !shared && (memo == nil || memo.Visibility != store.Public) {
 			user, err := s.GetCurrentUser(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -243,8 +257,23 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 			if user == nil {
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
-			if memo.Visibility == store.Private && user.ID != attachment.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+			// Attachments of memos in the trash are treated as private.
+			if (memo == nil || memo.Visibility == store.Private || memo.Visibility == store.Group) && user.ID != attachment.CreatorID {
+				granted := false
+				if memo != nil {
+					role, err := s.getMemoGrantRole(ctx, memo.ID, user.ID)
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "failed to get memo grant: %v", err)
+					}
+					isMember, err := s.isMemoGroupMember(ctx, memo, user.ID)
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "failed to check memo groups: %v", err)
+					}
+					granted = role != "" || isMember
+				}
+				if !granted {
+					return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				}
 			}
 		}
 	}
@@ -19,6 +19,10 @@ const (
 	KeyID = "v1"
 	// AccessTokenAudienceName is the audience name of the access token.
 	AccessTokenAudienceName = "user.access-token"
+	// PasswordResetAudienceName is the audience name of the token of password reset links.
+	PasswordResetAudienceName = "user.password-reset"
+	// InvitationAudienceName is the audience name of the token of invitation links.
+	InvitationAudienceName = "user.invitation"
 	// SessionSlidingDuration is the sliding expiration duration for user sessions (2 weeks).
 	// Sessions are considered valid if last_accessed_time + SessionSlidingDuration > current_time.
 	SessionSlidingDuration = 14 * 24 * time.Hour
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+// passwordResetDuration is how long password reset links are valid.
+const passwordResetDuration = time.Hour
+
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	smtpSetting, err := s.Store.GetWorkspaceSMTPSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace SMTP setting: %v", err)
+	}
+	if !smtpSetting.EnableEmail {
+		return nil, status.Errorf(codes.FailedPrecondition, "sending email is not enabled")
+	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+
+	emailAddress := strings.TrimSpace(request.Email)
+	if emailAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	normalStatus := store.Normal
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{Email: &emailAddress, RowStatus: &normalStatus})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	messages := []*email.Message{}
+	for _, user := range users {
+		// Users who can't sign in with a password can't reset it either.
+		if workspaceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+			continue
+		}
+		token, err := generateToken(user.Username, user.ID, PasswordResetAudienceName, time.Now().Add(passwordResetDuration), s.getPasswordResetKey(user))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password reset token: %v", err)
+		}
+		message, err := email.NewMessage([]string{user.Email}, "Reset your Memos password", email.TemplatePasswordReset, &email.PasswordResetData{
+			Username:  user.Username,
+			Link:      notification.GetLink(s.Profile.InstanceURL, "auth/reset-password?token="+url.QueryEscape(token)),
+			ExpiresIn: "1 hour",
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build email: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	// The emails are sent in the background, so that the response time doesn't reveal whether there are users with the address.
+	go func() {
+		for _, message := range messages {
+			if err := notification.SendEmail(context.WithoutCancel(ctx), s.Store, message); err != nil {
+				slog.Error("failed to send password reset email", "err", err)
+			}
+		}
+	}()
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if request.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new password is required")
+	}
+	user, err := s.parsePasswordResetToken(ctx, request.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid password reset token: %v", err)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	// The sessions and access tokens are revoked with the password change, so that whoever had them is signed out.
+	if err := s.Store.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:           user.ID,
+			PasswordHash: &passwordHashStr,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update user")
+		}
+		if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSetting_SESSIONS,
+			Value:  &storepb.UserSetting_Sessions{Sessions: &storepb.SessionsUserSetting{}},
+		}); err != nil {
+			return errors.Wrap(err, "failed to revoke sessions")
+		}
+		if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSetting_ACCESS_TOKENS,
+			Value:  &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{}},
+		}); err != nil {
+			return errors.Wrap(err, "failed to revoke access tokens")
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset password: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getPasswordResetKey returns the key password reset tokens of the user are signed with.
+// It changes with the password, so that a token can only be used once.
+func (s *APIV1Service) getPasswordResetKey(user *store.User) []byte {
+	return []byte(s.Secret + user.PasswordHash)
+}
+
+// parsePasswordResetToken returns the user whose password the token resets.
+func (s *APIV1Service) parsePasswordResetToken(ctx context.Context, token string) (*store.User, error) {
+	var user *store.User
+	claims := &ClaimsMessage{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		userID, err := util.ConvertStringToInt32(claims.Subject)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid subject")
+		}
+		user, err = s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus != store.Normal {
+			return nil, errors.Errorf("user %d not found", userID)
+		}
+		return s.getPasswordResetKey(user), nil
+	}, jwt.WithAudience(PasswordResetAudienceName), jwt.WithIssuer(Issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"encoding/base64"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -46,6 +47,27 @@ func getPageToken(limit int, offset int) (string, error) {
 	})
 }
 
+// getCursorPageToken returns a page token that continues right after the given cursor.
+func getCursorPageToken(limit int, cursor *store.Cursor) (string, error) {
+	return marshalPageToken(&v1pb.PageToken{
+		Limit: int32(limit),
+		Cursor: &v1pb.PageToken_Cursor{
+			Timestamp: cursor.Timestamp,
+			Id:        cursor.ID,
+		},
+	})
+}
+
+func convertCursorToStore(cursor *v1pb.PageToken_Cursor) *store.Cursor {
+	if cursor == nil {
+		return nil
+	}
+	return &store.Cursor{
+		Timestamp: cursor.Timestamp,
+		ID:        cursor.Id,
+	}
+}
+
 func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
@@ -68,3 +90,8 @@ func unmarshalPageToken(s string, pageToken *v1pb.PageToken) error {
 func isSuperUser(user *store.User) bool {
 	return user.Role == store.RoleAdmin || user.Role == store.RoleHost
 }
+
+// getMemoVisibilityFilter returns the filter matching the memos the user is allowed to see.
+func getMemoVisibilityFilter(userID int32) string {
+	return fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || granted_to(%d) || in_groups_of(%d)`, userID, userID, userID)
+}
@@ -0,0 +1,349 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	groups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	response := &v1pb.ListGroupsResponse{
+		Groups: []*v1pb.Group{},
+	}
+	for _, group := range groups {
+		groupMessage, err := s.convertGroupFromStore(ctx, group)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+		}
+		response.Groups = append(response.Groups, groupMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.Group, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	currentUser, err := s.checkGroupAdminPermission(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+
+	name := strings.TrimSpace(request.Group.DisplayName)
+	if err := s.validateGroupName(ctx, name, 0); err != nil {
+		return nil, err
+	}
+	memberIDs, err := s.extractGroupMemberIDs(ctx, request.Group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.Store.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID:   currentUser.ID,
+		Name:        name,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	if err := s.setGroupMembers(ctx, group.ID, memberIDs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set group members: %v", err)
+	}
+
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.Group, error) {
+	if _, err := s.checkGroupAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	group, err := s.getGroupByName(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateUserGroup{
+		ID:        group.ID,
+		UpdatedTs: &updatedTs,
+	}
+	var memberIDs []int32
+	updateMembers := false
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "display_name":
+			name := strings.TrimSpace(request.Group.DisplayName)
+			if err := s.validateGroupName(ctx, name, group.ID); err != nil {
+				return nil, err
+			}
+			update.Name = &name
+		case "description":
+			update.Description = &request.Group.Description
+		case "members":
+			memberIDs, err = s.extractGroupMemberIDs(ctx, request.Group.Members)
+			if err != nil {
+				return nil, err
+			}
+			updateMembers = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	group, err = s.Store.UpdateUserGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	if updateMembers {
+		if err := s.setGroupMembers(ctx, group.ID, memberIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set group members: %v", err)
+		}
+	}
+
+	groupMessage, err := s.convertGroupFromStore(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	if _, err := s.checkGroupAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: group.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkGroupAdminPermission returns the current user if they are allowed to manage groups.
+func (s *APIV1Service) checkGroupAdminPermission(ctx context.Context) (*store.User, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+func (s *APIV1Service) getGroupByName(ctx context.Context, name string) (*store.UserGroup, error) {
+	groupID, err := ExtractGroupIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	group, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &groupID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return group, nil
+}
+
+// validateGroupName checks that the name is set and not used by another group than groupID.
+func (s *APIV1Service) validateGroupName(ctx context.Context, name string, groupID int32) error {
+	if name == "" {
+		return status.Errorf(codes.InvalidArgument, "display name is required")
+	}
+	existing, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{Name: &name})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if existing != nil && existing.ID != groupID {
+		return status.Errorf(codes.AlreadyExists, "group %q already exists", name)
+	}
+	return nil
+}
+
+// extractGroupMemberIDs resolves the member names to the IDs of existing users.
+func (s *APIV1Service) extractGroupMemberIDs(ctx context.Context, members []string) ([]int32, error) {
+	memberIDs := []int32{}
+	for _, member := range members {
+		userID, err := ExtractUserIDFromName(member)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member name: %v", err)
+		}
+		if slices.Contains(memberIDs, userID) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", member)
+		}
+		memberIDs = append(memberIDs, userID)
+	}
+	return memberIDs, nil
+}
+
+// setGroupMembers replaces the members of the group with the given users.
+func (s *APIV1Service) setGroupMembers(ctx context.Context, groupID int32, memberIDs []int32) error {
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &groupID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list group members")
+	}
+	for _, member := range members {
+		if slices.Contains(memberIDs, member.UserID) {
+			continue
+		}
+		if err := s.Store.DeleteUserGroupMember(ctx, &store.DeleteUserGroupMember{GroupID: &groupID, UserID: &member.UserID}); err != nil {
+			return errors.Wrap(err, "failed to delete group member")
+		}
+	}
+	for _, userID := range memberIDs {
+		if _, err := s.Store.UpsertUserGroupMember(ctx, &store.UserGroupMember{GroupID: groupID, UserID: userID}); err != nil {
+			return errors.Wrap(err, "failed to upsert group member")
+		}
+	}
+	return nil
+}
+
+// isMemoGroupMember reports whether the user is a member of one of the groups the memo targets.
+func (s *APIV1Service) isMemoGroupMember(ctx context.Context, memo *store.Memo, userID int32) (bool, error) {
+	if memo.Visibility != store.Group {
+		return false, nil
+	}
+	memoGroups, err := s.Store.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+	if err != nil {
+		return false, err
+	}
+	for _, memoGroup := range memoGroups {
+		members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &memoGroup.GroupID, UserID: &userID})
+		if err != nil {
+			return false, err
+		}
+		if len(members) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveMemoGroupIDs resolves the groups a memo targets. Regular users can only target groups they belong to.
+func (s *APIV1Service) resolveMemoGroupIDs(ctx context.Context, user *store.User, groupNames []string) ([]int32, error) {
+	if len(groupNames) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "memos with group visibility need at least one group")
+	}
+	groupIDs := []int32{}
+	for _, groupName := range groupNames {
+		group, err := s.getGroupByName(ctx, groupName)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(groupIDs, group.ID) {
+			continue
+		}
+		if !isSuperUser(user) {
+			members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID, UserID: &user.ID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+			}
+			if len(members) == 0 {
+				return nil, status.Errorf(codes.PermissionDenied, "not a member of group %q", groupName)
+			}
+		}
+		groupIDs = append(groupIDs, group.ID)
+	}
+	return groupIDs, nil
+}
+
+// setMemoGroups replaces the groups targeted by the memo.
+func (s *APIV1Service) setMemoGroups(ctx context.Context, memoID int32, groupIDs []int32) error {
+	if err := s.Store.DeleteMemoGroup(ctx, &store.DeleteMemoGroup{MemoID: &memoID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo groups")
+	}
+	for _, groupID := range groupIDs {
+		if _, err := s.Store.UpsertMemoGroup(ctx, &store.MemoGroup{MemoID: memoID, GroupID: groupID}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo group")
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) convertGroupFromStore(ctx context.Context, group *store.UserGroup) (*v1pb.Group, error) {
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	if err != nil {
+		return nil, err
+	}
+	groupMessage := &v1pb.Group{
+		Name:        fmt.Sprintf("%s%d", GroupNamePrefix, group.ID),
+		DisplayName: group.Name,
+		Description: group.Description,
+		Members:     []string{},
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, group.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+	}
+	for _, member := range members {
+		groupMessage.Members = append(groupMessage.Members, fmt.Sprintf("%s%d", UserNamePrefix, member.UserID))
+	}
+	return groupMessage, nil
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/filter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -39,6 +42,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	}
 
 	var limit, offset int
+	var cursor *store.Cursor
 	if request.PageToken != "" {
 		var pageToken v1pb.PageToken
 		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
@@ -46,6 +50,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		cursor = convertCursorToStore(pageToken.Cursor)
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -59,10 +64,28 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 
 	findInbox := &store.FindInbox{
 		ReceiverID: &userID,
-		Limit:      &limitPlusOne,
-		Offset:     &offset,
+	}
+	if request.Filter != "" {
+		if err := s.validateInboxFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		findInbox.Filters = append(findInbox.Filters, request.Filter)
+	}
+	// The total size is only counted for the first page, the following pages rely on the first count or GetInboxStats.
+	totalSize := int32(0)
+	if request.PageToken == "" {
+		inboxStats, err := s.Store.ListInboxStats(ctx, findInbox)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list inbox stats: %v", err)
+		}
+		for _, stats := range inboxStats {
+			totalSize += stats.Count
+		}
 	}
 
+	findInbox.Limit = &limitPlusOne
+	findInbox.Offset = &offset
+	findInbox.Cursor = cursor
 	inboxes, err := s.Store.ListInboxes(ctx, findInbox)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
@@ -72,7 +95,11 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	nextPageToken := ""
 	if len(inboxes) == limitPlusOne {
 		inboxes = inboxes[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		lastInbox := inboxes[len(inboxes)-1]
+		nextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			Timestamp: lastInbox.CreatedTs,
+			ID:        lastInbox.ID,
+		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
 		}
@@ -88,11 +115,96 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	response := &v1pb.ListInboxesResponse{
 		Inboxes:       inboxMessages,
 		NextPageToken: nextPageToken,
-		TotalSize:     int32(len(inboxMessages)), // For now, use actual returned count
+		TotalSize:     totalSize,
 	}
 	return response, nil
 }
 
+func (s *APIV1Service) BatchUpdateInboxes(ctx context.Context, request *v1pb.BatchUpdateInboxesRequest) (*v1pb.BatchUpdateInboxesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent name %q: %v", request.Parent, err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the receiver can update their inboxes, as in UpdateInbox.
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot update inboxes for user %q", request.Parent)
+	}
+	if request.Status == v1pb.Inbox_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "status cannot be unspecified")
+	}
+
+	findInbox := &store.FindInbox{
+		ReceiverID: &userID,
+	}
+	if request.Filter != "" {
+		if err := s.validateInboxFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		findInbox.Filters = append(findInbox.Filters, request.Filter)
+	}
+	updatedCount, err := s.Store.UpdateInboxes(ctx, &store.UpdateInboxes{
+		Find:   findInbox,
+		Status: convertInboxStatusToStore(request.Status),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update inboxes: %v", err)
+	}
+
+	return &v1pb.BatchUpdateInboxesResponse{
+		UpdatedCount: int32(updatedCount),
+	}, nil
+}
+
+func (s *APIV1Service) GetInboxStats(ctx context.Context, request *v1pb.GetInboxStatsRequest) (*v1pb.InboxStats, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent name %q: %v", request.Parent, err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only allow hosts and admins to access other users' inbox stats, as in ListInboxes.
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot access inbox stats for user %q", request.Parent)
+	}
+
+	unreadStatus := store.UNREAD
+	inboxStats, err := s.Store.ListInboxStats(ctx, &store.FindInbox{
+		ReceiverID: &userID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list inbox stats: %v", err)
+	}
+
+	stats := &v1pb.InboxStats{
+		UnreadTypeCount: map[string]int32{},
+	}
+	for _, inboxStats := range inboxStats {
+		inboxType := v1pb.Inbox_Type(inboxStats.Type)
+		// Inboxes of unknown types aren't listed either.
+		if inboxType == v1pb.Inbox_TYPE_UNSPECIFIED {
+			continue
+		}
+		stats.UnreadCount += inboxStats.Count
+		stats.UnreadTypeCount[inboxType.String()] += inboxStats.Count
+	}
+	return stats, nil
+}
+
 func (s *APIV1Service) UpdateInbox(ctx context.Context, request *v1pb.UpdateInboxRequest) (*v1pb.Inbox, error) {
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
@@ -130,18 +242,35 @@ func (s *APIV1Service) UpdateInbox(ctx context.Context, request *v1pb.UpdateInbo
 	}
 
 	update := &store.UpdateInbox{
-		ID: inboxID,
+		ID:     inboxID,
+		Status: existingInbox.Status,
 	}
+	var snoozeTs int64
 	for _, field := range request.UpdateMask.Paths {
 		if field == "status" {
 			if request.Inbox.Status == v1pb.Inbox_STATUS_UNSPECIFIED {
 				return nil, status.Errorf(codes.InvalidArgument, "status cannot be unspecified")
 			}
 			update.Status = convertInboxStatusToStore(request.Inbox.Status)
+		} else if field == "snooze_time" {
+			if existingInbox.Message.Type != storepb.InboxMessage_REMINDER {
+				return nil, status.Errorf(codes.InvalidArgument, "only reminders can be snoozed")
+			}
+			if request.Inbox.SnoozeTime == nil || !request.Inbox.SnoozeTime.AsTime().After(time.Now()) {
+				return nil, status.Errorf(codes.InvalidArgument, "snooze time must be in the future")
+			}
+			snoozeTs = request.Inbox.SnoozeTime.AsTime().Unix()
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "unsupported field in update mask: %q", field)
 		}
 	}
+	// Snoozing archives the notification, the reminder is sent to the inbox again once the snooze time has passed.
+	if snoozeTs > 0 {
+		if err := s.snoozeMemoReminder(ctx, existingInbox, snoozeTs); err != nil {
+			return nil, err
+		}
+		update.Status = store.ARCHIVED
+	}
 
 	inbox, err := s.Store.UpdateInbox(ctx, update)
 	if err != nil {
@@ -191,7 +320,70 @@ func (s *APIV1Service) DeleteInbox(ctx context.Context, request *v1pb.DeleteInbo
 	return &emptypb.Empty{}, nil
 }
 
+// snoozeMemoReminder snoozes the memo reminder the inbox notification was sent for until the given timestamp.
+func (s *APIV1Service) snoozeMemoReminder(ctx context.Context, inbox *store.Inbox, snoozeTs int64) error {
+	if inbox.Message.ActivityId == nil {
+		return status.Errorf(codes.FailedPrecondition, "reminder has no activity")
+	}
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get activity: %v", err)
+	}
+	if activity == nil || activity.Payload.GetMemoReminder() == nil {
+		return status.Errorf(codes.FailedPrecondition, "reminder activity not found")
+	}
+	payload := activity.Payload.GetMemoReminder()
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &payload.MemoId, ExcludeContent: true})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return status.Errorf(codes.FailedPrecondition, "memo of the reminder not found")
+	}
+	for _, reminder := range memo.Payload.Reminders {
+		if reminder.DueTs == payload.DueTs && reminder.Content == payload.Content {
+			reminder.SnoozeTs = snoozeTs
+			reminder.Notified = false
+			if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: memo.Payload}); err != nil {
+				return status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			}
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "reminder has been removed from the memo")
+}
+
+// validateInboxFilter validates the inbox filter string.
+func (s *APIV1Service) validateInboxFilter(_ context.Context, filterStr string) error {
+	parsedExpr, err := filter.Parse(filterStr, filter.InboxFilterCELAttributes...)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse filter")
+	}
+	convertCtx := filter.NewConvertContext()
+
+	// Determine the dialect based on the actual database driver
+	var dialect filter.SQLDialect
+	switch s.Profile.Driver {
+	case "mysql":
+		dialect = &filter.MySQLDialect{}
+	case "postgres":
+		dialect = &filter.PostgreSQLDialect{}
+	default:
+		dialect = &filter.SQLiteDialect{}
+	}
+
+	converter := filter.NewCommonSQLConverter(dialect)
+	if err := converter.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+		return errors.Wrap(err, "failed to convert filter to SQL")
+	}
+	return nil
+}
+
 func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
+	senders := []string{}
+	for _, senderID := range inbox.Message.SenderIds {
+		senders = append(senders, fmt.Sprintf("%s%d", UserNamePrefix, senderID))
+	}
 	return &v1pb.Inbox{
 		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
 		Sender:     fmt.Sprintf("%s%d", UserNamePrefix, inbox.SenderID),
@@ -200,6 +392,7 @@ func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 		CreateTime: timestamppb.New(time.Unix(inbox.CreatedTs, 0)),
 		Type:       v1pb.Inbox_Type(inbox.Message.Type),
 		ActivityId: inbox.Message.ActivityId,
+		Senders:    senders,
 	}
 }
 
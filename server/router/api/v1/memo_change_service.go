@@ -0,0 +1,418 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	syncTokenPrefix = "memo_change:"
+	// memoChangeSettleDelay is how long a change is held back from sync on databases whose transactions can commit out of id order.
+	memoChangeSettleDelay = 10 * time.Second
+)
+
+func (s *APIV1Service) ListMemoChanges(ctx context.Context, request *v1pb.ListMemoChangesRequest) (*v1pb.ListMemoChangesResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	now := time.Now()
+	createdBefore := s.getMemoChangeSettledBefore(now)
+	if request.SyncToken == "" {
+		latestChange, err := s.Store.GetLatestMemoChange(ctx, &store.FindMemoChange{CreatedBefore: createdBefore})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get latest memo change: %v", err)
+		}
+		latestChangeID := int32(0)
+		if latestChange != nil {
+			latestChangeID = latestChange.ID
+		}
+		return &v1pb.ListMemoChangesResponse{
+			NextSyncToken: getSyncToken(latestChangeID, now),
+		}, nil
+	}
+	afterID, issuedAt, err := parseSyncToken(request.SyncToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync token: %v", err)
+	}
+	// The changes after the token may have been pruned from the change log since it was issued.
+	if issuedAt.Before(now.Add(-store.MemoChangeRetention + memoChangeSettleDelay)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sync token expired, do a full sync and start over with an empty sync token")
+	}
+
+	limit := int(request.PageSize)
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	changes, err := s.Store.ListMemoChanges(ctx, &store.FindMemoChange{
+		AfterID:       &afterID,
+		CreatedBefore: createdBefore,
+		Limit:         &limitPlusOne,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo changes: %v", err)
+	}
+	response := &v1pb.ListMemoChangesResponse{
+		Memos:       []*v1pb.Memo{},
+		Relations:   []*v1pb.MemoRelation{},
+		Reactions:   []*v1pb.Reaction{},
+		Attachments: []*v1pb.Attachment{},
+		Tombstones:  []*v1pb.ListMemoChangesResponse_Tombstone{},
+	}
+	if len(changes) == limitPlusOne {
+		changes = changes[:limit]
+		response.HasMore = true
+	}
+	nextSyncID := afterID
+	if len(changes) > 0 {
+		nextSyncID = changes[len(changes)-1].ID
+	}
+	response.NextSyncToken = getSyncToken(nextSyncID, now)
+
+	resolver := &memoChangeResolver{
+		service:     s,
+		currentUser: currentUser,
+		memos:       map[int32]*store.Memo{},
+		granted:     map[int32]bool{},
+	}
+	// Grant and group changes only matter to the grantee and the group members, for whom the memo itself appears or disappears.
+	groupMembers := map[string]bool{}
+	memoChanges := []*store.MemoChange{}
+	for _, change := range changes {
+		if change.Type == store.MemoChangeGrant || change.Type == store.MemoChangeGroup {
+			if currentUser == nil {
+				continue
+			}
+			if change.Type == store.MemoChangeGrant && change.ResourceKey != fmt.Sprintf("%d", currentUser.ID) {
+				continue
+			}
+			if change.Type == store.MemoChangeGroup {
+				isMember, ok := groupMembers[change.ResourceKey]
+				if !ok {
+					groupID, err := strconv.ParseInt(change.ResourceKey, 10, 32)
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "invalid group change key: %v", err)
+					}
+					memberGroupID := int32(groupID)
+					members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &memberGroupID, UserID: &currentUser.ID})
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+					}
+					isMember = len(members) > 0
+					groupMembers[change.ResourceKey] = isMember
+				}
+				if !isMember {
+					continue
+				}
+			}
+			resolver.granted[change.MemoID] = true
+			memoChange := *change
+			memoChange.Type = store.MemoChangeMemo
+			memoChange.ResourceKey = change.MemoUID
+			change = &memoChange
+		}
+		memoChanges = append(memoChanges, change)
+	}
+	changes = memoChanges
+
+	// Only the latest change of every resource matters, since changes are resolved against the current state.
+	latestChanges := map[string]int{}
+	for index, change := range changes {
+		latestChanges[getMemoChangeResourceID(change)] = index
+	}
+	for index, change := range changes {
+		if latestChanges[getMemoChangeResourceID(change)] != index {
+			continue
+		}
+		if err := resolver.resolve(ctx, change, response); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve memo change: %v", err)
+		}
+	}
+	return response, nil
+}
+
+// memoChangeResolver turns memo changes into the current state of the changed resources, as seen by the current user.
+type memoChangeResolver struct {
+	service     *APIV1Service
+	currentUser *store.User
+	// memos caches the current memos by id, nil if the memo is deleted or in the trash.
+	memos map[int32]*store.Memo
+	// granted are the ids of the memos whose grants to the current user, or whose groups of the current user, changed,
+	// so the user may have seen them.
+	granted map[int32]bool
+}
+
+func (r *memoChangeResolver) resolve(ctx context.Context, change *store.MemoChange, response *v1pb.ListMemoChangesResponse) error {
+	// Users that could never see the memo don't learn anything about it.
+	canView, err := r.canViewMemo(ctx, change.MemoID, change.CreatorID, change.Visibility)
+	if err != nil {
+		return err
+	}
+	if !canView && !r.granted[change.MemoID] {
+		memo, err := r.getVisibleMemo(ctx, change.MemoID)
+		if err != nil || memo == nil {
+			return err
+		}
+	}
+
+	tombstone := &v1pb.ListMemoChangesResponse_Tombstone{
+		DeleteTime: timestamppb.New(time.Unix(change.CreatedTs, 0)),
+	}
+	switch change.Type {
+	case store.MemoChangeMemo:
+		memo, err := r.getVisibleMemo(ctx, change.MemoID)
+		if err != nil {
+			return err
+		}
+		// A memo whose uid changed is deleted under its old name.
+		if memo != nil && memo.UID == change.ResourceKey {
+			memoMessage, err := r.convertMemo(ctx, memo)
+			if err != nil {
+				return err
+			}
+			response.Memos = append(response.Memos, memoMessage)
+			return nil
+		}
+		tombstone.Type = v1pb.ListMemoChangesResponse_Tombstone_MEMO
+		tombstone.Name = fmt.Sprintf("%s%s", MemoNamePrefix, change.ResourceKey)
+	case store.MemoChangeRelation:
+		relationType, relatedMemoUID, ok := store.ParseMemoRelationChangeKey(change.ResourceKey)
+		if !ok {
+			return errors.Errorf("invalid relation change key %q", change.ResourceKey)
+		}
+		relationMessage, err := r.getVisibleMemoRelation(ctx, change.MemoID, relationType, relatedMemoUID)
+		if err != nil {
+			return err
+		}
+		if relationMessage != nil {
+			response.Relations = append(response.Relations, relationMessage)
+			return nil
+		}
+		tombstone.Type = v1pb.ListMemoChangesResponse_Tombstone_RELATION
+		tombstone.Relation = &v1pb.MemoRelation{
+			Memo:        &v1pb.MemoRelation_Memo{Name: fmt.Sprintf("%s%s", MemoNamePrefix, change.MemoUID)},
+			RelatedMemo: &v1pb.MemoRelation_Memo{Name: fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemoUID)},
+			Type:        convertMemoRelationTypeFromStore(relationType),
+			CustomType:  getMemoRelationCustomType(relationType),
+		}
+	case store.MemoChangeReaction:
+		reactionID, err := strconv.ParseInt(change.ResourceKey, 10, 32)
+		if err != nil {
+			return errors.Wrapf(err, "invalid reaction change key %q", change.ResourceKey)
+		}
+		reaction, err := r.getVisibleReaction(ctx, change.MemoID, int32(reactionID))
+		if err != nil {
+			return err
+		}
+		if reaction != nil {
+			response.Reactions = append(response.Reactions, convertReactionFromStore(reaction))
+			return nil
+		}
+		tombstone.Type = v1pb.ListMemoChangesResponse_Tombstone_REACTION
+		tombstone.Name = fmt.Sprintf("%s%s", ReactionNamePrefix, change.ResourceKey)
+	case store.MemoChangeAttachment:
+		attachment, err := r.getVisibleAttachment(ctx, change.MemoID, change.ResourceKey)
+		if err != nil {
+			return err
+		}
+		if attachment != nil {
+			response.Attachments = append(response.Attachments, convertAttachmentFromStore(attachment))
+			return nil
+		}
+		tombstone.Type = v1pb.ListMemoChangesResponse_Tombstone_ATTACHMENT
+		tombstone.Name = fmt.Sprintf("%s%s", AttachmentNamePrefix, change.ResourceKey)
+	default:
+		return nil
+	}
+	response.Tombstones = append(response.Tombstones, tombstone)
+	return nil
+}
+
+// getVisibleMemo returns the memo if it still exists and the current user can see it.
+func (r *memoChangeResolver) getVisibleMemo(ctx context.Context, memoID int32) (*store.Memo, error) {
+	memo, ok := r.memos[memoID]
+	if !ok {
+		var err error
+		memo, err = r.service.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		r.memos[memoID] = memo
+	}
+	if memo == nil {
+		return nil, nil
+	}
+	canView, err := r.canViewMemo(ctx, memo.ID, memo.CreatorID, memo.Visibility)
+	if err != nil || !canView {
+		return nil, err
+	}
+	return memo, nil
+}
+
+func (r *memoChangeResolver) convertMemo(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := r.service.Store.ListReactions(ctx, &store.FindReaction{
+		ContentID: &memoName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := r.service.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	return r.service.convertMemoFromStore(ctx, memo, reactions, attachments)
+}
+
+func (r *memoChangeResolver) getVisibleMemoRelation(ctx context.Context, memoID int32, relationType store.MemoRelationType, relatedMemoUID string) (*v1pb.MemoRelation, error) {
+	memo, err := r.getVisibleMemo(ctx, memoID)
+	if err != nil || memo == nil {
+		return nil, err
+	}
+	relatedMemo, err := r.service.Store.GetMemo(ctx, &store.FindMemo{UID: &relatedMemoUID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get related memo")
+	}
+	if relatedMemo == nil {
+		return nil, nil
+	}
+	if relatedMemo, err = r.getVisibleMemo(ctx, relatedMemo.ID); err != nil || relatedMemo == nil {
+		return nil, err
+	}
+	memoRelations, err := r.service.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID:        &memo.ID,
+		RelatedMemoID: &relatedMemo.ID,
+		Type:          &relationType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	if len(memoRelations) == 0 {
+		return nil, nil
+	}
+	return r.service.convertMemoRelationFromStore(ctx, memoRelations[0])
+}
+
+func (r *memoChangeResolver) getVisibleReaction(ctx context.Context, memoID int32, reactionID int32) (*store.Reaction, error) {
+	memo, err := r.getVisibleMemo(ctx, memoID)
+	if err != nil || memo == nil {
+		return nil, err
+	}
+	reactions, err := r.service.Store.ListReactions(ctx, &store.FindReaction{ID: &reactionID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	if len(reactions) == 0 || reactions[0].ContentID != fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID) {
+		return nil, nil
+	}
+	return reactions[0], nil
+}
+
+func (r *memoChangeResolver) getVisibleAttachment(ctx context.Context, memoID int32, attachmentUID string) (*store.Attachment, error) {
+	memo, err := r.getVisibleMemo(ctx, memoID)
+	if err != nil || memo == nil {
+		return nil, err
+	}
+	attachment, err := r.service.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attachment")
+	}
+	// The attachment may have been moved to another memo since.
+	if attachment == nil || attachment.MemoID == nil || *attachment.MemoID != memo.ID {
+		return nil, nil
+	}
+	return attachment, nil
+}
+
+// canViewMemo applies the visibility rules of ListMemos to a single memo, including the grants and groups of the current user.
+func (r *memoChangeResolver) canViewMemo(ctx context.Context, memoID int32, creatorID int32, visibility store.Visibility) (bool, error) {
+	if visibility == store.Public {
+		return true, nil
+	}
+	if r.currentUser == nil {
+		return false, nil
+	}
+	if visibility == store.Protected || creatorID == r.currentUser.ID {
+		return true, nil
+	}
+	role, err := r.service.getMemoGrantRole(ctx, memoID, r.currentUser.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get memo grant")
+	}
+	if role != "" {
+		return true, nil
+	}
+	isMember, err := r.service.isMemoGroupMember(ctx, &store.Memo{ID: memoID, Visibility: visibility}, r.currentUser.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check memo groups")
+	}
+	return isMember, nil
+}
+
+// getMemoChangeResourceID identifies the resource of a change across all types.
+func getMemoChangeResourceID(change *store.MemoChange) string {
+	return fmt.Sprintf("%s/%d/%s", change.Type, change.MemoID, change.ResourceKey)
+}
+
+// getMemoChangeSettledBefore returns the timestamp changes must be recorded before to be synced, or nil if they can be synced right away.
+// Change ids are assigned when a change is written, but on MySQL and PostgreSQL concurrent transactions can commit out of id order,
+// so a change is only synced once the changes written before it had time to commit, or a token could move past them.
+// SQLite serializes its writes, so its changes always commit in id order.
+func (s *APIV1Service) getMemoChangeSettledBefore(now time.Time) *int64 {
+	if s.Profile.Driver == "sqlite" {
+		return nil
+	}
+	settledBefore := now.Add(-memoChangeSettleDelay).Unix()
+	return &settledBefore
+}
+
+// getSyncToken returns the token of the changes after the given change, issued at the given time.
+func getSyncToken(changeID int32, issuedAt time.Time) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s%d:%d", syncTokenPrefix, changeID, issuedAt.Unix())))
+}
+
+// parseSyncToken returns the change id of the token and the time it was issued.
+// Tokens issued without a time are treated as issued at the epoch, so they count as expired.
+func parseSyncToken(syncToken string) (int32, time.Time, error) {
+	b, err := base64.StdEncoding.DecodeString(syncToken)
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "failed to decode sync token")
+	}
+	token, ok := strings.CutPrefix(string(b), syncTokenPrefix)
+	if !ok {
+		return 0, time.Time{}, errors.New("malformed sync token")
+	}
+	changeID, issuedTs, hasIssuedTs := strings.Cut(token, ":")
+	id, err := strconv.ParseInt(changeID, 10, 32)
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "malformed sync token")
+	}
+	issuedAt := time.Unix(0, 0)
+	if hasIssuedTs {
+		ts, err := strconv.ParseInt(issuedTs, 10, 64)
+		if err != nil {
+			return 0, time.Time{}, errors.Wrap(err, "malformed sync token")
+		}
+		issuedAt = time.Unix(ts, 0)
+	}
+	return int32(id), issuedAt, nil
+}
@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoEditorUpdatePaths are the memo fields that users with an editor grant are allowed to update.
+var memoEditorUpdatePaths = []string{"content", "location", "attachments", "relations", "update_time"}
+
+func (s *APIV1Service) ListMemoGrants(ctx context.Context, request *v1pb.ListMemoGrantsRequest) (*v1pb.ListMemoGrantsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.Store.ListMemoGrants(ctx, &store.FindMemoGrant{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo grants: %v", err)
+	}
+	response := &v1pb.ListMemoGrantsResponse{
+		MemoGrants: []*v1pb.MemoGrant{},
+	}
+	for _, grant := range grants {
+		response.MemoGrants = append(response.MemoGrants, convertMemoGrantFromStore(memo, grant))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpsertMemoGrant(ctx context.Context, request *v1pb.UpsertMemoGrantRequest) (*v1pb.MemoGrant, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if request.MemoGrant == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo grant is required")
+	}
+	role := convertMemoGrantRoleToStore(request.MemoGrant.Role)
+	if role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %v", request.MemoGrant.Role)
+	}
+	userID, err := ExtractUserIDFromName(request.MemoGrant.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if userID == memo.CreatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot grant access to the memo creator")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	existing, err := s.Store.GetMemoGrant(ctx, &store.FindMemoGrant{MemoID: &memo.ID, UserID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo grant: %v", err)
+	}
+	grant, err := s.Store.UpsertMemoGrant(ctx, &store.MemoGrant{
+		CreatorID: currentUser.ID,
+		MemoID:    memo.ID,
+		UserID:    userID,
+		Role:      role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo grant: %v", err)
+	}
+
+	// Let the user know about new access, but not about grants that didn't change.
+	if existing == nil || existing.Role != grant.Role {
+		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: currentUser.ID,
+			Type:      store.ActivityTypeMemoGrant,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoGrant: &storepb.ActivityMemoGrantPayload{
+					MemoId: memo.ID,
+					Role:   string(grant.Role),
+				},
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create activity")
+		}
+		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   currentUser.ID,
+			ReceiverID: userID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_MEMO_GRANT,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+		}
+	}
+
+	return convertMemoGrantFromStore(memo, grant), nil
+}
+
+func (s *APIV1Service) DeleteMemoGrant(ctx context.Context, request *v1pb.DeleteMemoGrantRequest) (*emptypb.Empty, error) {
+	memoUID, userID, err := ExtractMemoGrantUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo grant name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.Store.GetMemoGrant(ctx, &store.FindMemoGrant{MemoID: &memo.ID, UserID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo grant: %v", err)
+	}
+	if grant == nil {
+		return nil, status.Errorf(codes.NotFound, "memo grant not found")
+	}
+	if err := s.Store.DeleteMemoGrant(ctx, &store.DeleteMemoGrant{ID: &grant.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo grant: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoGrantRole returns the role the user has been granted on the memo, or an empty role without a grant.
+func (s *APIV1Service) getMemoGrantRole(ctx context.Context, memoID int32, userID int32) (store.MemoGrantRole, error) {
+	grant, err := s.Store.GetMemoGrant(ctx, &store.FindMemoGrant{MemoID: &memoID, UserID: &userID})
+	if err != nil {
+		return "", err
+	}
+	if grant == nil {
+		return "", nil
+	}
+	return grant.Role, nil
+}
+
+func convertMemoGrantFromStore(memo *store.Memo, grant *store.MemoGrant) *v1pb.MemoGrant {
+	return &v1pb.MemoGrant{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoGrantNamePrefix, grant.UserID),
+		User:       fmt.Sprintf("%s%d", UserNamePrefix, grant.UserID),
+		Role:       convertMemoGrantRoleFromStore(grant.Role),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, grant.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(grant.CreatedTs, 0)),
+	}
+}
+
+func convertMemoGrantRoleFromStore(role store.MemoGrantRole) v1pb.MemoGrant_Role {
+	switch role {
+	case store.MemoGrantRoleViewer:
+		return v1pb.MemoGrant_VIEWER
+	case store.MemoGrantRoleCommenter:
+		return v1pb.MemoGrant_COMMENTER
+	case store.MemoGrantRoleEditor:
+		return v1pb.MemoGrant_EDITOR
+	default:
+		return v1pb.MemoGrant_ROLE_UNSPECIFIED
+	}
+}
+
+func convertMemoGrantRoleToStore(role v1pb.MemoGrant_Role) store.MemoGrantRole {
+	switch role {
+	case v1pb.MemoGrant_VIEWER:
+		return store.MemoGrantRoleViewer
+	case v1pb.MemoGrant_COMMENTER:
+		return store.MemoGrantRoleCommenter
+	case v1pb.MemoGrant_EDITOR:
+		return store.MemoGrantRoleEditor
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultMemoGraphDepth    = 1
+	maxMemoGraphDepth        = 5
+	defaultMemoGraphMaxNodes = 100
+	maxMemoGraphMaxNodes     = 1000
+)
+
+func (s *APIV1Service) GetMemoGraph(ctx context.Context, request *v1pb.GetMemoGraphRequest) (*v1pb.MemoGraph, error) {
+	if request.Name == "" && request.Filter == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name or filter is required")
+	}
+	depth := int(request.Depth)
+	if depth <= 0 {
+		depth = defaultMemoGraphDepth
+	}
+	if depth > maxMemoGraphDepth {
+		depth = maxMemoGraphDepth
+	}
+	maxNodes := int(request.MaxNodes)
+	if maxNodes <= 0 {
+		maxNodes = defaultMemoGraphMaxNodes
+	}
+	if maxNodes > maxMemoGraphMaxNodes {
+		maxNodes = maxMemoGraphMaxNodes
+	}
+
+	memoFind := &store.FindMemo{}
+	if request.Name != "" {
+		memoUID, err := ExtractMemoUIDFromName(request.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memoFind.UID = &memoUID
+	} else {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		state := store.Normal
+		memoFind.RowStatus = &state
+		memoFind.Filters = append(memoFind.Filters, request.Filter)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	// The same visibility filter applies to the starting memos and to both ends of every relation walked.
+	var memoFilter string
+	if currentUser == nil {
+		memoFilter = `visibility == "PUBLIC"`
+	} else {
+		memoFilter = getMemoVisibilityFilter(currentUser.ID)
+		memoFind.TagAliases, err = s.Store.GetUserTagAliases(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tag aliases: %v", err)
+		}
+	}
+	memoFind.Filters = append(memoFind.Filters, memoFilter)
+	limitPlusOne := maxNodes + 1
+	memoFind.Limit = &limitPlusOne
+	startMemos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	if request.Name != "" && len(startMemos) == 0 {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	graph := &v1pb.MemoGraph{
+		Nodes: []*v1pb.MemoGraph_Node{},
+		Edges: []*v1pb.MemoGraph_Edge{},
+	}
+	nodes := map[int32]*v1pb.MemoGraph_Node{}
+	addNode := func(memo *store.Memo, depth int) error {
+		snippet, err := getMemoContentSnippet(memo.Content)
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo content snippet")
+		}
+		node := &v1pb.MemoGraph_Node{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Snippet: snippet,
+			Depth:   int32(depth),
+			Orphan:  true,
+		}
+		nodes[memo.ID] = node
+		graph.Nodes = append(graph.Nodes, node)
+		return nil
+	}
+	// getMemo looks up the memos at the other end of relations, which are nil once in the trash.
+	memos := map[int32]*store.Memo{}
+	getMemo := func(memoID int32) (*store.Memo, error) {
+		if memo, ok := memos[memoID]; ok {
+			return memo, nil
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+		if err != nil {
+			return nil, err
+		}
+		memos[memoID] = memo
+		return memo, nil
+	}
+
+	frontier := []int32{}
+	for _, memo := range startMemos {
+		if len(nodes) == maxNodes {
+			graph.Truncated = true
+			break
+		}
+		memos[memo.ID] = memo
+		if err := addNode(memo, 0); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add memo to graph: %v", err)
+		}
+		frontier = append(frontier, memo.ID)
+	}
+
+	edges := map[string]bool{}
+	for currentDepth := 0; currentDepth <= depth && len(frontier) > 0; currentDepth++ {
+		next := []int32{}
+		for _, memoID := range frontier {
+			relations, err := s.listMemoGraphRelations(ctx, memoID, memoFilter)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+			}
+			for _, relation := range relations {
+				otherID := relation.MemoID
+				if otherID == memoID {
+					otherID = relation.RelatedMemoID
+				}
+				other, err := getMemo(otherID)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+				}
+				if other == nil {
+					continue
+				}
+				nodes[memoID].Orphan = false
+				if _, ok := nodes[otherID]; !ok {
+					// Memos one hop past the depth only count to tell orphans apart.
+					if currentDepth == depth {
+						continue
+					}
+					if len(nodes) == maxNodes {
+						graph.Truncated = true
+						continue
+					}
+					if err := addNode(other, currentDepth+1); err != nil {
+						return nil, status.Errorf(codes.Internal, "failed to add memo to graph: %v", err)
+					}
+					next = append(next, otherID)
+				}
+
+				key := fmt.Sprintf("%d/%d/%s", relation.MemoID, relation.RelatedMemoID, relation.Type)
+				if edges[key] {
+					continue
+				}
+				edges[key] = true
+				graph.Edges = append(graph.Edges, &v1pb.MemoGraph_Edge{
+					Source: nodes[relation.MemoID].Name,
+					Target: nodes[relation.RelatedMemoID].Name,
+					Type:   convertMemoRelationTypeFromStore(relation.Type),
+				})
+			}
+		}
+		frontier = next
+	}
+	return graph, nil
+}
+
+// listMemoGraphRelations lists the reference and comment relations of the memo in both directions
+// where both memos match the filter.
+func (s *APIV1Service) listMemoGraphRelations(ctx context.Context, memoID int32, memoFilter string) ([]*store.MemoRelation, error) {
+	relations := []*store.MemoRelation{}
+	for _, find := range []*store.FindMemoRelation{
+		{MemoID: &memoID, MemoFilter: &memoFilter},
+		{RelatedMemoID: &memoID, MemoFilter: &memoFilter},
+	} {
+		list, err := s.Store.ListMemoRelations(ctx, find)
+		if err != nil {
+			return nil, err
+		}
+		for _, relation := range list {
+			if relation.Type != store.MemoRelationReference && relation.Type != store.MemoRelationComment {
+				continue
+			}
+			if relation.MemoID == relation.RelatedMemoID {
+				continue
+			}
+			relations = append(relations, relation)
+		}
+	}
+	return relations, nil
+}
@@ -3,6 +3,11 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,9 +15,13 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
+var memoRelationTypeNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
 func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMemoRelationsRequest) (*emptypb.Empty, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -22,18 +31,72 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
-	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
-		MemoID: &memo.ID,
-		Type:   &referenceType,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	previousReferenceIDs, err := s.listMemoReferenceIDs(ctx, memo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo references: %v", err)
+	}
+	if err := s.setMemoRelations(ctx, memo, request.Relations); err != nil {
+		return nil, err
+	}
+	// References written in the content are kept whatever relations are set.
+	if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
 	}
+	if err := s.notifyMemoReferences(ctx, memo, previousReferenceIDs, user.ID); err != nil {
+		slog.Warn("Failed to notify memo references", slog.Any("err", err))
+	}
+	return &emptypb.Empty{}, nil
+}
 
-	for _, relation := range request.Relations {
+// setMemoRelations replaces the reference and custom relations of the memo.
+func (s *APIV1Service) setMemoRelations(ctx context.Context, memo *store.Memo, relations []*v1pb.MemoRelation) error {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	for _, relation := range relations {
+		if relation.Type == v1pb.MemoRelation_CUSTOM && findMemoRelationType(workspaceMemoRelatedSetting, relation.CustomType) == nil {
+			return status.Errorf(codes.InvalidArgument, "unknown relation type %q", relation.CustomType)
+		}
+	}
+
+	// Delete all reference and custom relations first.
+	existingRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memo relations")
+	}
+	deletedTypes := []store.MemoRelationType{}
+	for _, relation := range existingRelations {
+		if relation.Type == store.MemoRelationComment || slices.Contains(deletedTypes, relation.Type) {
+			continue
+		}
+		relationType := relation.Type
+		if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID: &memo.ID,
+			Type:   &relationType,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete memo relation")
+		}
+		deletedTypes = append(deletedTypes, relationType)
+	}
+
+	for _, relation := range relations {
 		// Ignore reflexive relations.
-		if request.Name == relation.RelatedMemo.Name {
+		if memoName == relation.RelatedMemo.Name {
 			continue
 		}
 		// Ignore comment relations as there's no need to update a comment's relation.
@@ -43,22 +106,25 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 		}
 		relatedMemoUID, err := ExtractMemoUIDFromName(relation.RelatedMemo.Name)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
+			return status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
 		}
 		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &relatedMemoUID})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get related memo")
+			return status.Errorf(codes.Internal, "failed to get related memo")
+		}
+		if relatedMemo == nil {
+			return status.Errorf(codes.NotFound, "related memo not found")
 		}
 		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
 			MemoID:        memo.ID,
 			RelatedMemoID: relatedMemo.ID,
-			Type:          convertMemoRelationTypeToStore(relation.Type),
+			Type:          convertMemoRelationTypeToStore(relation),
 		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
+			return status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.ListMemoRelationsRequest) (*v1pb.ListMemoRelationsResponse, error) {
@@ -70,7 +136,22 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
+	relationList, err := s.listMemoRelations(ctx, memo.ID, request.Direction)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.ListMemoRelationsResponse{
+		Relations: relationList,
+	}
+	return response, nil
+}
+
+// listMemoRelations lists the relations of a memo in the given direction that the current user can see.
+func (s *APIV1Service) listMemoRelations(ctx context.Context, memoID int32, direction v1pb.ListMemoRelationsRequest_Direction) ([]*v1pb.MemoRelation, error) {
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -79,42 +160,40 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if currentUser == nil {
 		memoFilter = `visibility == "PUBLIC"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		memoFilter = getMemoVisibilityFilter(currentUser.ID)
 	}
-	relationList := []*v1pb.MemoRelation{}
-	tempList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		MemoID:     &memo.ID,
-		MemoFilter: &memoFilter,
-	})
-	if err != nil {
-		return nil, err
+	finds := []*store.FindMemoRelation{}
+	if direction != v1pb.ListMemoRelationsRequest_INCOMING {
+		finds = append(finds, &store.FindMemoRelation{
+			MemoID:     &memoID,
+			MemoFilter: &memoFilter,
+		})
 	}
-	for _, raw := range tempList {
-		relation, err := s.convertMemoRelationFromStore(ctx, raw)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
-		}
-		relationList = append(relationList, relation)
-	}
-	tempList, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &memo.ID,
-		MemoFilter:    &memoFilter,
-	})
-	if err != nil {
-		return nil, err
+	if direction != v1pb.ListMemoRelationsRequest_OUTGOING {
+		finds = append(finds, &store.FindMemoRelation{
+			RelatedMemoID: &memoID,
+			MemoFilter:    &memoFilter,
+		})
 	}
-	for _, raw := range tempList {
-		relation, err := s.convertMemoRelationFromStore(ctx, raw)
+	relationList := []*v1pb.MemoRelation{}
+	for _, find := range finds {
+		tempList, err := s.Store.ListMemoRelations(ctx, find)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
+			return nil, err
+		}
+		for _, raw := range tempList {
+			relation, err := s.convertMemoRelationFromStore(ctx, raw)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert memo relation")
+			}
+			// Skip relations to memos in the trash.
+			if relation == nil {
+				continue
+			}
+			relationList = append(relationList, relation)
 		}
-		relationList = append(relationList, relation)
-	}
-
-	response := &v1pb.ListMemoRelationsResponse{
-		Relations: relationList,
 	}
-	return response, nil
+	return relationList, nil
 }
 
 func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRelation *store.MemoRelation) (*v1pb.MemoRelation, error) {
@@ -122,6 +201,9 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
+	if memo == nil {
+		return nil, nil
+	}
 	memoSnippet, err := getMemoContentSnippet(memo.Content)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
@@ -130,11 +212,14 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
+	if relatedMemo == nil {
+		return nil, nil
+	}
 	relatedMemoSnippet, err := getMemoContentSnippet(relatedMemo.Content)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get related memo content snippet")
 	}
-	return &v1pb.MemoRelation{
+	relation := &v1pb.MemoRelation{
 		Memo: &v1pb.MemoRelation_Memo{
 			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
 			Snippet: memoSnippet,
@@ -144,7 +229,50 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 			Snippet: relatedMemoSnippet,
 		},
 		Type: convertMemoRelationTypeFromStore(memoRelation.Type),
-	}, nil
+	}
+	if memoRelation.Type == store.MemoRelationReference {
+		relation.ContextSnippet = getMemoReferenceSnippet(memo.Content, relatedMemo.UID)
+	}
+	if relation.Type == v1pb.MemoRelation_CUSTOM {
+		relation.CustomType = string(memoRelation.Type)
+		workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+		}
+		// Relations keep their type when it's removed from the setting, only without the inverse label.
+		if relationType := findMemoRelationType(workspaceMemoRelatedSetting, relation.CustomType); relationType != nil {
+			relation.InverseLabel = relationType.InverseLabel
+		}
+	}
+	return relation, nil
+}
+
+// getMemoReferenceSnippet returns the first line of the content that references the memo, shortened around the reference.
+func getMemoReferenceSnippet(content string, memoUID string) string {
+	const (
+		snippetLength  = 128
+		snippetContext = 48
+	)
+	reference := MemoNamePrefix + memoUID
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		index := strings.Index(line, reference)
+		if index < 0 {
+			continue
+		}
+		text := []rune(line)
+		start := max(0, utf8.RuneCountInString(line[:index])-snippetContext)
+		end := min(len(text), start+snippetLength)
+		snippet := string(text[start:end])
+		if start > 0 {
+			snippet = "..." + snippet
+		}
+		if end < len(text) {
+			snippet += "..."
+		}
+		return snippet
+	}
+	return ""
 }
 
 func convertMemoRelationTypeFromStore(relationType store.MemoRelationType) v1pb.MemoRelation_Type {
@@ -153,18 +281,58 @@ func convertMemoRelationTypeFromStore(relationType store.MemoRelationType) v1pb.
 		return v1pb.MemoRelation_REFERENCE
 	case store.MemoRelationComment:
 		return v1pb.MemoRelation_COMMENT
-	default:
+	case "":
 		return v1pb.MemoRelation_TYPE_UNSPECIFIED
+	default:
+		return v1pb.MemoRelation_CUSTOM
 	}
 }
 
-func convertMemoRelationTypeToStore(relationType v1pb.MemoRelation_Type) store.MemoRelationType {
-	switch relationType {
+func convertMemoRelationTypeToStore(relation *v1pb.MemoRelation) store.MemoRelationType {
+	switch relation.Type {
 	case v1pb.MemoRelation_REFERENCE:
 		return store.MemoRelationReference
 	case v1pb.MemoRelation_COMMENT:
 		return store.MemoRelationComment
+	case v1pb.MemoRelation_CUSTOM:
+		return store.MemoRelationType(relation.CustomType)
 	default:
 		return store.MemoRelationReference
 	}
 }
+
+// getMemoRelationCustomType returns the name of the relation type if it's a custom one.
+func getMemoRelationCustomType(relationType store.MemoRelationType) string {
+	if convertMemoRelationTypeFromStore(relationType) != v1pb.MemoRelation_CUSTOM {
+		return ""
+	}
+	return string(relationType)
+}
+
+func findMemoRelationType(setting *storepb.WorkspaceMemoRelatedSetting, name string) *storepb.WorkspaceMemoRelatedSetting_RelationType {
+	for _, relationType := range setting.RelationTypes {
+		if relationType.Name == name {
+			return relationType
+		}
+	}
+	return nil
+}
+
+// validateMemoRelationTypes checks the custom relation types of the workspace memo related setting.
+// Names are lowercase so they never clash with the built-in REFERENCE and COMMENT types.
+func validateMemoRelationTypes(relationTypes []*v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType) error {
+	names := []string{}
+	for _, relationType := range relationTypes {
+		if !memoRelationTypeNameRegexp.MatchString(relationType.Name) {
+			return errors.Errorf("invalid relation type name %q, expected lowercase letters, digits and dashes", relationType.Name)
+		}
+		if slices.Contains(names, relationType.Name) {
+			return errors.Errorf("duplicate relation type %q", relationType.Name)
+		}
+		if strings.TrimSpace(relationType.InverseLabel) == "" {
+			return errors.Errorf("relation type %q has no inverse label", relationType.Name)
+		}
+		names = append(names, relationType.Name)
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(revisions) == limitPlusOne {
+		revisions = revisions[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions:     []*v1pb.MemoRevision{},
+		NextPageToken: nextPageToken,
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo, revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+
+	revisionMessage := convertMemoRevisionFromStore(memo, revision)
+	revisionMessage.Diff = diffLines(revision.Content, memo.Content)
+	return revisionMessage, nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisionAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+
+	// Restoring goes through UpdateMemo so that the current version is kept as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content:    revision.Content,
+			Visibility: convertVisibilityFromStore(revision.Visibility),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility", "update_time"}},
+	})
+}
+
+// getMemoForRevisionAccess returns the memo if the current user is allowed to see its revision history.
+// Revisions may contain content that was never visible to others, so only the creator and admins can access them.
+func (s *APIV1Service) getMemoForRevisionAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// recordMemoRevision keeps the previous content and visibility of a memo when an update changes either of them.
+func (s *APIV1Service) recordMemoRevision(ctx context.Context, memo *store.Memo, update *store.UpdateMemo, previousContent string, previousVisibility store.Visibility, user *store.User) error {
+	contentChanged := update.Content != nil && *update.Content != previousContent
+	visibilityChanged := update.Visibility != nil && *update.Visibility != previousVisibility
+	if !contentChanged && !visibilityChanged {
+		return nil
+	}
+	_, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    previousContent,
+		Visibility: previousVisibility,
+	})
+	return err
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, revision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, revision.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, revision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+	}
+}
+
+// maxDiffLines is the max number of changed lines compared line by line, more are diffed as a whole
+// since the diff takes time proportional to the product of the line counts.
+const maxDiffLines = 2000
+
+// diffLines computes a line-based diff from the old text to the new text using the longest common subsequence.
+// The common subsequence is found with Hirschberg's algorithm, so the diff takes linear space.
+func diffLines(oldText, newText string) []*v1pb.MemoRevision_DiffLine {
+	oldLines, newLines := splitLines(oldText), splitLines(newText)
+
+	// Skip the common prefix and suffix, which are usually most of the lines.
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	diff := appendDiffLines([]*v1pb.MemoRevision_DiffLine{}, v1pb.MemoRevision_DiffLine_EQUAL, oldLines[:prefix])
+	oldMiddle, newMiddle := oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix]
+	if len(oldMiddle) > maxDiffLines || len(newMiddle) > maxDiffLines {
+		diff = appendDiffLines(diff, v1pb.MemoRevision_DiffLine_DELETE, oldMiddle)
+		diff = appendDiffLines(diff, v1pb.MemoRevision_DiffLine_INSERT, newMiddle)
+	} else {
+		diff = appendLCSDiff(diff, oldMiddle, newMiddle)
+	}
+	return appendDiffLines(diff, v1pb.MemoRevision_DiffLine_EQUAL, oldLines[len(oldLines)-suffix:])
+}
+
+// appendLCSDiff appends the diff of the lines, splitting the old lines in half and the new lines where
+// the longest common subsequences of both halves add up to the longest one.
+func appendLCSDiff(diff []*v1pb.MemoRevision_DiffLine, oldLines, newLines []string) []*v1pb.MemoRevision_DiffLine {
+	switch {
+	case len(oldLines) == 0:
+		return appendDiffLines(diff, v1pb.MemoRevision_DiffLine_INSERT, newLines)
+	case len(newLines) == 0:
+		return appendDiffLines(diff, v1pb.MemoRevision_DiffLine_DELETE, oldLines)
+	case len(oldLines) == 1:
+		for j, line := range newLines {
+			if line == oldLines[0] {
+				diff = appendDiffLines(diff, v1pb.MemoRevision_DiffLine_INSERT, newLines[:j])
+				diff = appendDiffLines(diff, v1pb.MemoRevision_DiffLine_EQUAL, oldLines)
+				return appendDiffLines(diff, v1pb.MemoRevision_DiffLine_INSERT, newLines[j+1:])
+			}
+		}
+		diff = appendDiffLines(diff, v1pb.MemoRevision_DiffLine_DELETE, oldLines)
+		return appendDiffLines(diff, v1pb.MemoRevision_DiffLine_INSERT, newLines)
+	}
+
+	middle := len(oldLines) / 2
+	head := lcsLengths(oldLines[:middle], newLines, false)
+	tail := lcsLengths(oldLines[middle:], newLines, true)
+	split := 0
+	for j := range newLines {
+		if head[j+1]+tail[j+1] > head[split]+tail[split] {
+			split = j + 1
+		}
+	}
+	diff = appendLCSDiff(diff, oldLines[:middle], newLines[:split])
+	return appendLCSDiff(diff, oldLines[middle:], newLines[split:])
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of the old lines and each prefix of the new lines,
+// or each suffix if reversed, indexed by where the prefix ends or the suffix starts.
+func lcsLengths(oldLines, newLines []string, reversed bool) []int {
+	previous, current := make([]int, len(newLines)+1), make([]int, len(newLines)+1)
+	for i := range oldLines {
+		if reversed {
+			oldLine := oldLines[len(oldLines)-1-i]
+			for j := len(newLines) - 1; j >= 0; j-- {
+				if oldLine == newLines[j] {
+					current[j] = previous[j+1] + 1
+				} else {
+					current[j] = max(previous[j], current[j+1])
+				}
+			}
+		} else {
+			oldLine := oldLines[i]
+			for j := 1; j <= len(newLines); j++ {
+				if oldLine == newLines[j-1] {
+					current[j] = previous[j-1] + 1
+				} else {
+					current[j] = max(previous[j], current[j-1])
+				}
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous
+}
+
+func appendDiffLines(diff []*v1pb.MemoRevision_DiffLine, operation v1pb.MemoRevision_DiffLine_Operation, lines []string) []*v1pb.MemoRevision_DiffLine {
+	for _, line := range lines {
+		diff = append(diff, &v1pb.MemoRevision_DiffLine{Operation: operation, Text: line})
+	}
+	return diff
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestDiffLines(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldText string
+		newText string
+		equal   int
+	}{
+		{name: "empty", oldText: "", newText: "", equal: 0},
+		{name: "insert all", oldText: "", newText: "a\nb", equal: 0},
+		{name: "delete all", oldText: "a\nb", newText: "", equal: 0},
+		{name: "replace middle", oldText: "a\nb\nc", newText: "a\nx\nc", equal: 2},
+		{name: "move", oldText: "a\nb\nc\nd\ne", newText: "c\nd\ne\na\nb", equal: 3},
+		{name: "interleaved", oldText: "a\nb\nc\na\nb\nb\na", newText: "c\nb\na\nb\na\nc", equal: 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := diffLines(tc.oldText, tc.newText)
+			requireDiffApplies(t, diff, tc.oldText, tc.newText)
+			equal := 0
+			for _, line := range diff {
+				if line.Operation == v1pb.MemoRevision_DiffLine_EQUAL {
+					equal++
+				}
+			}
+			require.Equal(t, tc.equal, equal)
+		})
+	}
+
+	// Too many changed lines are diffed as a whole, but the common prefix and suffix are kept.
+	oldLines, newLines := []string{"first"}, []string{"first"}
+	for i := 0; i < maxDiffLines+1; i++ {
+		oldLines = append(oldLines, fmt.Sprintf("old %d", i))
+		newLines = append(newLines, fmt.Sprintf("new %d", i))
+	}
+	oldLines, newLines = append(oldLines, "last"), append(newLines, "last")
+	diff := diffLines(strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+	requireDiffApplies(t, diff, strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+	require.Equal(t, v1pb.MemoRevision_DiffLine_EQUAL, diff[0].Operation)
+	require.Equal(t, v1pb.MemoRevision_DiffLine_DELETE, diff[1].Operation)
+	require.Equal(t, v1pb.MemoRevision_DiffLine_EQUAL, diff[len(diff)-1].Operation)
+}
+
+// requireDiffApplies checks that the diff turns the old text into the new text.
+func requireDiffApplies(t *testing.T, diff []*v1pb.MemoRevision_DiffLine, oldText, newText string) {
+	oldLines, newLines := []string{}, []string{}
+	for _, line := range diff {
+		if line.Operation != v1pb.MemoRevision_DiffLine_INSERT {
+			oldLines = append(oldLines, line.Text)
+		}
+		if line.Operation != v1pb.MemoRevision_DiffLine_DELETE {
+			newLines = append(newLines, line.Text)
+		}
+	}
+	require.Equal(t, splitLines(oldText), oldLines)
+	require.Equal(t, splitLines(newText), newLines)
+}
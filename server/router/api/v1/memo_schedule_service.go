@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListScheduledMemos(ctx context.Context, request *v1pb.ListScheduledMemosRequest) (*v1pb.ListScheduledMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		Scheduled: true,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	memoMessages := []*v1pb.Memo{}
+	for _, memo := range memos {
+		memoMessage, err := s.convertScheduledMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+
+	return &v1pb.ListScheduledMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// PublishMemo makes a scheduled memo public and dispatches the memo updated webhook.
+// The RSS feeds pick the memo up from then on, as they only list public memos.
+func (s *APIV1Service) PublishMemo(ctx context.Context, memo *store.Memo) error {
+	ctx = context.WithValue(ctx, userIDContextKey, memo.CreatorID)
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get creator")
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+
+	publishTs := int64(0)
+	update := &store.UpdateMemo{
+		ID:        memo.ID,
+		PublishTs: &publishTs,
+	}
+	// Public memos may have been disabled since the memo was scheduled, the schedule is dropped then.
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility {
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return errors.Wrap(err, "failed to clear memo schedule")
+		}
+		return errors.New("disable public memos system setting is enabled")
+	}
+	visibility := store.Public
+	update.Visibility = &visibility
+	// The memo is saved together with its revision, and its groups are dropped once it leaves GROUP, as in UpdateMemo.
+	if err := s.Store.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return errors.Wrap(err, "failed to update memo")
+		}
+		if err := s.recordMemoRevision(ctx, memo, update, memo.Content, memo.Visibility, user); err != nil {
+			return errors.Wrap(err, "failed to record memo revision")
+		}
+		if memo.Visibility == store.Group {
+			if err := s.setMemoGroups(ctx, memo.ID, nil); err != nil {
+				return errors.Wrap(err, "failed to clear memo groups")
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	memoMessage, err := s.convertScheduledMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// validateMemoPublishTs checks that a memo with the given visibility can be scheduled to become public at the given timestamp.
+func (s *APIV1Service) validateMemoPublishTs(ctx context.Context, visibility store.Visibility, publishTs int64) error {
+	if publishTs <= time.Now().Unix() {
+		return status.Errorf(codes.InvalidArgument, "publish time must be in the future")
+	}
+	if visibility == store.Public {
+		return status.Errorf(codes.InvalidArgument, "memo is already public")
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisibility {
+		return status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return nil
+}
+
+func (s *APIV1Service) convertScheduledMemoFromStore(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ContentID: &memoName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	return s.convertMemoFromStore(ctx, memo, reactions, attachments)
+}
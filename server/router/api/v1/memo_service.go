@@ -3,11 +3,14 @@ package v1
 import (
 	"context"
 	"fmt"
+	"html"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/lithammer/shortuuid/v4"
@@ -22,9 +25,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/usememos/memos/plugin/ai"
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
@@ -89,6 +94,13 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if workspaceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	var groupIDs []int32
+	if create.Visibility == store.Group {
+		groupIDs, err = s.resolveMemoGroupIDs(ctx, user, request.Memo.Groups)
+		if err != nil {
+			return nil, err
+		}
+	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
@@ -117,19 +129,27 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		}
 	}
 
+	if request.Memo.PublishTime != nil {
+		create.PublishTs = request.Memo.PublishTime.AsTime().Unix()
+		if err := s.validateMemoPublishTs(ctx, create.Visibility, create.PublishTs); err != nil {
+			return nil, err
+		}
+	}
+
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
 		return nil, err
 	}
+	if len(groupIDs) > 0 {
+		if err := s.setMemoGroups(ctx, memo.ID, groupIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set memo groups: %v", err)
+		}
+	}
 
 	attachments := []*store.Attachment{}
 
 	if len(request.Memo.Attachments) > 0 {
-		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
-			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
-			Attachments: request.Memo.Attachments,
-		})
-		if err != nil {
+		if err := s.setMemoAttachments(ctx, memo, request.Memo.Attachments); err != nil {
 			return nil, errors.Wrap(err, "failed to set memo attachments")
 		}
 
@@ -142,14 +162,20 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		attachments = a
 	}
 	if len(request.Memo.Relations) > 0 {
-		_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-			Name:      fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
-			Relations: request.Memo.Relations,
-		})
-		if err != nil {
+		if err := s.setMemoRelations(ctx, memo, request.Memo.Relations); err != nil {
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
+	// The memo is already saved, so failing to sync its references is only logged, like the webhook failures.
+	if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, nil); err != nil {
+		slog.Warn("Failed to sync memo references", slog.Any("err", err))
+	}
+	if err := s.notifyMemoMentions(ctx, memo, nil, user.ID); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
+	if err := s.notifyMemoReferences(ctx, memo, nil, user.ID); err != nil {
+		slog.Warn("Failed to notify memo references", slog.Any("err", err))
+	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
 	if err != nil {
@@ -186,11 +212,17 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		memoFind.OrderByTimeAsc = false
 	}
 
+	searchQueries := []string{}
 	if request.Filter != "" {
 		if err := s.validateFilter(ctx, request.Filter); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
 		memoFind.Filters = append(memoFind.Filters, request.Filter)
+		parsedExpr, err := filter.Parse(request.Filter, filter.MemoFilterCELAttributes...)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		searchQueries = filter.GetSearchQueries(parsedExpr.GetExpr())
 	}
 
 	currentUser, err := s.GetCurrentUser(ctx)
@@ -201,11 +233,16 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else {
 		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+			filter := getMemoVisibilityFilter(currentUser.ID)
 			memoFind.Filters = append(memoFind.Filters, filter)
 		} else if *memoFind.CreatorID != currentUser.ID {
 			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
 		}
+		// Tag filters follow the aliases of the current user.
+		memoFind.TagAliases, err = s.Store.GetUserTagAliases(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tag aliases: %v", err)
+		}
 	}
 
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
@@ -224,6 +261,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		memoFind.Cursor = convertCursorToStore(pageToken.Cursor)
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -242,7 +280,12 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		// Relevance isn't a stable sort key, so search results keep paging by offset.
+		if memoFind.OrderByRelevance {
+			nextPageToken, err = getPageToken(limit, offset+limit)
+		} else {
+			nextPageToken, err = getCursorPageToken(limit, getMemoCursor(memos[len(memos)-1], memoFind))
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -298,6 +341,13 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert memo")
 		}
+		if len(searchQueries) > 0 {
+			highlight, err := getMemoSearchHighlight(memo.Content, searchQueries)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get memo search highlight")
+			}
+			memoMessage.Highlight = highlight
+		}
 
 		memoMessages = append(memoMessages, memoMessage)
 	}
@@ -324,15 +374,33 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
 	if memo.Visibility != store.Public {
-		user, err := s.GetCurrentUser(ctx)
+		// A valid share token grants read access without authentication.
+		shared, err := s.isMemoSharedWithToken(ctx, memo.ID, request.ShareToken)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user")
-		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			return nil, status.Errorf(codes.Internal, "failed to check share token")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		if !shared {
+			user, err := s.GetCurrentUser(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user")
+			}
+			if user == nil {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+			if memo.Visibility != store.Protected && memo.CreatorID != user.ID {
+				// Users the memo has been granted to can read it whatever their role.
+				role, err := s.getMemoGrantRole(ctx, memo.ID, user.ID)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get memo grant")
+				}
+				isMember, err := s.isMemoGroupMember(ctx, memo, user.ID)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check memo groups")
+				}
+				if role == "" && !isMember {
+					return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+				}
+			}
 		}
 	}
 
@@ -378,14 +446,37 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	// Only the creator or admin can update the memo.
+	// Only the creator, admin or an editor the memo has been granted to can update the memo.
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		role, err := s.getMemoGrantRole(ctx, memo.ID, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo grant")
+		}
+		if !role.CanEdit() {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		// Editors can change what the memo says, but not who can see it or where it lives.
+		for _, path := range request.UpdateMask.Paths {
+			if !slices.Contains(memoEditorUpdatePaths, path) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied to update %s", path)
+			}
+		}
+	}
+	if err := s.checkMemoEtag(ctx, memo, request.Memo.Etag); err != nil {
+		return nil, err
 	}
 
+	previousContent, previousVisibility := memo.Content, memo.Visibility
+	previousReferences := memo.Payload.GetProperty().GetReferences()
+	previousMentions := memo.Payload.GetMentions()
+	previousReferenceIDs, err := s.listMemoReferenceIDs(ctx, memo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo references: %v", err)
+	}
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	updateGroups := false
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -411,6 +502,8 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
 			update.Visibility = &visibility
+		} else if path == "groups" {
+			updateGroups = true
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
 		} else if path == "state" {
@@ -441,26 +534,67 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
 		} else if path == "attachments" {
-			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
-				Name:        request.Memo.Name,
-				Attachments: request.Memo.Attachments,
-			})
-			if err != nil {
+			if err := s.setMemoAttachments(ctx, memo, request.Memo.Attachments); err != nil {
 				return nil, errors.Wrap(err, "failed to set memo attachments")
 			}
 		} else if path == "relations" {
-			_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-				Name:      request.Memo.Name,
-				Relations: request.Memo.Relations,
-			})
-			if err != nil {
+			if err := s.setMemoRelations(ctx, memo, request.Memo.Relations); err != nil {
 				return nil, errors.Wrap(err, "failed to set memo relations")
 			}
+		} else if path == "publish_time" {
+			publishTs := int64(0)
+			if request.Memo.PublishTime != nil {
+				publishTs = request.Memo.PublishTime.AsTime().Unix()
+			}
+			update.PublishTs = &publishTs
 		}
 	}
 
-	if err = s.Store.UpdateMemo(ctx, update); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	// The groups of the memo are resolved whenever its visibility or groups change, and dropped once it leaves GROUP.
+	visibility := memo.Visibility
+	if update.Visibility != nil {
+		visibility = *update.Visibility
+	}
+	var groupIDs []int32
+	if visibility == store.Group && (updateGroups || previousVisibility != store.Group) {
+		groupIDs, err = s.resolveMemoGroupIDs(ctx, user, request.Memo.Groups)
+		if err != nil {
+			return nil, err
+		}
+		updateGroups = true
+	} else if visibility != store.Group && (updateGroups || previousVisibility == store.Group) {
+		if len(request.Memo.Groups) > 0 && slices.Contains(request.UpdateMask.Paths, "groups") {
+			return nil, status.Errorf(codes.InvalidArgument, "groups can only be set on memos with group visibility")
+		}
+		updateGroups = true
+	}
+
+	// Only memos that aren't public yet can be scheduled, and making a scheduled memo public drops its schedule.
+	if update.PublishTs != nil && *update.PublishTs > 0 {
+		if err := s.validateMemoPublishTs(ctx, visibility, *update.PublishTs); err != nil {
+			return nil, err
+		}
+	} else if update.PublishTs == nil && memo.PublishTs > 0 && visibility == store.Public {
+		publishTs := int64(0)
+		update.PublishTs = &publishTs
+	}
+
+	// The memo is saved together with its revision and its groups.
+	if err := s.Store.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.Store.UpdateMemo(ctx, update); err != nil {
+			return err
+		}
+		if err := s.recordMemoRevision(ctx, memo, update, previousContent, previousVisibility, user); err != nil {
+			return errors.Wrap(err, "failed to record memo revision")
+		}
+		if updateGroups {
+			if err := s.setMemoGroups(ctx, memo.ID, groupIDs); err != nil {
+				return errors.Wrap(err, "failed to set memo groups")
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
 	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
@@ -469,6 +603,22 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	// The memo is already saved, so failing to sync its references is only logged, like the webhook failures.
+	// Setting the relations replaces the references too, so they are synced back from the content either way.
+	if slices.Contains(request.UpdateMask.Paths, "content") || slices.Contains(request.UpdateMask.Paths, "relations") {
+		if err := memopayload.SyncMemoReferences(ctx, s.Store, memo, previousReferences); err != nil {
+			slog.Warn("Failed to sync memo references", slog.Any("err", err))
+		}
+		if err := s.notifyMemoReferences(ctx, memo, previousReferenceIDs, user.ID); err != nil {
+			slog.Warn("Failed to notify memo references", slog.Any("err", err))
+		}
+	}
+	// Only the users newly mentioned in the content are notified.
+	if slices.Contains(request.UpdateMask.Paths, "content") {
+		if err := s.notifyMemoMentions(ctx, memo, previousMentions, user.ID); err != nil {
+			slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+		}
+	}
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Memo.Name,
 	})
@@ -539,38 +689,10 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		}
 	}
 
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
-	}
-
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
-	}
-
-	// Delete related attachments.
-	for _, attachment := range attachments {
-		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete attachment")
-		}
-	}
-
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
-	}
-	for _, relation := range relations {
-		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
-		}
-	}
-
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+	// Move the memo to the trash. It's permanently deleted with its related data once the retention period expires.
+	trashedTs := time.Now().Unix()
+	if err = s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, TrashedTs: &trashedTs}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move memo to trash")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -585,6 +707,32 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if relatedMemo.Visibility == store.Private || relatedMemo.Visibility == store.Group {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user")
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		if relatedMemo.CreatorID != user.ID && !isSuperUser(user) {
+			role, err := s.getMemoGrantRole(ctx, relatedMemo.ID, user.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo grant")
+			}
+			// Members of the groups of the memo can comment on it like on a protected memo.
+			isMember, err := s.isMemoGroupMember(ctx, relatedMemo, user.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check memo groups")
+			}
+			if !role.CanComment() && !isMember {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+		}
+	}
 
 	// Create the memo comment first.
 	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment})
@@ -614,30 +762,40 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
 	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
-			CreatorID: creatorID,
-			Type:      store.ActivityTypeMemoComment,
-			Level:     store.ActivityLevelInfo,
-			Payload: &storepb.ActivityPayload{
-				MemoComment: &storepb.ActivityMemoCommentPayload{
-					MemoId:        memo.ID,
-					RelatedMemoId: relatedMemo.ID,
-				},
-			},
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		if err := s.NotificationDispatcher.Dispatch(ctx, &notification.Notification{
+			Type:       storepb.InboxMessage_MEMO_COMMENT,
 			SenderID:   creatorID,
 			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
+			MemoID:     memo.ID,
+		}, func() error {
+			activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+				CreatorID: creatorID,
+				Type:      store.ActivityTypeMemoComment,
+				Level:     store.ActivityLevelInfo,
+				Payload: &storepb.ActivityPayload{
+					MemoComment: &storepb.ActivityMemoCommentPayload{
+						MemoId:        memo.ID,
+						RelatedMemoId: relatedMemo.ID,
+					},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to create activity")
+			}
+			if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   creatorID,
+				ReceiverID: relatedMemo.CreatorID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       storepb.InboxMessage_MEMO_COMMENT,
+					ActivityId: &activity.ID,
+				},
+			}); err != nil {
+				return errors.Wrap(err, "failed to create inbox")
+			}
+			return nil
 		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+			return nil, status.Errorf(codes.Internal, "failed to notify memo creator: %v", err)
 		}
 	}
 
@@ -653,6 +811,9 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -662,36 +823,58 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if currentUser == nil {
 		memoFilter = `visibility == "PUBLIC"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		memoFilter = getMemoVisibilityFilter(currentUser.ID)
 	}
-	memoRelationComment := store.MemoRelationComment
-	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &memo.ID,
-		Type:          &memoRelationComment,
-		MemoFilter:    &memoFilter,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+	memoFind := &store.FindMemo{
+		ParentID: &memo.ID,
+		Filters:  []string{memoFilter},
 	}
-
-	if len(memoRelations) == 0 {
-		response := &v1pb.ListMemoCommentsResponse{
-			Memos: []*v1pb.Memo{},
+	if request.OrderBy != "" {
+		if err := s.parseMemoOrderBy(request.OrderBy, memoFind); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
 		}
-		return response, nil
 	}
 
-	memoRelationIDs := make([]string, 0, len(memoRelations))
-	for _, m := range memoRelations {
-		memoRelationIDs = append(memoRelationIDs, fmt.Sprintf("%d", m.MemoID))
+	// Comments are returned all at once unless a page size is requested.
+	var limit int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		memoFind.Cursor = convertCursorToStore(pageToken.Cursor)
+	} else {
+		limit = int(request.PageSize)
 	}
-	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
-		Filters: []string{fmt.Sprintf("id in [%s]", strings.Join(memoRelationIDs, ", "))},
-	})
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		memoFind.Limit = &limitPlusOne
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
 
+	nextPageToken := ""
+	if limit > 0 && len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		nextPageToken, err = getCursorPageToken(limit, getMemoCursor(memos[len(memos)-1], memoFind))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	if len(memos) == 0 {
+		response := &v1pb.ListMemoCommentsResponse{
+			Memos:         []*v1pb.Memo{},
+			NextPageToken: nextPageToken,
+		}
+		return response, nil
+	}
+
 	memoIDToNameMap := make(map[int32]string)
 	memoNamesForQuery := make([]string, 0, len(memos))
 	memoIDsForQuery := make([]string, 0, len(memos))
@@ -739,7 +922,8 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	}
 
 	response := &v1pb.ListMemoCommentsResponse{
-		Memos: memosResponse,
+		Memos:         memosResponse,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
@@ -752,7 +936,7 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 
 	memoFind := &store.FindMemo{
 		CreatorID:       &user.ID,
-		Filters:         []string{fmt.Sprintf("tag in [\"%s\"]", request.OldTag)},
+		Filters:         []string{fmt.Sprintf("tag in_tree %q", request.OldTag)},
 		ExcludeComments: true,
 	}
 	if (request.Parent) != "memos/-" {
@@ -769,13 +953,16 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 	}
 
 	for _, memo := range memos {
+		if !hasTagInTree(memo, request.OldTag) {
+			continue
+		}
 		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
 		}
 		memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldTag {
-				tag.Content = request.NewTag
+			if tag, ok := node.(*ast.Tag); ok {
+				tag.Content = renameTagInTree(tag.Content, request.OldTag, request.NewTag)
 			}
 		})
 		memo.Content = restore.Restore(nodes)
@@ -802,7 +989,7 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 
 	memoFind := &store.FindMemo{
 		CreatorID:       &user.ID,
-		Filters:         []string{fmt.Sprintf("tag in [\"%s\"]", request.Tag)},
+		Filters:         []string{fmt.Sprintf("tag in_tree %q", request.Tag)},
 		ExcludeContent:  true,
 		ExcludeComments: true,
 	}
@@ -820,10 +1007,14 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 	}
 
 	for _, memo := range memos {
+		if !hasTagInTree(memo, request.Tag) {
+			continue
+		}
 		if request.DeleteRelatedMemos {
-			err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+			trashedTs := time.Now().Unix()
+			err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, TrashedTs: &trashedTs})
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to delete memo")
+				return nil, status.Errorf(codes.Internal, "failed to move memo to trash")
 			}
 		} else {
 			// Get full memo content for tag removal
@@ -952,6 +1143,88 @@ func substring(s string, length int) string {
 	return s[:byteIndex]
 }
 
+// getMemoCursor returns the position of the memo in a list sorted as described by memoFind.
+func getMemoCursor(memo *store.Memo, memoFind *store.FindMemo) *store.Cursor {
+	timestamp := memo.CreatedTs
+	if memoFind.OrderByUpdatedTs {
+		timestamp = memo.UpdatedTs
+	}
+	return &store.Cursor{
+		Timestamp: timestamp,
+		ID:        memo.ID,
+	}
+}
+
+// getMemoSearchHighlight returns an excerpt of the plain text content around the first term matching the search queries,
+// with every matched term wrapped in <mark></mark> tags. The text is HTML escaped so the excerpt is safe to render as HTML.
+func getMemoSearchHighlight(content string, queries []string) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse content")
+	}
+	text := []rune(strings.TrimSpace(renderer.NewStringRenderer().Render(nodes)))
+	lowerText := make([]rune, len(text))
+	for i, r := range text {
+		lowerText[i] = unicode.ToLower(r)
+	}
+
+	terms := [][]rune{}
+	for _, query := range queries {
+		for _, term := range strings.Fields(strings.ToLower(query)) {
+			terms = append(terms, []rune(term))
+		}
+	}
+	// Prefer longer terms when several of them match at the same position.
+	slices.SortFunc(terms, func(a, b []rune) int {
+		return len(b) - len(a)
+	})
+	matchAt := func(i int) int {
+		for _, term := range terms {
+			if i+len(term) <= len(lowerText) && slices.Equal(lowerText[i:i+len(term)], term) {
+				return len(term)
+			}
+		}
+		return 0
+	}
+
+	const (
+		highlightLength  = 160
+		highlightContext = 32
+	)
+	start := 0
+	for i := range lowerText {
+		if matchAt(i) > 0 {
+			start = max(0, i-highlightContext)
+			break
+		}
+	}
+	end := min(len(text), start+highlightLength)
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString("...")
+	}
+	for i := start; i < end; {
+		if n := matchAt(i); n > 0 {
+			builder.WriteString("<mark>")
+			builder.WriteString(html.EscapeString(string(text[i : i+n])))
+			builder.WriteString("</mark>")
+			i += n
+			continue
+		}
+		j := i + 1
+		for j < end && matchAt(j) == 0 {
+			j++
+		}
+		builder.WriteString(html.EscapeString(string(text[i:j])))
+		i = j
+	}
+	if end < len(text) {
+		builder.WriteString("...")
+	}
+	return builder.String(), nil
+}
+
 // parseMemoOrderBy parses the order_by field and sets the appropriate ordering in memoFind.
 func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo) error {
 	// Parse order_by field like "display_time desc" or "create_time asc"
@@ -980,8 +1253,14 @@ func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo)
 	case "name":
 		// For ordering by memo name/id - not commonly used but supported
 		memoFind.OrderByTimeAsc = direction == "asc"
+	case "relevance":
+		// Best matches of the search() filter come first, ties are broken by display time.
+		if direction == "asc" {
+			return errors.New("relevance only supports descending order")
+		}
+		memoFind.OrderByRelevance = true
 	default:
-		return errors.Errorf("unsupported order field: %s, supported fields are: display_time, create_time, update_time, name", field)
+		return errors.Errorf("unsupported order field: %s, supported fields are: display_time, create_time, update_time, name, relevance", field)
 	}
 
 	return nil
@@ -1124,7 +1403,7 @@ func (s *APIV1Service) filterOutTag(nodes []ast.Node, tagToRemove string) []ast.
 	
 	for i, node := range nodes {
 		// Skip tags that match the removal criteria
-		if tag, ok := node.(*ast.Tag); ok && tag.Content == tagToRemove {
+		if tag, ok := node.(*ast.Tag); ok && isTagInTree(tag.Content, tagToRemove) {
 			// Smart whitespace handling: if we're removing a tag, check adjacent text nodes
 			s.handleWhitespaceAroundRemovedTag(nodes, i, &filtered)
 			continue
@@ -48,6 +48,23 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
 	}
 
+	if memo.Visibility == store.Group {
+		memoGroups, err := s.Store.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memo groups")
+		}
+		for _, memoGroup := range memoGroups {
+			memoMessage.Groups = append(memoMessage.Groups, fmt.Sprintf("%s%d", GroupNamePrefix, memoGroup.GroupID))
+		}
+	}
+
+	if memo.TrashedTs > 0 {
+		memoMessage.TrashTime = timestamppb.New(time.Unix(memo.TrashedTs, 0))
+	}
+	if memo.PublishTs > 0 {
+		memoMessage.PublishTime = timestamppb.New(time.Unix(memo.PublishTs, 0))
+	}
+
 	if memo.ParentUID != nil {
 		parentName := fmt.Sprintf("%s%s", MemoNamePrefix, *memo.ParentUID)
 		memoMessage.Parent = &parentName
@@ -60,11 +77,11 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Reactions = append(memoMessage.Reactions, reactionResponse)
 	}
 
-	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
+	relations, err := s.listMemoRelations(ctx, memo.ID, v1pb.ListMemoRelationsRequest_DIRECTION_UNSPECIFIED)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo relations")
 	}
-	memoMessage.Relations = listMemoRelationsResponse.Relations
+	memoMessage.Relations = relations
 
 	memoMessage.Attachments = []*v1pb.Attachment{}
 
@@ -72,6 +89,7 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		attachmentResponse := convertAttachmentFromStore(attachment)
 		memoMessage.Attachments = append(memoMessage.Attachments, attachmentResponse)
 	}
+	memoMessage.Etag = getMemoEtag(memo, attachments, relations)
 
 	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
 	if err != nil {
@@ -130,6 +148,8 @@ func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
 		return v1pb.Visibility_PROTECTED
 	case store.Public:
 		return v1pb.Visibility_PUBLIC
+	case store.Group:
+		return v1pb.Visibility_GROUP
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -143,6 +163,8 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Protected
 	case v1pb.Visibility_PUBLIC:
 		return store.Public
+	case v1pb.Visibility_GROUP:
+		return store.Group
 	default:
 		return store.Private
 	}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// CreateMemoFromEmail creates a memo for the user with the subject and body of the email as markdown,
+// and its attachments and inline images as attachments.
+func (s *APIV1Service) CreateMemoFromEmail(ctx context.Context, user *store.User, message *email.ReceivedMessage) (err error) {
+	ctx = context.WithValue(ctx, userIDContextKey, user.ID)
+
+	body, err := message.Markdown()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to convert body: %v", err)
+	}
+	content := body
+	if subject := strings.TrimSpace(message.Subject); subject != "" {
+		content = strings.TrimSpace(fmt.Sprintf("# %s\n\n%s", subject, body))
+	}
+	if content == "" && len(message.Attachments) == 0 {
+		return status.Errorf(codes.InvalidArgument, "email is empty")
+	}
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get content length limit")
+	}
+	if len(content) > contentLengthLimit {
+		return status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace storage setting")
+	}
+	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+	for _, attachment := range message.Attachments {
+		if len(attachment.Data) > uploadSizeLimit {
+			return status.Errorf(codes.InvalidArgument, "attachment %s exceeds the size limit", attachment.Filename)
+		}
+	}
+
+	// The attachments are created before the memo, so they're deleted again if the memo can't be created.
+	createdAttachments := []*store.Attachment{}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, attachment := range createdAttachments {
+			if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
+				slog.Warn("Failed to delete attachment of email", slog.Any("err", err), slog.Int("attachmentID", int(attachment.ID)))
+			}
+		}
+	}()
+	attachments := []*v1pb.Attachment{}
+	for _, attachment := range message.Attachments {
+		create := &store.Attachment{
+			UID:       shortuuid.New(),
+			CreatorID: user.ID,
+			Filename:  attachment.Filename,
+			Type:      attachment.ContentType,
+			Size:      int64(len(attachment.Data)),
+			Blob:      attachment.Data,
+		}
+		if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
+			return errors.Wrap(err, "failed to save attachment blob")
+		}
+		created, err := s.Store.CreateAttachment(ctx, create)
+		if err != nil {
+			return errors.Wrap(err, "failed to create attachment")
+		}
+		createdAttachments = append(createdAttachments, created)
+		attachments = append(attachments, &v1pb.Attachment{Name: fmt.Sprintf("%s%s", AttachmentNamePrefix, created.UID)})
+	}
+
+	visibility, err := s.getUserDefaultMemoVisibility(ctx, user.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get default memo visibility")
+	}
+	_, err = s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     content,
+			Visibility:  visibility,
+			Attachments: attachments,
+		},
+	})
+	return err
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// getMemoEtag derives the etag of a memo from its update time and everything that UpdateMemo can change.
+// The update time alone is not enough, as it has a resolution of one second and is not bumped by every update.
+func getMemoEtag(memo *store.Memo, attachments []*store.Attachment, relations []*v1pb.MemoRelation) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d\x00%d\x00%s\x00%s\x00%t\x00%s\x00", memo.UpdatedTs, memo.CreatedTs, memo.RowStatus, memo.Visibility, memo.Pinned, memo.Content)
+	if memo.Payload != nil && memo.Payload.Location != nil {
+		location := memo.Payload.Location
+		fmt.Fprintf(h, "%s\x00%f\x00%f\x00", location.Placeholder, location.Latitude, location.Longitude)
+	}
+	attachmentUIDs := []string{}
+	for _, attachment := range attachments {
+		attachmentUIDs = append(attachmentUIDs, attachment.UID)
+	}
+	slices.Sort(attachmentUIDs)
+	fmt.Fprintf(h, "%s\x00", strings.Join(attachmentUIDs, ","))
+	// Only the relations set through the memo itself count, comments and backlinks don't modify it.
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	relationKeys := []string{}
+	for _, relation := range relations {
+		if relation.Memo.GetName() != memoName || relation.Type == v1pb.MemoRelation_COMMENT {
+			continue
+		}
+		relationKeys = append(relationKeys, fmt.Sprintf("%s/%s", relation.Type, relation.RelatedMemo.GetName()))
+	}
+	slices.Sort(relationKeys)
+	fmt.Fprintf(h, "%s", strings.Join(relationKeys, ","))
+	return fmt.Sprintf(`"%x"`, h.Sum64())
+}
+
+// checkMemoEtag makes sure that the memo hasn't been modified since the client read it.
+// The etag is taken from the request, or from the If-Match header when the request comes through the gateway.
+func (s *APIV1Service) checkMemoEtag(ctx context.Context, memo *store.Memo, etag string) error {
+	ifMatch := getIfMatchHeader(ctx)
+	if etag == "" && ifMatch == "" {
+		return nil
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	relations, err := s.listMemoRelations(ctx, memo.ID, v1pb.ListMemoRelationsRequest_DIRECTION_UNSPECIFIED)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memo relations")
+	}
+	currentEtag := getMemoEtag(memo, attachments, relations)
+	if etag != "" && normalizeEtag(etag) != normalizeEtag(currentEtag) {
+		return status.Errorf(codes.Aborted, "memo has been modified since it was read")
+	}
+	if ifMatch != "" && !matchIfMatchHeader(ifMatch, currentEtag) {
+		return status.Errorf(codes.FailedPrecondition, "memo does not match the If-Match header")
+	}
+	return nil
+}
+
+func getIfMatchHeader(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get("grpcgateway-if-match"); len(values) > 0 {
+		return values[0]
+	} else if values := md.Get("if-match"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// matchIfMatchHeader reports whether the etag is one of the comma separated etags of an If-Match header, or the header is "*".
+func matchIfMatchHeader(ifMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || normalizeEtag(candidate) == normalizeEtag(etag) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeEtag strips the weak prefix and the quotes, so that clients can send the etag either way.
+func normalizeEtag(etag string) string {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strings.Trim(etag, `"`)
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoMentions lets the users mentioned in the memo since previousMentions know about it.
+// Users who can't see the memo aren't notified, nor is the sender mentioning themselves.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, previousMentions []string, senderID int32) error {
+	normalStatus := store.Normal
+	for _, username := range memo.Payload.GetMentions() {
+		if slices.Contains(previousMentions, username) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username, RowStatus: &normalStatus})
+		if err != nil {
+			return errors.Wrap(err, "failed to get mentioned user")
+		}
+		if user == nil || user.ID == senderID {
+			continue
+		}
+		visibleMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &memo.ID,
+			ExcludeContent: true,
+			Filters:        []string{getMemoVisibilityFilter(user.ID)},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to check memo visibility")
+		}
+		if visibleMemo == nil {
+			continue
+		}
+
+		if err := s.NotificationDispatcher.Dispatch(ctx, &notification.Notification{
+			Type:       storepb.InboxMessage_MEMO_MENTION,
+			SenderID:   senderID,
+			ReceiverID: user.ID,
+			MemoID:     memo.ID,
+		}, func() error {
+			activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+				CreatorID: senderID,
+				Type:      store.ActivityTypeMemoMention,
+				Level:     store.ActivityLevelInfo,
+				Payload: &storepb.ActivityPayload{
+					MemoMention: &storepb.ActivityMemoMentionPayload{
+						MemoId: memo.ID,
+						UserId: user.ID,
+					},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to create activity")
+			}
+			if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   senderID,
+				ReceiverID: user.ID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       storepb.InboxMessage_MEMO_MENTION,
+					ActivityId: &activity.ID,
+				},
+			}); err != nil {
+				return errors.Wrap(err, "failed to create inbox")
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
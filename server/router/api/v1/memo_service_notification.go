@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoReaction lets the creator of the memo know that the sender reacted to it.
+func (s *APIV1Service) notifyMemoReaction(ctx context.Context, memo *store.Memo, reaction *store.Reaction) error {
+	return s.notifyMemoCreator(ctx, memo, &notification.Notification{
+		Type:       storepb.InboxMessage_MEMO_REACTION,
+		SenderID:   reaction.CreatorID,
+		ReceiverID: memo.CreatorID,
+		MemoID:     memo.ID,
+	}, &store.Activity{
+		CreatorID: reaction.CreatorID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: &storepb.ActivityMemoReactionPayload{
+				MemoId:       memo.ID,
+				ReactionType: reaction.ReactionType,
+			},
+		},
+	})
+}
+
+// notifyMemoReferences lets the creators of the memos referenced by the memo since previousReferenceIDs know about it.
+// Creators who can't see the memo with the reference aren't notified.
+func (s *APIV1Service) notifyMemoReferences(ctx context.Context, memo *store.Memo, previousReferenceIDs []int32, senderID int32) error {
+	referenceIDs, err := s.listMemoReferenceIDs(ctx, memo.ID)
+	if err != nil {
+		return err
+	}
+	for _, relatedMemoID := range referenceIDs {
+		if slices.Contains(previousReferenceIDs, relatedMemoID) {
+			continue
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relatedMemoID, ExcludeContent: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get referenced memo")
+		}
+		if relatedMemo == nil || relatedMemo.CreatorID == senderID {
+			continue
+		}
+		visibleMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &memo.ID,
+			ExcludeContent: true,
+			Filters:        []string{getMemoVisibilityFilter(relatedMemo.CreatorID)},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to check memo visibility")
+		}
+		if visibleMemo == nil {
+			continue
+		}
+
+		if err := s.notifyMemoCreator(ctx, relatedMemo, &notification.Notification{
+			Type:       storepb.InboxMessage_MEMO_REFERENCE,
+			SenderID:   senderID,
+			ReceiverID: relatedMemo.CreatorID,
+			MemoID:     memo.ID,
+		}, &store.Activity{
+			CreatorID: senderID,
+			Type:      store.ActivityTypeMemoReference,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoReference: &storepb.ActivityMemoReferencePayload{
+					MemoId:        memo.ID,
+					RelatedMemoId: relatedMemo.ID,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listMemoReferenceIDs returns the ids of the memos referenced by the memo.
+func (s *APIV1Service) listMemoReferenceIDs(ctx context.Context, memoID int32) ([]int32, error) {
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memoID, Type: &referenceType})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	referenceIDs := []int32{}
+	for _, relation := range relations {
+		referenceIDs = append(referenceIDs, relation.RelatedMemoID)
+	}
+	return referenceIDs, nil
+}
+
+// notifyMemoCreator dispatches the notification of the activity of the sender on the memo to the memo creator.
+// In the inbox the activity is aggregated into the unread message of the same type about the memo if there's one,
+// so that many reactions to a memo end up as a single notification.
+func (s *APIV1Service) notifyMemoCreator(ctx context.Context, memo *store.Memo, n *notification.Notification, create *store.Activity) error {
+	if memo.CreatorID == n.SenderID {
+		return nil
+	}
+	return s.NotificationDispatcher.Dispatch(ctx, n, func() error {
+		activity, err := s.Store.CreateActivity(ctx, create)
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		aggregatedInbox, err := s.findAggregatedInbox(ctx, memo, n.Type)
+		if err != nil {
+			return err
+		}
+		if aggregatedInbox == nil {
+			if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   n.SenderID,
+				ReceiverID: memo.CreatorID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       n.Type,
+					ActivityId: &activity.ID,
+					SenderIds:  []int32{n.SenderID},
+					MemoId:     &memo.ID,
+				},
+			}); err != nil {
+				return errors.Wrap(err, "failed to create inbox")
+			}
+			return nil
+		}
+
+		senderIDs := []int32{n.SenderID}
+		for _, id := range aggregatedInbox.Message.SenderIds {
+			if id != n.SenderID {
+				senderIDs = append(senderIDs, id)
+			}
+		}
+		createdTs := time.Now().Unix()
+		if _, err := s.Store.UpdateInbox(ctx, &store.UpdateInbox{
+			ID:        aggregatedInbox.ID,
+			Status:    store.UNREAD,
+			CreatedTs: &createdTs,
+			SenderID:  &n.SenderID,
+			Message: &storepb.InboxMessage{
+				Type:       n.Type,
+				ActivityId: &activity.ID,
+				SenderIds:  senderIDs,
+				MemoId:     &memo.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to update inbox")
+		}
+		return nil
+	})
+}
+
+// findAggregatedInbox returns the unread inbox message of the type about the memo in the inbox of the memo creator.
+func (s *APIV1Service) findAggregatedInbox(ctx context.Context, memo *store.Memo, inboxType storepb.InboxMessage_Type) (*store.Inbox, error) {
+	unreadStatus := store.UNREAD
+	limit := 1
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:  &memo.CreatorID,
+		Status:      &unreadStatus,
+		MessageType: &inboxType,
+		MemoID:      &memo.ID,
+		Limit:       &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list inboxes")
+	}
+	if len(inboxes) == 0 {
+		return nil, nil
+	}
+	return inboxes[0], nil
+}
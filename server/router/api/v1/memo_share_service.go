@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareTokenLength is the length of share tokens, long enough that they can't be guessed.
+const memoShareTokenLength = 32
+
+func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.CreateMemoShareRequest) (*v1pb.MemoShare, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	token, err := util.RandomString(memoShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+	}
+	create := &store.MemoShare{
+		UID:       shortuuid.New(),
+		Token:     token,
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+	}
+	if request.MemoShare != nil && request.MemoShare.ExpireTime != nil {
+		expireTime := request.MemoShare.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		expiresTs := expireTime.Unix()
+		create.ExpiresTs = &expiresTs
+	}
+	share, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return convertMemoShareFromStore(memo, share), nil
+}
+
+func (s *APIV1Service) ListMemoShares(ctx context.Context, request *v1pb.ListMemoSharesRequest) (*v1pb.ListMemoSharesResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoSharesResponse{
+		MemoShares: []*v1pb.MemoShare{},
+	}
+	for _, share := range shares {
+		response.MemoShares = append(response.MemoShares, convertMemoShareFromStore(memo, share))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeMemoShare(ctx context.Context, request *v1pb.RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	memoUID, shareUID, err := ExtractMemoShareUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo share name: %v", err)
+	}
+	memo, err := s.getMemoForOwnerAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{UID: &shareUID, MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoForOwnerAccess returns the memo if the current user is allowed to manage its share links and grants.
+func (s *APIV1Service) getMemoForOwnerAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// isMemoSharedWithToken reports whether the token belongs to a share link of the memo that has not expired.
+func (s *APIV1Service) isMemoSharedWithToken(ctx context.Context, memoID int32, token string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{Token: &token, MemoID: &memoID})
+	if err != nil {
+		return false, err
+	}
+	if share == nil {
+		return false, nil
+	}
+	if share.ExpiresTs != nil && *share.ExpiresTs <= time.Now().Unix() {
+		return false, nil
+	}
+	return true, nil
+}
+
+func convertMemoShareFromStore(memo *store.Memo, share *store.MemoShare) *v1pb.MemoShare {
+	memoShare := &v1pb.MemoShare{
+		Name:       fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memo.UID, MemoShareNamePrefix, share.UID),
+		Token:      share.Token,
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, share.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(share.CreatedTs, 0)),
+	}
+	if share.ExpiresTs != nil {
+		memoShare.ExpireTime = timestamppb.New(time.Unix(*share.ExpiresTs, 0))
+	}
+	return memoShare
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTags(ctx context.Context, request *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		// Exclude comments by default.
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &normalStatus,
+	}
+	if request.Parent != "" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		memoFind.CreatorID = &userID
+	}
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else {
+		if memoFind.CreatorID == nil {
+			filter := getMemoVisibilityFilter(currentUser.ID)
+			memoFind.Filters = append(memoFind.Filters, filter)
+		} else if *memoFind.CreatorID != currentUser.ID {
+			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		}
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	memoTags := [][]string{}
+	for _, memo := range memos {
+		if memo.Payload != nil {
+			memoTags = append(memoTags, memo.Payload.Tags)
+		}
+	}
+	return &v1pb.ListTagsResponse{
+		Tags: buildTagTree(memoTags),
+	}, nil
+}
+
+// buildTagTree builds the tag tree from the tags of every memo, counting each memo once per node.
+func buildTagTree(memoTags [][]string) []*v1pb.TagTreeNode {
+	root := &v1pb.TagTreeNode{}
+	nodes := map[string]*v1pb.TagTreeNode{}
+	for _, tags := range memoTags {
+		counted := map[string]bool{}
+		for _, tag := range tags {
+			parent, path := root, ""
+			for _, name := range splitTag(tag) {
+				if path == "" {
+					path = name
+				} else {
+					path += "/" + name
+				}
+				node, ok := nodes[path]
+				if !ok {
+					node = &v1pb.TagTreeNode{Name: name, Tag: path}
+					nodes[path] = node
+					parent.Children = append(parent.Children, node)
+				}
+				if !counted[path] {
+					node.MemoCount++
+					counted[path] = true
+				}
+				parent = node
+			}
+		}
+	}
+	sortTagTree(root.Children)
+	return root.Children
+}
+
+func sortTagTree(nodes []*v1pb.TagTreeNode) {
+	slices.SortFunc(nodes, func(a, b *v1pb.TagTreeNode) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, node := range nodes {
+		sortTagTree(node.Children)
+	}
+}
+
+// splitTag splits a tag into its levels, ignoring empty levels.
+func splitTag(tag string) []string {
+	names := []string{}
+	for _, name := range strings.Split(tag, "/") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isTagInTree reports whether the tag is the root tag or one of its child tags.
+func isTagInTree(tag, root string) bool {
+	root = strings.Trim(root, "/")
+	return tag == root || strings.HasPrefix(tag, root+"/")
+}
+
+// hasTagInTree reports whether the memo has the root tag or one of its child tags.
+// Memos listed with the in_tree filter are checked again before being changed, so a loose match can't touch other memos.
+func hasTagInTree(memo *store.Memo, root string) bool {
+	return slices.ContainsFunc(memo.Payload.GetTags(), func(tag string) bool {
+		return isTagInTree(tag, root)
+	})
+}
+
+// renameTagInTree renames the tag if it is the old tag or one of its child tags, keeping the child levels.
+func renameTagInTree(tag, oldTag, newTag string) string {
+	if !isTagInTree(tag, oldTag) {
+		return tag
+	}
+	return strings.Trim(newTag, "/") + strings.TrimPrefix(tag, strings.Trim(oldTag, "/"))
+}
@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	state := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus: &state,
+		Filters:   []string{"has_task_list"},
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filters = append(memoFind.Filters, request.Filter)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else {
+		filter := getMemoVisibilityFilter(currentUser.ID)
+		memoFind.Filters = append(memoFind.Filters, filter)
+		memoFind.TagAliases, err = s.Store.GetUserTagAliases(ctx, currentUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tag aliases: %v", err)
+		}
+	}
+
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+		memoFind.OrderByUpdatedTs = true
+	}
+
+	var limit int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		memoFind.Cursor = convertCursorToStore(pageToken.Cursor)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	// Memos are listed in batches until the page has enough memos with matching tasks,
+	// so filtering out completed or uncompleted tasks doesn't leave the page short.
+	limitPlusOne := limit + 1
+	memoFind.Limit = &limitPlusOne
+	tasks := []*v1pb.Task{}
+	pageMemos, hasMore := 0, false
+	var lastMemo *store.Memo
+	for !hasMore {
+		memos, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range memos {
+			memoTasks, err := extractMemoTasks(memo.Content)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to extract tasks: %v", err)
+			}
+			matchedTasks := []*v1pb.Task{}
+			for _, task := range memoTasks {
+				if request.Completed != nil && task.Completed != *request.Completed {
+					continue
+				}
+				matchedTasks = append(matchedTasks, convertMemoTaskToProto(memo, task))
+			}
+			if len(matchedTasks) == 0 {
+				continue
+			}
+			if pageMemos == limit {
+				hasMore = true
+				break
+			}
+			tasks = append(tasks, matchedTasks...)
+			pageMemos++
+			lastMemo = memo
+		}
+		if len(memos) < limitPlusOne {
+			break
+		}
+		memoFind.Cursor = getMemoCursor(memos[len(memos)-1], memoFind)
+	}
+
+	nextPageToken := ""
+	if hasMore {
+		nextPageToken, err = getCursorPageToken(limit, getMemoCursor(lastMemo, memoFind))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	return &v1pb.ListTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) ToggleTask(ctx context.Context, request *v1pb.ToggleTaskRequest) (*v1pb.Task, error) {
+	memoUID, lineIndex, err := ExtractMemoTaskLineIndexFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Access is checked before the task is looked up, and memos the user can't see are reported as not found,
+	// so that the tasks of other memos can't be probed.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		role, err := s.getMemoGrantRole(ctx, memo.ID, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo grant")
+		}
+		if !role.CanEdit() {
+			canView := role != "" || memo.Visibility == store.Public || memo.Visibility == store.Protected
+			if !canView {
+				canView, err = s.isMemoGroupMember(ctx, memo, user.ID)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check memo groups")
+				}
+			}
+			if !canView {
+				return nil, status.Errorf(codes.NotFound, "memo not found")
+			}
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	content, err := toggleMemoTask(memo.Content, int(lineIndex))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "task not found: %v", err)
+	}
+	// Go through UpdateMemo, so that permissions, etags, revisions and webhooks work the same as for any other edit.
+	memoMessage, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content: content,
+			Etag:    request.Etag,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	memo.Content = memoMessage.Content
+	memoTasks, err := extractMemoTasks(memo.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to extract tasks: %v", err)
+	}
+	for _, task := range memoTasks {
+		if task.LineIndex == int(lineIndex) {
+			return convertMemoTaskToProto(memo, task), nil
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "task not found after toggling")
+}
+
+// memoTask is a task list item of a memo content.
+type memoTask struct {
+	// LineIndex is the zero-based index of the line of the task in the content.
+	LineIndex int
+	Text      string
+	Completed bool
+	DueDate   *time.Time
+}
+
+var taskDueDateRegexp = regexp.MustCompile(`(?:due:|📅\s*)(\d{4}-\d{2}-\d{2})`)
+
+// extractMemoTasks returns the task list items of the content in the order they appear.
+func extractMemoTasks(content string) ([]*memoTask, error) {
+	lines := strings.Split(content, "\n")
+	// The parser only takes lowercase marks as checked, uppercase ones are lowered in place for it so that no line moves.
+	parsedLines := make([]string, len(lines))
+	for i, line := range lines {
+		if markIndex := findTaskMarkIndex(line); markIndex >= 0 && line[markIndex] == 'X' {
+			line = line[:markIndex] + "x" + line[markIndex+1:]
+		}
+		parsedLines[i] = line
+	}
+	nodes, err := parser.Parse(tokenizer.Tokenize(strings.Join(parsedLines, "\n")))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+
+	tasks := []*memoTask{}
+	lineIndex := 0
+	var walk func(nodes []ast.Node)
+	walk = func(nodes []ast.Node) {
+		for _, node := range nodes {
+			switch n := node.(type) {
+			case *ast.List:
+				walk(n.Children)
+				continue
+			case *ast.LineBreak:
+				lineIndex++
+				continue
+			case *ast.TaskListItem:
+				// Task list items always span a single line, skip any that can't be located in the content.
+				if lineIndex < len(lines) && isTaskLine(lines[lineIndex], n) {
+					task := &memoTask{
+						LineIndex: lineIndex,
+						Text:      strings.TrimSpace(renderer.NewStringRenderer().Render(n.Children)),
+						Completed: n.Complete,
+					}
+					if matches := taskDueDateRegexp.FindStringSubmatch(task.Text); matches != nil {
+						if dueDate, err := time.Parse(time.DateOnly, matches[1]); err == nil {
+							task.DueDate = &dueDate
+						}
+					}
+					tasks = append(tasks, task)
+				}
+			}
+			// Multi-line blocks such as code blocks and tables restore to as many lines as they were parsed from.
+			lineIndex += strings.Count(node.Restore(), "\n")
+		}
+	}
+	walk(nodes)
+	return tasks, nil
+}
+
+// isTaskLine reports whether the line is the source of the task list item.
+func isTaskLine(line string, task *ast.TaskListItem) bool {
+	return strings.HasPrefix(strings.TrimLeft(line, " \t"), task.Symbol) && findTaskMarkIndex(line) >= 0
+}
+
+// findTaskMarkIndex returns the index of the checkbox mark of a task line, found after the indent,
+// the list symbol and the spaces following it, or -1 if the line has no checkbox there.
+// Checked boxes are marked with either "x" or "X", as in GitHub Flavored Markdown.
+func findTaskMarkIndex(line string) int {
+	index := len(line) - len(strings.TrimLeft(line, " \t"))
+	if index == len(line) || !strings.ContainsRune("-*+", rune(line[index])) {
+		return -1
+	}
+	index++
+	spaces := len(line[index:]) - len(strings.TrimLeft(line[index:], " \t"))
+	if spaces == 0 {
+		return -1
+	}
+	index += spaces
+	if len(line) < index+3 || line[index] != '[' || line[index+2] != ']' || !strings.ContainsRune(" xX", rune(line[index+1])) {
+		return -1
+	}
+	return index + 1
+}
+
+// toggleMemoTask checks or unchecks the task at the given line, leaving the rest of the content untouched.
+func toggleMemoTask(content string, lineIndex int) (string, error) {
+	tasks, err := extractMemoTasks(content)
+	if err != nil {
+		return "", err
+	}
+	for _, task := range tasks {
+		if task.LineIndex != lineIndex {
+			continue
+		}
+		lines := strings.Split(content, "\n")
+		line := lines[lineIndex]
+		markIndex := findTaskMarkIndex(line)
+		if markIndex < 0 {
+			return "", errors.Errorf("no checkbox at line %d", lineIndex)
+		}
+		mark := "x"
+		if task.Completed {
+			mark = " "
+		}
+		lines[lineIndex] = line[:markIndex] + mark + line[markIndex+1:]
+		return strings.Join(lines, "\n"), nil
+	}
+	return "", errors.Errorf("no task at line %d", lineIndex)
+}
+
+func convertMemoTaskToProto(memo *store.Memo, task *memoTask) *v1pb.Task {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	taskMessage := &v1pb.Task{
+		Name:      fmt.Sprintf("%s/%s%d", memoName, TaskNamePrefix, task.LineIndex),
+		Memo:      memoName,
+		LineIndex: int32(task.LineIndex),
+		Text:      task.Text,
+		Completed: task.Completed,
+	}
+	if task.DueDate != nil {
+		taskMessage.DueTime = timestamppb.New(*task.DueDate)
+	}
+	return taskMessage
+}
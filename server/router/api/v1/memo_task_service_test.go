@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToggleMemoTask(t *testing.T) {
+	testCases := []struct {
+		name      string
+		content   string
+		lineIndex int
+		want      string
+		expectErr bool
+	}{
+		{name: "check", content: "Todo\n- [ ] first", lineIndex: 1, want: "Todo\n- [x] first"},
+		{name: "uncheck", content: "- [x] first", lineIndex: 0, want: "- [ ] first"},
+		{name: "uncheck uppercase", content: "- [X] first", lineIndex: 0, want: "- [ ] first"},
+		{name: "indented", content: "- [ ] first\n    * [ ] nested", lineIndex: 1, want: "- [ ] first\n    * [x] nested"},
+		{name: "not a task", content: "Todo\n- [ ] first", lineIndex: 0, expectErr: true},
+		{name: "out of range", content: "- [ ] first", lineIndex: 3, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := toggleMemoTask(tc.content, tc.lineIndex)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, content)
+		})
+	}
+}
+
+func TestFindTaskMarkIndex(t *testing.T) {
+	testCases := []struct {
+		line string
+		want int
+	}{
+		{line: "- [ ] task", want: 3},
+		{line: "  + [x] task", want: 5},
+		{line: "* [X] task", want: 3},
+		{line: "-  [ ] task", want: 4},
+		{line: "\t* [ ] task", want: 4},
+		{line: "-[ ] task", want: -1},
+		{line: "- [", want: -1},
+		{line: "- [?] task", want: -1},
+		{line: "1. [ ] task", want: -1},
+		{line: "", want: -1},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, findTaskMarkIndex(tc.line), tc.line)
+	}
+}
@@ -45,6 +45,18 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
+	// Reactions to memos let the memo creator know.
+	if memoUID, err := ExtractMemoUIDFromName(reaction.ContentID); err == nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, ExcludeContent: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		if memo != nil {
+			if err := s.notifyMemoReaction(ctx, memo, reaction); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to notify memo reaction: %v", err)
+			}
+		}
+	}
 
 	reactionMessage := convertReactionFromStore(reaction)
 
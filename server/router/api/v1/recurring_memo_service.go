@@ -0,0 +1,285 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/template"
+	"github.com/usememos/memos/plugin/cron"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/recurring"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListRecurringMemos(ctx context.Context, _ *v1pb.ListRecurringMemosRequest) (*v1pb.ListRecurringMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	recurringMemos, err := s.Store.ListRecurringMemos(ctx, &store.FindRecurringMemo{CreatorID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recurring memos: %v", err)
+	}
+	response := &v1pb.ListRecurringMemosResponse{
+		RecurringMemos: []*v1pb.RecurringMemo{},
+	}
+	for _, recurringMemo := range recurringMemos {
+		response.RecurringMemos = append(response.RecurringMemos, convertRecurringMemoFromStore(recurringMemo))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetRecurringMemo(ctx context.Context, request *v1pb.GetRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	recurringMemo, err := s.getRecurringMemoByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) CreateRecurringMemo(ctx context.Context, request *v1pb.CreateRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.RecurringMemo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "recurring memo is required")
+	}
+
+	create := &store.RecurringMemo{
+		UID:        shortuuid.New(),
+		CreatorID:  user.ID,
+		Schedule:   strings.TrimSpace(request.RecurringMemo.Schedule),
+		Visibility: store.Visibility(convertTemplateVisibilityToStore(request.RecurringMemo.Visibility)),
+		Payload: &storepb.RecurringMemoPayload{
+			Template:  request.RecurringMemo.Template,
+			Content:   request.RecurringMemo.Content,
+			Variables: request.RecurringMemo.Variables,
+		},
+	}
+	if err := s.validateRecurringMemo(ctx, create); err != nil {
+		return nil, err
+	}
+	recurringMemo, err := s.Store.CreateRecurringMemo(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create recurring memo: %v", err)
+	}
+	if request.RecurringMemo.State == v1pb.State_ARCHIVED {
+		archived := store.Archived
+		recurringMemo, err = s.Store.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+			ID:        recurringMemo.ID,
+			RowStatus: &archived,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to pause recurring memo: %v", err)
+		}
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) UpdateRecurringMemo(ctx context.Context, request *v1pb.UpdateRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	if request.RecurringMemo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "recurring memo is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	recurringMemo, err := s.getRecurringMemoByName(ctx, request.RecurringMemo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updating resets the schedule start, so resumed or rescheduled recurring memos don't make up for past runs.
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateRecurringMemo{
+		ID:        recurringMemo.ID,
+		UpdatedTs: &updatedTs,
+		Payload:   recurringMemo.Payload,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "state":
+			rowStatus := convertStateToStore(request.RecurringMemo.State)
+			update.RowStatus = &rowStatus
+		case "schedule":
+			recurringMemo.Schedule = strings.TrimSpace(request.RecurringMemo.Schedule)
+			update.Schedule = &recurringMemo.Schedule
+		case "template":
+			recurringMemo.Payload.Template = request.RecurringMemo.Template
+		case "content":
+			recurringMemo.Payload.Content = request.RecurringMemo.Content
+		case "variables":
+			recurringMemo.Payload.Variables = request.RecurringMemo.Variables
+		case "visibility":
+			recurringMemo.Visibility = store.Visibility(convertTemplateVisibilityToStore(request.RecurringMemo.Visibility))
+			update.Visibility = &recurringMemo.Visibility
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := s.validateRecurringMemo(ctx, recurringMemo); err != nil {
+		return nil, err
+	}
+	recurringMemo, err = s.Store.UpdateRecurringMemo(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update recurring memo: %v", err)
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) DeleteRecurringMemo(ctx context.Context, request *v1pb.DeleteRecurringMemoRequest) (*emptypb.Empty, error) {
+	recurringMemo, err := s.getRecurringMemoByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteRecurringMemo(ctx, &store.DeleteRecurringMemo{ID: recurringMemo.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete recurring memo: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RunRecurringMemo creates the memo of a recurring memo run as its creator.
+// It goes through CreateMemoFromTemplate or CreateMemo so the memo gets the same checks, payload and webhooks.
+func (s *APIV1Service) RunRecurringMemo(ctx context.Context, recurringMemo *store.RecurringMemo, runTime time.Time) error {
+	ctx = context.WithValue(ctx, userIDContextKey, recurringMemo.CreatorID)
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get creator")
+	}
+
+	// Dates and times are the ones of the scheduled run, in the time zone of the schedule.
+	values := template.BuiltinValues(user.Username, runTime)
+	maps.Copy(values, recurringMemo.Payload.Variables)
+	visibility := convertTemplateVisibilityFromStore(string(recurringMemo.Visibility))
+	if recurringMemo.Payload.Template != "" {
+		_, err := s.CreateMemoFromTemplate(ctx, &v1pb.CreateMemoFromTemplateRequest{
+			Name:       recurringMemo.Payload.Template,
+			Variables:  values,
+			Visibility: visibility,
+		})
+		return err
+	}
+
+	content, err := template.Render(recurringMemo.Payload.Content, values)
+	if err != nil {
+		return errors.Wrap(err, "failed to render content")
+	}
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility, err = s.getUserDefaultMemoVisibility(ctx, user.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get default memo visibility")
+		}
+	}
+	_, err = s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+	return err
+}
+
+// getRecurringMemoByName returns the recurring memo if it belongs to the current user.
+func (s *APIV1Service) getRecurringMemoByName(ctx context.Context, name string) (*store.RecurringMemo, error) {
+	uid, err := ExtractRecurringMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recurring memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	recurringMemo, err := s.Store.GetRecurringMemo(ctx, &store.FindRecurringMemo{UID: &uid})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recurring memo: %v", err)
+	}
+	if recurringMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "recurring memo not found")
+	}
+	if recurringMemo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return recurringMemo, nil
+}
+
+// validateRecurringMemo checks that the recurring memo can run without anyone to prompt for variables.
+func (s *APIV1Service) validateRecurringMemo(ctx context.Context, recurringMemo *store.RecurringMemo) error {
+	if _, err := cron.ParseStandard(recurringMemo.Schedule); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	if recurringMemo.Visibility == store.Group {
+		return status.Errorf(codes.InvalidArgument, "group visibility is not supported")
+	}
+
+	payload := recurringMemo.Payload
+	if (payload.Template == "") == (payload.Content == "") {
+		return status.Errorf(codes.InvalidArgument, "either template or content is required")
+	}
+	content := payload.Content
+	if payload.Template != "" {
+		userID, templateID, err := extractTemplateFromName(payload.Template)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid template name: %v", err)
+		}
+		if _, err := s.checkTemplatePermission(ctx, userID, false); err != nil {
+			return err
+		}
+		memoTemplate, err := s.getMemoTemplate(ctx, userID, templateID)
+		if err != nil {
+			return err
+		}
+		content = memoTemplate.Content
+	}
+	for _, variable := range template.Variables(content) {
+		if _, ok := payload.Variables[variable]; !ok {
+			return status.Errorf(codes.InvalidArgument, "missing value for variable %q", variable)
+		}
+	}
+	return nil
+}
+
+func convertRecurringMemoFromStore(recurringMemo *store.RecurringMemo) *v1pb.RecurringMemo {
+	message := &v1pb.RecurringMemo{
+		Name:       fmt.Sprintf("%s%s", RecurringMemoNamePrefix, recurringMemo.UID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, recurringMemo.CreatorID),
+		State:      convertStateFromStore(recurringMemo.RowStatus),
+		Schedule:   recurringMemo.Schedule,
+		Template:   recurringMemo.Payload.Template,
+		Content:    recurringMemo.Payload.Content,
+		Variables:  recurringMemo.Payload.Variables,
+		Visibility: convertTemplateVisibilityFromStore(string(recurringMemo.Visibility)),
+		CreateTime: timestamppb.New(time.Unix(recurringMemo.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(recurringMemo.UpdatedTs, 0)),
+		MissedRuns: recurringMemo.MissedRuns,
+	}
+	if recurringMemo.LastRunTs != 0 {
+		message.LastRunTime = timestamppb.New(time.Unix(recurringMemo.LastRunTs, 0))
+	}
+	if recurringMemo.RowStatus == store.Normal {
+		if nextRunTime, err := recurring.GetNextRunTime(recurringMemo); err == nil && !nextRunTime.IsZero() {
+			message.NextRunTime = timestamppb.New(nextRunTime)
+		}
+	}
+	return message
+}
@@ -13,12 +13,18 @@ const (
 	WorkspaceSettingNamePrefix = "workspace/settings/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoShareNamePrefix        = "shares/"
+	MemoGrantNamePrefix        = "grants/"
+	TaskNamePrefix             = "tasks/"
 	AttachmentNamePrefix       = "attachments/"
 	ReactionNamePrefix         = "reactions/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identityProviders/"
+	GroupNamePrefix            = "groups/"
 	ActivityNamePrefix         = "activities/"
 	WebhookNamePrefix          = "webhooks/"
+	RecurringMemoNamePrefix    = "recurringMemos/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -84,6 +90,58 @@ func ExtractMemoUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo UID and revision ID from a resource name.
+// e.g., "memos/uuid/revisions/1" -> "uuid", 1.
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo revision ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractMemoShareUIDFromName returns the memo UID and the share UID from a resource name.
+// e.g., "memos/uuid/shares/suid" -> "uuid", "suid".
+func ExtractMemoShareUIDFromName(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareNamePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
+// ExtractMemoGrantUserIDFromName returns the memo UID and the granted user ID from a resource name.
+// e.g., "memos/uuid/grants/2" -> "uuid", 2.
+func ExtractMemoGrantUserIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoGrantNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid user ID %q", tokens[1])
+	}
+	return tokens[0], userID, nil
+}
+
+// ExtractMemoTaskLineIndexFromName returns the memo UID and the line index of a task from a resource name.
+// e.g., "memos/uuid/tasks/3" -> "uuid", 3.
+func ExtractMemoTaskLineIndexFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, TaskNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	lineIndex, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil || lineIndex < 0 {
+		return "", 0, errors.Errorf("invalid task line index %q", tokens[1])
+	}
+	return tokens[0], lineIndex, nil
+}
+
 // ExtractAttachmentUIDFromName returns the attachment UID from a resource name.
 func ExtractAttachmentUIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, AttachmentNamePrefix)
@@ -133,6 +191,27 @@ func ExtractIdentityProviderIDFromName(name string) (int32, error) {
 	return id, nil
 }
 
+func ExtractGroupIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid group ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractRecurringMemoUIDFromName returns the uid from a recurring memo resource name.
+func ExtractRecurringMemoUIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, RecurringMemoNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 func ExtractActivityIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, ActivityNamePrefix)
 	if err != nil {
@@ -319,7 +319,7 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error {
+func (s *APIV1Service) validateFilter(ctx context.Context, filterStr string) error {
 	if filterStr == "" {
 		return errors.New("filter cannot be empty")
 	}
@@ -349,5 +349,17 @@ func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error
 	if err != nil {
 		return errors.Wrap(err, "failed to convert filter to SQL")
 	}
+	// Which memos are granted to other users, or which groups they are in, isn't for the current user to find out.
+	if len(convertCtx.UserIDs) > 0 {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get current user")
+		}
+		for _, userID := range convertCtx.UserIDs {
+			if user == nil || userID != int64(user.ID) {
+				return errors.New("granted_to() and in_groups_of() only accept the id of the current user")
+			}
+		}
+	}
 	return nil
 }
@@ -0,0 +1,370 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/template"
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// workspaceTemplateParent is the parent of the templates shared with all users.
+const workspaceTemplateParent = "workspace"
+
+// extractTemplateParentFromName returns the user of a template parent, or 0 for the workspace.
+// Format: users/{user} or workspace.
+func extractTemplateParentFromName(parent string) (int32, error) {
+	if parent == workspaceTemplateParent {
+		return 0, nil
+	}
+	return ExtractUserIDFromName(parent)
+}
+
+// extractTemplateFromName returns the user, or 0 for the workspace, and the id of a template.
+// Format: users/{user}/templates/{template} or workspace/templates/{template}.
+func extractTemplateFromName(name string) (int32, string, error) {
+	parent, templateID, ok := strings.Cut(name, "/templates/")
+	if !ok || templateID == "" || strings.Contains(templateID, "/") {
+		return 0, "", errors.Errorf("invalid template name format: %s", name)
+	}
+	userID, err := extractTemplateParentFromName(parent)
+	if err != nil {
+		return 0, "", err
+	}
+	return userID, templateID, nil
+}
+
+func constructTemplateName(userID int32, templateID string) string {
+	if userID == 0 {
+		return fmt.Sprintf("%s/templates/%s", workspaceTemplateParent, templateID)
+	}
+	return fmt.Sprintf("%s%d/templates/%s", UserNamePrefix, userID, templateID)
+}
+
+func (s *APIV1Service) ListTemplates(ctx context.Context, request *v1pb.ListTemplatesRequest) (*v1pb.ListTemplatesResponse, error) {
+	userID, err := extractTemplateParentFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if _, err := s.checkTemplatePermission(ctx, userID, false); err != nil {
+		return nil, err
+	}
+
+	memoTemplates, err := s.listMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+	}
+	response := &v1pb.ListTemplatesResponse{
+		Templates: []*v1pb.Template{},
+	}
+	for _, memoTemplate := range memoTemplates {
+		response.Templates = append(response.Templates, convertTemplateFromStore(userID, memoTemplate))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetTemplate(ctx context.Context, request *v1pb.GetTemplateRequest) (*v1pb.Template, error) {
+	userID, templateID, err := extractTemplateFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template name: %v", err)
+	}
+	if _, err := s.checkTemplatePermission(ctx, userID, false); err != nil {
+		return nil, err
+	}
+
+	memoTemplate, err := s.getMemoTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return convertTemplateFromStore(userID, memoTemplate), nil
+}
+
+func (s *APIV1Service) CreateTemplate(ctx context.Context, request *v1pb.CreateTemplateRequest) (*v1pb.Template, error) {
+	userID, err := extractTemplateParentFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if _, err := s.checkTemplatePermission(ctx, userID, true); err != nil {
+		return nil, err
+	}
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+
+	memoTemplate := &storepb.MemoTemplate{
+		Id:         util.GenUUID(),
+		Title:      strings.TrimSpace(request.Template.Title),
+		Content:    request.Template.Content,
+		Visibility: convertTemplateVisibilityToStore(request.Template.Visibility),
+	}
+	if err := validateMemoTemplate(memoTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	if err := s.upsertMemoTemplate(ctx, userID, memoTemplate); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create template: %v", err)
+	}
+	return convertTemplateFromStore(userID, memoTemplate), nil
+}
+
+func (s *APIV1Service) UpdateTemplate(ctx context.Context, request *v1pb.UpdateTemplateRequest) (*v1pb.Template, error) {
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+	userID, templateID, err := extractTemplateFromName(request.Template.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template name: %v", err)
+	}
+	if _, err := s.checkTemplatePermission(ctx, userID, true); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	memoTemplate, err := s.getMemoTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			memoTemplate.Title = strings.TrimSpace(request.Template.Title)
+		case "content":
+			memoTemplate.Content = request.Template.Content
+		case "visibility":
+			memoTemplate.Visibility = convertTemplateVisibilityToStore(request.Template.Visibility)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := validateMemoTemplate(memoTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	if err := s.upsertMemoTemplate(ctx, userID, memoTemplate); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update template: %v", err)
+	}
+	return convertTemplateFromStore(userID, memoTemplate), nil
+}
+
+func (s *APIV1Service) DeleteTemplate(ctx context.Context, request *v1pb.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	userID, templateID, err := extractTemplateFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template name: %v", err)
+	}
+	if _, err := s.checkTemplatePermission(ctx, userID, true); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMemoTemplate(ctx, userID, templateID); err != nil {
+		return nil, err
+	}
+	if err := s.removeMemoTemplate(ctx, userID, templateID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete template: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateMemoFromTemplate renders the template for the current user and creates the memo through CreateMemo,
+// so the memo gets the same checks, payload and webhooks as any other memo.
+func (s *APIV1Service) CreateMemoFromTemplate(ctx context.Context, request *v1pb.CreateMemoFromTemplateRequest) (*v1pb.Memo, error) {
+	userID, templateID, err := extractTemplateFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template name: %v", err)
+	}
+	user, err := s.checkTemplatePermission(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	memoTemplate, err := s.getMemoTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := template.BuiltinValues(user.Username, time.Now())
+	maps.Copy(values, request.Variables)
+	content, err := template.Render(memoTemplate.Content, values)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to render template: %v", err)
+	}
+
+	visibility := request.Visibility
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility = convertTemplateVisibilityFromStore(memoTemplate.Visibility)
+	}
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility, err = s.getUserDefaultMemoVisibility(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get default memo visibility: %v", err)
+		}
+	}
+	return s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+}
+
+// checkTemplatePermission returns the current user if they can read, or write, the templates of the user.
+// Workspace templates can be read by everyone signed in but only written by admins.
+func (s *APIV1Service) checkTemplatePermission(ctx context.Context, userID int32, write bool) (*store.User, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if userID == 0 {
+		if write && !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		return user, nil
+	}
+	if user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+func (s *APIV1Service) listMemoTemplates(ctx context.Context, userID int32) ([]*storepb.MemoTemplate, error) {
+	if userID != 0 {
+		return s.Store.GetUserTemplates(ctx, userID)
+	}
+	workspaceTemplateSetting, err := s.Store.GetWorkspaceTemplateSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return workspaceTemplateSetting.Templates, nil
+}
+
+func (s *APIV1Service) getMemoTemplate(ctx context.Context, userID int32, templateID string) (*storepb.MemoTemplate, error) {
+	memoTemplates, err := s.listMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list templates: %v", err)
+	}
+	for _, memoTemplate := range memoTemplates {
+		if memoTemplate.Id == templateID {
+			return memoTemplate, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "template not found")
+}
+
+func (s *APIV1Service) upsertMemoTemplate(ctx context.Context, userID int32, memoTemplate *storepb.MemoTemplate) error {
+	if userID != 0 {
+		return s.Store.UpsertUserTemplate(ctx, userID, memoTemplate)
+	}
+	memoTemplates, err := s.listMemoTemplates(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updatedTemplates := make([]*storepb.MemoTemplate, 0, len(memoTemplates)+1)
+	templateExists := false
+	for _, existing := range memoTemplates {
+		if existing.Id == memoTemplate.Id {
+			updatedTemplates = append(updatedTemplates, memoTemplate)
+			templateExists = true
+		} else {
+			updatedTemplates = append(updatedTemplates, existing)
+		}
+	}
+	if !templateExists {
+		updatedTemplates = append(updatedTemplates, memoTemplate)
+	}
+	return s.upsertWorkspaceTemplates(ctx, updatedTemplates)
+}
+
+func (s *APIV1Service) removeMemoTemplate(ctx context.Context, userID int32, templateID string) error {
+	if userID != 0 {
+		return s.Store.RemoveUserTemplate(ctx, userID, templateID)
+	}
+	memoTemplates, err := s.listMemoTemplates(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updatedTemplates := make([]*storepb.MemoTemplate, 0, len(memoTemplates))
+	for _, existing := range memoTemplates {
+		if existing.Id != templateID {
+			updatedTemplates = append(updatedTemplates, existing)
+		}
+	}
+	return s.upsertWorkspaceTemplates(ctx, updatedTemplates)
+}
+
+func (s *APIV1Service) upsertWorkspaceTemplates(ctx context.Context, memoTemplates []*storepb.MemoTemplate) error {
+	_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_TEMPLATE,
+		Value: &storepb.WorkspaceSetting_TemplateSetting{
+			TemplateSetting: &storepb.WorkspaceTemplateSetting{
+				Templates: memoTemplates,
+			},
+		},
+	})
+	return err
+}
+
+// getUserDefaultMemoVisibility returns the memo visibility from the general setting of the user.
+func (s *APIV1Service) getUserDefaultMemoVisibility(ctx context.Context, userID int32) (v1pb.Visibility, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return v1pb.Visibility_VISIBILITY_UNSPECIFIED, err
+	}
+	visibility := v1pb.Visibility(v1pb.Visibility_value[userSetting.GetGeneral().GetMemoVisibility()])
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		return v1pb.Visibility_PRIVATE, nil
+	}
+	return visibility, nil
+}
+
+func validateMemoTemplate(memoTemplate *storepb.MemoTemplate) error {
+	if memoTemplate.Title == "" {
+		return errors.New("title is required")
+	}
+	if strings.TrimSpace(memoTemplate.Content) == "" {
+		return errors.New("content is required")
+	}
+	// Group memos need groups, which templates don't have.
+	if memoTemplate.Visibility == string(store.Group) {
+		return errors.New("group visibility is not supported")
+	}
+	return nil
+}
+
+func convertTemplateVisibilityToStore(visibility v1pb.Visibility) string {
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		return ""
+	}
+	return string(convertVisibilityToStore(visibility))
+}
+
+func convertTemplateVisibilityFromStore(visibility string) v1pb.Visibility {
+	if visibility == "" {
+		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
+	}
+	return convertVisibilityFromStore(store.Visibility(visibility))
+}
+
+func convertTemplateFromStore(userID int32, memoTemplate *storepb.MemoTemplate) *v1pb.Template {
+	return &v1pb.Template{
+		Name:       constructTemplateName(userID, memoTemplate.Id),
+		Title:      memoTemplate.Title,
+		Content:    memoTemplate.Content,
+		Visibility: convertTemplateVisibilityFromStore(memoTemplate.Visibility),
+		Variables:  template.Variables(memoTemplate.Content),
+	}
+}
@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/ingest"
+)
+
+func TestEmailIngest(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Authenticating needs TLS, so the listener has a self-signed certificate trusted by the test client.
+	certPEM, keyPEM, err := emailtest.NewCertificate()
+	require.NoError(t, err)
+	ts.Profile.SMTPTLSCert = filepath.Join(t.TempDir(), "cert.pem")
+	ts.Profile.SMTPTLSKey = filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(ts.Profile.SMTPTLSCert, certPEM, 0600))
+	require.NoError(t, os.WriteFile(ts.Profile.SMTPTLSKey, keyPEM, 0600))
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certPEM))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	service, err := ingest.NewService(ts.Profile, ts.Store, ts.Service)
+	require.NoError(t, err)
+	go service.Serve(ctx, listener)
+	defer service.Close()
+	addr := listener.Addr().String()
+
+	// sendMail sends the message like smtp.SendMail, over STARTTLS.
+	sendMail := func(auth smtp.Auth, from string, to []string, data []byte) error {
+		client, err := smtp.Dial(addr)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		if err := client.StartTLS(&tls.Config{RootCAs: roots, ServerName: "127.0.0.1"}); err != nil {
+			return err
+		}
+		if auth != nil {
+			if err := client.Auth(auth); err != nil {
+				return err
+			}
+		}
+		if err := client.Mail(from); err != nil {
+			return err
+		}
+		for _, recipient := range to {
+			if err := client.Rcpt(recipient); err != nil {
+				return err
+			}
+		}
+		writer, err := client.Data()
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(data); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		return client.Quit()
+	}
+
+	updateSetting := func(emailIngestSetting *v1pb.UserSetting_EmailIngestSetting, paths ...string) (*v1pb.UserSetting_EmailIngestSetting, error) {
+		setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/EMAIL_INGEST", user.ID),
+				Value: &v1pb.UserSetting_EmailIngestSetting_{EmailIngestSetting: emailIngestSetting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		return setting.GetEmailIngestSetting(), err
+	}
+	listMemos := func() []*v1pb.Memo {
+		memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		return memos.Memos
+	}
+	message := func(from, subject, body string) []byte {
+		return []byte(strings.Join([]string{
+			"From: " + from,
+			"Subject: " + subject,
+			"MIME-Version: 1.0",
+			`Content-Type: multipart/mixed; boundary="b"`,
+			"",
+			"--b",
+			"Content-Type: text/html; charset=utf-8",
+			"",
+			body,
+			"--b",
+			"Content-Type: application/pdf",
+			`Content-Disposition: attachment; filename="report.pdf"`,
+			"Content-Transfer-Encoding: base64",
+			"",
+			"JVBERi0xLjQ=",
+			"--b--",
+			"",
+		}, "\r\n"))
+	}
+
+	// Emails are refused until the user enables creating memos by email.
+	err = sendMail(nil, user.Email, []string{"token@localhost"}, message(user.Email, "Hello", "<p>Hi</p>"))
+	require.Error(t, err)
+
+	setting, err := updateSetting(&v1pb.UserSetting_EmailIngestSetting{Enabled: true}, "enabled")
+	require.NoError(t, err)
+	require.True(t, setting.Enabled)
+	require.Len(t, setting.Token, 32)
+	require.Equal(t, setting.Token+"@localhost", setting.Address)
+
+	// Only the user's email address is allowed by default.
+	err = sendMail(nil, "eve@example.com", []string{setting.Address}, message("eve@example.com", "Spam", "<p>Buy now</p>"))
+	require.Error(t, err)
+	require.Empty(t, listMemos())
+
+	err = sendMail(nil, user.Email, []string{strings.ToUpper(setting.Address)}, message(user.Email, "Groceries", "<p>Buy <b>milk</b></p>"))
+	require.NoError(t, err)
+	memos := listMemos()
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Groceries\n\nBuy **milk**", memos[0].Content)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memos[0].Visibility)
+	require.Len(t, memos[0].Attachments, 1)
+	require.Equal(t, "report.pdf", memos[0].Attachments[0].Filename)
+	require.Equal(t, "application/pdf", memos[0].Attachments[0].Type)
+	require.Equal(t, int64(8), memos[0].Attachments[0].Size)
+
+	// The envelope sender is checked, not the From header anyone can set.
+	err = sendMail(nil, "eve@example.com", []string{setting.Address}, message(user.Email, "Spoofed", "<p>Hi</p>"))
+	require.Error(t, err)
+	require.Len(t, listMemos(), 1)
+
+	// Allowed senders can be addresses or domains.
+	_, err = updateSetting(&v1pb.UserSetting_EmailIngestSetting{AllowedSenders: []string{"not an address"}}, "allowedSenders")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = updateSetting(&v1pb.UserSetting_EmailIngestSetting{AllowedSenders: []string{"@Example.org"}}, "allowedSenders")
+	require.NoError(t, err)
+	err = sendMail(nil, "bob@example.org", []string{setting.Address}, message("bob@example.org", "From Bob", "<p>Hi</p>"))
+	require.NoError(t, err)
+	require.Len(t, listMemos(), 2)
+
+	// Senders can also authenticate with the username and the token, but only over TLS.
+	client, err := smtp.Dial(addr)
+	require.NoError(t, err)
+	require.ErrorContains(t, client.Auth(smtp.PlainAuth("", user.Username, setting.Token, "127.0.0.1")), "538")
+	client.Close()
+	err = sendMail(smtp.PlainAuth("", user.Username, "wrong", "127.0.0.1"), "eve@example.com", []string{"anything@example.com"}, message("eve@example.com", "Auth", "<p>Hi</p>"))
+	require.Error(t, err)
+	err = sendMail(smtp.PlainAuth("", user.Username, setting.Token, "127.0.0.1"), "script@example.net", []string{"anything@example.com"}, message("script@example.net", "Auth", "<p>Hi</p>"))
+	require.NoError(t, err)
+	require.Len(t, listMemos(), 3)
+
+	// Emails over the size limit are refused.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_EMAIL_INGEST,
+		Value: &storepb.WorkspaceSetting_EmailIngestSetting{
+			EmailIngestSetting: &storepb.WorkspaceEmailIngestSetting{Domain: "memos.example.com", MaxMessageSizeMb: 1},
+		},
+	})
+	require.NoError(t, err)
+	err = sendMail(nil, "bob@example.org", []string{setting.Address}, message("bob@example.org", "Large", strings.Repeat("a", 2<<20)))
+	require.ErrorContains(t, err, "552")
+	require.Len(t, listMemos(), 3)
+
+	// Regenerating the token changes the address, and disabling removes it.
+	regenerated, err := updateSetting(&v1pb.UserSetting_EmailIngestSetting{}, "token")
+	require.NoError(t, err)
+	require.NotEqual(t, setting.Token, regenerated.Token)
+	require.Equal(t, regenerated.Token+"@memos.example.com", regenerated.Address)
+	err = sendMail(nil, "bob@example.org", []string{setting.Address}, message("bob@example.org", "Old", "<p>Hi</p>"))
+	require.Error(t, err)
+	disabled, err := updateSetting(&v1pb.UserSetting_EmailIngestSetting{Enabled: false}, "enabled")
+	require.NoError(t, err)
+	require.False(t, disabled.Enabled)
+	require.Empty(t, disabled.Address)
+	err = sendMail(nil, "bob@example.org", []string{regenerated.Address}, message("bob@example.org", "Disabled", "<p>Hi</p>"))
+	require.Error(t, err)
+}
@@ -0,0 +1,286 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/digest"
+	"github.com/usememos/memos/store"
+)
+
+// enableEmail points the SMTP setting of the workspace to the fake server.
+func enableEmail(ctx context.Context, t *testing.T, ts *TestService, server *emailtest.Server) {
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_SMTP,
+		Value: &storepb.WorkspaceSetting_SmtpSetting{
+			SmtpSetting: &storepb.WorkspaceSMTPSetting{
+				EnableEmail: true,
+				Host:        server.Host(),
+				Port:        int32(server.Port()),
+				Security:    storepb.WorkspaceSMTPSetting_NONE,
+				FromEmail:   "memos@example.com",
+				FromName:    "Memos",
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+// getEmailText returns the plain text body of the email.
+func getEmailText(t *testing.T, message *emailtest.Message) string {
+	parsed, err := message.Parse()
+	require.NoError(t, err)
+	_, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	part, err := multipart.NewReader(parsed.Body, params["boundary"]).NextPart()
+	require.NoError(t, err)
+	text, err := io.ReadAll(quotedprintable.NewReader(part))
+	require.NoError(t, err)
+	return string(text)
+}
+
+// getLinkToken returns the token query parameter of the link in the email.
+func getLinkToken(t *testing.T, message *emailtest.Message, parameter string) string {
+	match := regexp.MustCompile(`http://localhost:8080/\S+`).FindString(getEmailText(t, message))
+	require.NotEmpty(t, match)
+	link, err := url.Parse(match)
+	require.NoError(t, err)
+	return link.Query().Get(parameter)
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+
+	// Resetting passwords needs email.
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: user.Email})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	server, err := emailtest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+	enableEmail(ctx, t, ts, server)
+
+	// Unknown addresses get the same response, and no email.
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "nobody@example.com"})
+	require.NoError(t, err)
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: user.Email})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(server.Messages()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	message := server.Messages()[0]
+	require.Equal(t, []string{user.Email}, message.To)
+	token := getLinkToken(t, message, "token")
+	require.NotEmpty(t, token)
+
+	require.NoError(t, ts.Store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{SessionId: "session"}))
+	_, err = ts.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{
+			AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{{AccessToken: "token"}},
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: "invalid", NewPassword: "new-password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+	require.NoError(t, err)
+	user, err = ts.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("new-password")))
+
+	// The sessions and access tokens are revoked.
+	sessions, err := ts.Store.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+	accessTokens, err := ts.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, accessTokens)
+
+	// The token can only be used once.
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInviteUser(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_GENERAL,
+		Value: &storepb.WorkspaceSetting_GeneralSetting{
+			GeneralSetting: &storepb.WorkspaceGeneralSetting{DisallowUserRegistration: true},
+		},
+	})
+	require.NoError(t, err)
+	server, err := emailtest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	// Inviting needs email, and only admins can invite.
+	_, err = ts.Service.InviteUser(hostCtx, &v1pb.InviteUserRequest{Email: "bob@example.com"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	enableEmail(ctx, t, ts, server)
+	_, err = ts.Service.InviteUser(ts.CreateUserContext(ctx, user.ID), &v1pb.InviteUserRequest{Email: "bob@example.com"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.InviteUser(hostCtx, &v1pb.InviteUserRequest{Email: user.Email})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.InviteUser(hostCtx, &v1pb.InviteUserRequest{Email: "bob@example.com"})
+	require.NoError(t, err)
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, []string{"bob@example.com"}, messages[0].To)
+	token := getLinkToken(t, messages[0], "invitation")
+	require.NotEmpty(t, token)
+
+	// Signing up needs the invitation, which sets the email address.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "bob", Password: "password"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	created, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:            &v1pb.User{Username: "bob", Password: "password", Email: "other@example.com"},
+		InvitationToken: token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "bob@example.com", created.Email)
+	require.Equal(t, v1pb.User_USER, created.Role)
+
+	// The invitation can only be accepted once.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+		User:            &v1pb.User{Username: "bob2", Password: "password"},
+		InvitationToken: token,
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestEmailNotifications(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+	server, err := emailtest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+	enableEmail(ctx, t, ts, server)
+	go ts.Service.NotificationDispatcher.Run(ctx)
+
+	// Comments are emailed as well as delivered to the inbox, reactions go to the daily digest.
+	_, err = ts.Service.UpdateUserSetting(authorCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/NOTIFICATIONS", author.ID),
+			Value: &v1pb.UserSetting_NotificationsSetting_{NotificationsSetting: &v1pb.UserSetting_NotificationsSetting{
+				Preferences: []*v1pb.UserSetting_NotificationsSetting_Preference{{
+					Type: v1pb.Inbox_MEMO_COMMENT,
+					Channels: []v1pb.UserSetting_NotificationsSetting_Channel{
+						v1pb.UserSetting_NotificationsSetting_INBOX,
+						v1pb.UserSetting_NotificationsSetting_EMAIL,
+					},
+				}},
+				DigestFrequency: v1pb.UserSetting_NotificationsSetting_DAILY,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences", "digestFrequency"}},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "Nice memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(server.Messages()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	text := getEmailText(t, server.Messages()[0])
+	require.Contains(t, text, "commenter commented on your memo")
+	require.Contains(t, text, "Nice memo")
+	require.Contains(t, text, "http://localhost:8080/"+comment.Name)
+
+	_, err = ts.Service.UpsertMemoReaction(commenterCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+
+	// The digest bundles the unread inbox items of the day.
+	digest.NewRunner(ts.Store, ts.Profile.InstanceURL).RunOnce(ctx)
+	messages := server.Messages()
+	require.Len(t, messages, 2)
+	require.Equal(t, []string{author.Email}, messages[1].To)
+	parsed, err := messages[1].Parse()
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Your daily Memos digest", subject)
+	text = getEmailText(t, messages[1])
+	require.Contains(t, text, "commenter commented on your memo")
+	require.Contains(t, text, "commenter reacted to your memo")
+	require.Contains(t, text, "http://localhost:8080/inbox")
+
+	// The digest isn't sent again before the next one is due, e.g. when the server restarts.
+	digest.NewRunner(ts.Store, ts.Profile.InstanceURL).RunOnce(ctx)
+	require.Len(t, server.Messages(), 2)
+	notificationsSetting, err := ts.Store.GetUserNotificationsSetting(ctx, author.ID)
+	require.NoError(t, err)
+	require.NotZero(t, notificationsSetting.LastDigestTs)
+
+	// Read items are left out, and nothing is sent when there are none.
+	require.NoError(t, ts.Store.UpdateUserSetting(ctx, author.ID, storepb.UserSetting_NOTIFICATIONS, func(userSetting *storepb.UserSetting) error {
+		userSetting.GetNotifications().LastDigestTs = 0
+		return nil
+	}))
+	inboxes, err := ts.Service.ListInboxes(authorCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", author.ID)})
+	require.NoError(t, err)
+	for _, inbox := range inboxes.Inboxes {
+		_, err := ts.Service.UpdateInbox(authorCtx, &v1pb.UpdateInboxRequest{
+			Inbox:      &v1pb.Inbox{Name: inbox.Name, Status: v1pb.Inbox_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+		})
+		require.NoError(t, err)
+	}
+	digest.NewRunner(ts.Store, ts.Profile.InstanceURL).RunOnce(ctx)
+	require.Len(t, server.Messages(), 2)
+}
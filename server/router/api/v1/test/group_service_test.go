@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGroupCRUD(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	// Only admins can manage groups.
+	_, err = ts.Service.CreateGroup(userCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "engineering"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	group, err := ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{
+		Group: &v1pb.Group{
+			DisplayName: "engineering",
+			Description: "The engineering team",
+			Members:     []string{userName},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "engineering", group.DisplayName)
+	require.Equal(t, []string{userName}, group.Members)
+
+	_, err = ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "engineering"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "design", Members: []string{"users/999"}}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Signed-in users can read groups.
+	resp, err := ts.Service.ListGroups(userCtx, &v1pb.ListGroupsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Groups, 1)
+	_, err = ts.Service.ListGroups(ctx, &v1pb.ListGroupsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	group, err = ts.Service.UpdateGroup(hostCtx, &v1pb.UpdateGroupRequest{
+		Group:      &v1pb.Group{Name: group.Name, DisplayName: "platform", Members: []string{}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "members"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "platform", group.DisplayName)
+	require.Empty(t, group.Members)
+
+	_, err = ts.Service.DeleteGroup(userCtx, &v1pb.DeleteGroupRequest{Name: group.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteGroup(hostCtx, &v1pb.DeleteGroupRequest{Name: group.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetGroup(hostCtx, &v1pb.GetGroupRequest{Name: group.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGroupMemoVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	member, err := ts.CreateRegularUser(ctx, "member")
+	require.NoError(t, err)
+	memberCtx := ts.CreateUserContext(ctx, member.ID)
+	outsider, err := ts.CreateRegularUser(ctx, "outsider")
+	require.NoError(t, err)
+	outsiderCtx := ts.CreateUserContext(ctx, outsider.ID)
+
+	group, err := ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{
+		Group: &v1pb.Group{
+			DisplayName: "engineering",
+			Members:     []string{fmt.Sprintf("users/%d", user.ID), fmt.Sprintf("users/%d", member.ID)},
+		},
+	})
+	require.NoError(t, err)
+	otherGroup, err := ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "design"}})
+	require.NoError(t, err)
+
+	// Group memos need a group the creator belongs to.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "group memo", Visibility: v1pb.Visibility_GROUP},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "group memo", Visibility: v1pb.Visibility_GROUP, Groups: []string{otherGroup.Name}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: "hello.txt",
+			Size:     5,
+			Type:     "text/plain",
+			Content:  []byte("hello"),
+		},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     "group memo",
+			Visibility:  v1pb.Visibility_GROUP,
+			Groups:      []string{group.Name},
+			Attachments: []*v1pb.Attachment{attachment},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_GROUP, memo.Visibility)
+	require.Equal(t, []string{group.Name}, memo.Groups)
+
+	// Members can read the memo, its attachments and comment on it.
+	_, err = ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	memos, err := ts.Service.ListMemos(memberCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	_, err = ts.Service.GetAttachmentBinary(memberCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "hello.txt"})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(memberCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "comment", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// Everyone else can't.
+	_, err = ts.Service.GetMemo(outsiderCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memos, err = ts.Service.ListMemos(outsiderCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 0)
+	_, err = ts.Service.GetAttachmentBinary(outsiderCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "hello.txt"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = ts.Service.CreateMemoComment(outsiderCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "comment", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Filters can only ask for the group memos of the current user.
+	memos, err = ts.Service.ListMemos(memberCtx, &v1pb.ListMemosRequest{Filter: fmt.Sprintf("in_groups_of(%d)", member.ID)})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	_, err = ts.Service.ListMemos(outsiderCtx, &v1pb.ListMemosRequest{Filter: fmt.Sprintf("in_groups_of(%d)", member.ID)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Leaving the group visibility drops the groups of the memo.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.Groups)
+	_, err = ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
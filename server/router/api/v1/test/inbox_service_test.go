@@ -170,6 +170,130 @@ func TestListInboxes(t *testing.T) {
 	})
 }
 
+func TestListInboxesFilterAndPaging(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	createTestInboxes(ctx, t, ts, user.ID)
+
+	// The filter is applied before paging, and the total size counts every page.
+	resp, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{
+		Parent:   fmt.Sprintf("users/%d", user.ID),
+		PageSize: 2,
+		Filter:   `status == "UNREAD" && message_type in ["MEMO_COMMENT", "MEMO_MENTION"]`,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Inboxes, 2)
+	require.Equal(t, int32(4), resp.TotalSize)
+	next, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{
+		Parent:    fmt.Sprintf("users/%d", user.ID),
+		PageToken: resp.NextPageToken,
+		Filter:    `status == "UNREAD" && message_type in ["MEMO_COMMENT", "MEMO_MENTION"]`,
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Inboxes, 2)
+	require.Empty(t, next.NextPageToken)
+	// The total size is only counted for the first page.
+	require.Equal(t, int32(0), next.TotalSize)
+	for _, inbox := range append(resp.Inboxes, next.Inboxes...) {
+		require.Equal(t, v1pb.Inbox_UNREAD, inbox.Status)
+		require.NotEqual(t, v1pb.Inbox_MEMO_REACTION, inbox.Type)
+	}
+
+	// Unknown fields can't be filtered on.
+	_, err = ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Filter: `sender == "users/1"`,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBatchUpdateInboxesAndStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	createTestInboxes(ctx, t, ts, user.ID)
+
+	stats, err := ts.Service.GetInboxStats(userCtx, &v1pb.GetInboxStatsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Equal(t, int32(6), stats.UnreadCount)
+	require.Equal(t, map[string]int32{"MEMO_COMMENT": 3, "MEMO_MENTION": 1, "MEMO_REACTION": 2}, stats.UnreadTypeCount)
+	_, err = ts.Service.GetInboxStats(otherUserCtx, &v1pb.GetInboxStatsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Only the receiver can update their inboxes.
+	_, err = ts.Service.BatchUpdateInboxes(otherUserCtx, &v1pb.BatchUpdateInboxesRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Status: v1pb.Inbox_ARCHIVED,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Mark the reactions as read.
+	resp, err := ts.Service.BatchUpdateInboxes(userCtx, &v1pb.BatchUpdateInboxesRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Filter: `message_type == "MEMO_REACTION"`,
+		Status: v1pb.Inbox_ARCHIVED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.UpdatedCount)
+	stats, err = ts.Service.GetInboxStats(userCtx, &v1pb.GetInboxStatsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), stats.UnreadCount)
+	require.NotContains(t, stats.UnreadTypeCount, "MEMO_REACTION")
+
+	// Mark everything as read.
+	_, err = ts.Service.BatchUpdateInboxes(userCtx, &v1pb.BatchUpdateInboxesRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Status: v1pb.Inbox_ARCHIVED,
+	})
+	require.NoError(t, err)
+	stats, err = ts.Service.GetInboxStats(userCtx, &v1pb.GetInboxStatsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), stats.UnreadCount)
+	require.Empty(t, stats.UnreadTypeCount)
+}
+
+// createTestInboxes creates unread comment, mention and reaction inboxes and an archived comment inbox for the user.
+func createTestInboxes(ctx context.Context, t *testing.T, ts *TestService, userID int32) {
+	const systemBotID int32 = 0
+	for _, inboxType := range []storepb.InboxMessage_Type{
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_MEMO_MENTION,
+		storepb.InboxMessage_MEMO_REACTION,
+		storepb.InboxMessage_MEMO_REACTION,
+	} {
+		_, err := ts.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   systemBotID,
+			ReceiverID: userID,
+			Status:     store.UNREAD,
+			Message:    &storepb.InboxMessage{Type: inboxType},
+		})
+		require.NoError(t, err)
+	}
+	_, err := ts.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   systemBotID,
+		ReceiverID: userID,
+		Status:     store.ARCHIVED,
+		Message:    &storepb.InboxMessage{Type: storepb.InboxMessage_MEMO_COMMENT},
+	})
+	require.NoError(t, err)
+}
+
 func TestUpdateInbox(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	initial, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, initial.NextSyncToken)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo to sync",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	reaction, err := ts.Service.UpsertMemoReaction(userCtx, &apiv1.UpsertMemoReactionRequest{
+		Name: memo.Name,
+		Reaction: &apiv1.Reaction{
+			ContentId:    memo.Name,
+			ReactionType: "👍",
+		},
+	})
+	require.NoError(t, err)
+
+	changes, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.False(t, changes.HasMore)
+	require.Len(t, changes.Memos, 1)
+	require.Equal(t, memo.Name, changes.Memos[0].Name)
+	require.Len(t, changes.Reactions, 1)
+	require.Equal(t, reaction.Name, changes.Reactions[0].Name)
+	require.Empty(t, changes.Tombstones)
+
+	// Nothing changed since the last sync.
+	unchanged, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: changes.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, unchanged.Memos)
+	require.Empty(t, unchanged.Tombstones)
+	require.Equal(t, changes.NextSyncToken, unchanged.NextSyncToken)
+
+	_, err = ts.Service.DeleteMemoReaction(userCtx, &apiv1.DeleteMemoReactionRequest{Name: reaction.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	deletions, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: changes.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, deletions.Memos)
+	require.Empty(t, deletions.Reactions)
+	require.Len(t, deletions.Tombstones, 2)
+	require.Equal(t, apiv1.ListMemoChangesResponse_Tombstone_REACTION, deletions.Tombstones[0].Type)
+	require.Equal(t, reaction.Name, deletions.Tombstones[0].Name)
+	require.Equal(t, apiv1.ListMemoChangesResponse_Tombstone_MEMO, deletions.Tombstones[1].Type)
+	require.Equal(t, memo.Name, deletions.Tombstones[1].Name)
+}
+
+func TestListMemoChangesVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	initial, err := ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "private memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	publicMemo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "public memo",
+			Visibility: apiv1.Visibility_PUBLIC,
+		},
+	})
+	require.NoError(t, err)
+
+	// Other users only learn about memos they can see.
+	changes, err := ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, changes.Memos, 1)
+	require.Equal(t, publicMemo.Name, changes.Memos[0].Name)
+	require.Empty(t, changes.Tombstones)
+
+	// Anonymous users see public memos too.
+	anonymousChanges, err := ts.Service.ListMemoChanges(ctx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, anonymousChanges.Memos, 1)
+
+	// Making a memo private removes it from the view of other users.
+	_, err = ts.Service.UpdateMemo(ownerCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:       publicMemo.Name,
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+
+	narrowed, err := ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{SyncToken: changes.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, narrowed.Memos)
+	require.Len(t, narrowed.Tombstones, 1)
+	require.Equal(t, publicMemo.Name, narrowed.Tombstones[0].Name)
+
+	// The owner still sees the memo as updated.
+	ownerChanges, err := ts.Service.ListMemoChanges(ownerCtx, &apiv1.ListMemoChangesRequest{SyncToken: changes.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, ownerChanges.Memos, 1)
+	require.Empty(t, ownerChanges.Tombstones)
+}
+
+func TestListMemoChangesGrants(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	grantee, err := ts.CreateRegularUser(ctx, "grantee")
+	require.NoError(t, err)
+	granteeCtx := ts.CreateUserContext(ctx, grantee.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "private memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	initial, err := ts.Service.ListMemoChanges(granteeCtx, &apiv1.ListMemoChangesRequest{})
+	require.NoError(t, err)
+
+	// Granting the memo makes it appear for the grantee only.
+	grant, err := ts.Service.UpsertMemoGrant(ownerCtx, &apiv1.UpsertMemoGrantRequest{
+		Parent:    memo.Name,
+		MemoGrant: &apiv1.MemoGrant{User: fmt.Sprintf("users/%d", grantee.ID), Role: apiv1.MemoGrant_VIEWER},
+	})
+	require.NoError(t, err)
+	granted, err := ts.Service.ListMemoChanges(granteeCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, granted.Memos, 1)
+	require.Equal(t, memo.Name, granted.Memos[0].Name)
+	otherChanges, err := ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, otherChanges.Memos)
+	require.Empty(t, otherChanges.Tombstones)
+
+	// Later updates of the memo reach the grantee.
+	_, err = ts.Service.UpdateMemo(ownerCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "updated memo"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	updated, err := ts.Service.ListMemoChanges(granteeCtx, &apiv1.ListMemoChangesRequest{SyncToken: granted.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, updated.Memos, 1)
+	require.Equal(t, "updated memo", updated.Memos[0].Content)
+
+	// Revoking the grant removes the memo from the view of the grantee.
+	_, err = ts.Service.DeleteMemoGrant(ownerCtx, &apiv1.DeleteMemoGrantRequest{Name: grant.Name})
+	require.NoError(t, err)
+	revoked, err := ts.Service.ListMemoChanges(granteeCtx, &apiv1.ListMemoChangesRequest{SyncToken: updated.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, revoked.Memos)
+	require.Len(t, revoked.Tombstones, 1)
+	require.Equal(t, memo.Name, revoked.Tombstones[0].Name)
+}
+
+func TestListMemoChangesGroups(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	member, err := ts.CreateRegularUser(ctx, "member")
+	require.NoError(t, err)
+	memberCtx := ts.CreateUserContext(ctx, member.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	group, err := ts.Service.CreateGroup(hostCtx, &apiv1.CreateGroupRequest{
+		Group: &apiv1.Group{
+			DisplayName: "engineering",
+			Members:     []string{fmt.Sprintf("users/%d", owner.ID), fmt.Sprintf("users/%d", member.ID)},
+		},
+	})
+	require.NoError(t, err)
+	initial, err := ts.Service.ListMemoChanges(memberCtx, &apiv1.ListMemoChangesRequest{})
+	require.NoError(t, err)
+
+	// Group memos appear for the group members only.
+	memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "group memo",
+			Visibility: apiv1.Visibility_GROUP,
+			Groups:     []string{group.Name},
+		},
+	})
+	require.NoError(t, err)
+	created, err := ts.Service.ListMemoChanges(memberCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Len(t, created.Memos, 1)
+	require.Equal(t, memo.Name, created.Memos[0].Name)
+	otherChanges, err := ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{SyncToken: initial.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, otherChanges.Memos)
+	require.Empty(t, otherChanges.Tombstones)
+
+	// Making the memo private removes it from the view of the group members.
+	_, err = ts.Service.UpdateMemo(ownerCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Visibility: apiv1.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	private, err := ts.Service.ListMemoChanges(memberCtx, &apiv1.ListMemoChangesRequest{SyncToken: created.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, private.Memos)
+	require.Len(t, private.Tombstones, 1)
+	require.Equal(t, memo.Name, private.Tombstones[0].Name)
+	otherChanges, err = ts.Service.ListMemoChanges(otherCtx, &apiv1.ListMemoChangesRequest{SyncToken: created.NextSyncToken})
+	require.NoError(t, err)
+	require.Empty(t, otherChanges.Memos)
+	require.Empty(t, otherChanges.Tombstones)
+}
+
+func TestListMemoChangesPaging(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	initial, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    "memo",
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	names := []string{}
+	syncToken := initial.NextSyncToken
+	for {
+		changes, err := ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: syncToken, PageSize: 2})
+		require.NoError(t, err)
+		for _, memo := range changes.Memos {
+			names = append(names, memo.Name)
+		}
+		syncToken = changes.NextSyncToken
+		if !changes.HasMore {
+			break
+		}
+	}
+	require.Len(t, names, 3)
+
+	_, err = ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: "invalid"})
+	require.Error(t, err)
+
+	// Tokens issued before the changes after them could have been pruned have expired.
+	expiredToken := base64.StdEncoding.EncodeToString([]byte("memo_change:0:1000000000"))
+	_, err = ts.Service.ListMemoChanges(userCtx, &apiv1.ListMemoChangesRequest{SyncToken: expiredToken})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
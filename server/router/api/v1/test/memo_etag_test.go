@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUpdateMemoEtag(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "first version",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, memo.Etag)
+
+	fetched, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, memo.Etag, fetched.Etag)
+
+	// The first device updates the memo with a fresh etag.
+	updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:    memo.Name,
+			Content: "second version",
+			Etag:    memo.Etag,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, updated.Etag)
+
+	// The second device still holds the old etag.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:    memo.Name,
+			Content: "conflicting version",
+			Etag:    memo.Etag,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// Updates without an etag still overwrite the memo.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:   memo.Name,
+			Pinned: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+
+	fetched, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "second version", fetched.Content)
+	require.NotEqual(t, updated.Etag, fetched.Etag)
+}
+
+func TestUpdateMemoIfMatchHeader(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo content",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	// The gateway forwards the If-Match header with a prefix.
+	ifMatchCtx := metadata.NewIncomingContext(userCtx, metadata.Pairs("grpcgateway-if-match", memo.Etag))
+	updated, err := ts.Service.UpdateMemo(ifMatchCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:    memo.Name,
+			Content: "updated content",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.UpdateMemo(ifMatchCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:    memo.Name,
+			Content: "conflicting content",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Weak etags, lists of etags and wildcards are accepted.
+	weakCtx := metadata.NewIncomingContext(userCtx, metadata.Pairs("grpcgateway-if-match", `"stale", W/`+updated.Etag))
+	_, err = ts.Service.SetMemoRelations(weakCtx, &apiv1.SetMemoRelationsRequest{
+		Name:      memo.Name,
+		Relations: []*apiv1.MemoRelation{},
+	})
+	require.NoError(t, err)
+	wildcardCtx := metadata.NewIncomingContext(userCtx, metadata.Pairs("grpcgateway-if-match", "*"))
+	_, err = ts.Service.SetMemoAttachments(wildcardCtx, &apiv1.SetMemoAttachmentsRequest{
+		Name:        memo.Name,
+		Attachments: []*apiv1.Attachment{},
+	})
+	require.NoError(t, err)
+}
+
+func TestSetMemoRelationsEtag(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo content",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	relatedMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "related memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.SetMemoRelations(userCtx, &apiv1.SetMemoRelationsRequest{
+		Name: memo.Name,
+		Relations: []*apiv1.MemoRelation{
+			{
+				RelatedMemo: &apiv1.MemoRelation_Memo{Name: relatedMemo.Name},
+				Type:        apiv1.MemoRelation_REFERENCE,
+			},
+		},
+		Etag: memo.Etag,
+	})
+	require.NoError(t, err)
+
+	// Setting relations modifies the memo, even within the same second.
+	_, err = ts.Service.SetMemoRelations(userCtx, &apiv1.SetMemoRelationsRequest{
+		Name:      memo.Name,
+		Relations: []*apiv1.MemoRelation{},
+		Etag:      memo.Etag,
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	_, err = ts.Service.SetMemoAttachments(userCtx, &apiv1.SetMemoAttachmentsRequest{
+		Name:        memo.Name,
+		Attachments: []*apiv1.Attachment{},
+		Etag:        memo.Etag,
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	fetched, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoAttachments(userCtx, &apiv1.SetMemoAttachmentsRequest{
+		Name:        memo.Name,
+		Attachments: []*apiv1.Attachment{},
+		Etag:        fetched.Etag,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoGrant(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	grantee, err := ts.CreateRegularUser(ctx, "grantee")
+	require.NoError(t, err)
+	granteeCtx := ts.CreateUserContext(ctx, grantee.ID)
+	granteeName := fmt.Sprintf("users/%d", grantee.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "private memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.GetMemo(granteeCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memos, err := ts.Service.ListMemos(granteeCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 0)
+
+	// Only the creator can manage the grants of the memo.
+	_, err = ts.Service.UpsertMemoGrant(granteeCtx, &apiv1.UpsertMemoGrantRequest{
+		Parent:    memo.Name,
+		MemoGrant: &apiv1.MemoGrant{User: granteeName, Role: apiv1.MemoGrant_EDITOR},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpsertMemoGrant(userCtx, &apiv1.UpsertMemoGrantRequest{
+		Parent:    memo.Name,
+		MemoGrant: &apiv1.MemoGrant{User: fmt.Sprintf("users/%d", user.ID), Role: apiv1.MemoGrant_VIEWER},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	grant, err := ts.Service.UpsertMemoGrant(userCtx, &apiv1.UpsertMemoGrantRequest{
+		Parent:    memo.Name,
+		MemoGrant: &apiv1.MemoGrant{User: granteeName, Role: apiv1.MemoGrant_VIEWER},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s/grants/%d", memo.Name, grantee.ID), grant.Name)
+
+	// The grantee is notified about the new access.
+	inboxes, err := ts.Service.ListInboxes(granteeCtx, &apiv1.ListInboxesRequest{Parent: granteeName})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 1)
+	require.Equal(t, apiv1.Inbox_MEMO_GRANT, inboxes.Inboxes[0].Type)
+
+	// Viewers can read the memo, but not comment on or edit it.
+	granted, err := ts.Service.GetMemo(granteeCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "private memo", granted.Content)
+	memos, err = ts.Service.ListMemos(granteeCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	_, err = ts.Service.CreateMemoComment(granteeCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "comment", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpdateMemo(granteeCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "edited"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Editors can edit the content, but not the visibility.
+	_, err = ts.Service.UpsertMemoGrant(userCtx, &apiv1.UpsertMemoGrantRequest{
+		Parent:    memo.Name,
+		MemoGrant: &apiv1.MemoGrant{User: granteeName, Role: apiv1.MemoGrant_EDITOR},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(granteeCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "comment", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	edited, err := ts.Service.UpdateMemo(granteeCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "edited"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "edited", edited.Content)
+	_, err = ts.Service.UpdateMemo(granteeCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Visibility: apiv1.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	grants, err := ts.Service.ListMemoGrants(userCtx, &apiv1.ListMemoGrantsRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, grants.MemoGrants, 1)
+	require.Equal(t, apiv1.MemoGrant_EDITOR, grants.MemoGrants[0].Role)
+
+	// Filters can only ask for the memos granted to the current user.
+	memos, err = ts.Service.ListMemos(granteeCtx, &apiv1.ListMemosRequest{Filter: fmt.Sprintf("granted_to(%d)", grantee.ID)})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: fmt.Sprintf("granted_to(%d)", grantee.ID)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{Filter: fmt.Sprintf("granted_to(%d)", grantee.ID)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.DeleteMemoGrant(userCtx, &apiv1.DeleteMemoGrantRequest{Name: grant.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(granteeCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteMemoGrant(userCtx, &apiv1.DeleteMemoGrantRequest{Name: grant.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
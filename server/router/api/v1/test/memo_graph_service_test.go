@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGetMemoGraph(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	createMemo := func(content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: content, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	// c <- b <- a, with a private memo p referenced by a and an orphan o.
+	c := createMemo("memo c #graph", v1pb.Visibility_PUBLIC)
+	b := createMemo("memo b [["+c.Name+"]] #graph", v1pb.Visibility_PUBLIC)
+	p := createMemo("private memo", v1pb.Visibility_PRIVATE)
+	a := createMemo("memo a [["+b.Name+"]] [["+p.Name+"]] #graph", v1pb.Visibility_PUBLIC)
+	o := createMemo("orphan memo #graph", v1pb.Visibility_PUBLIC)
+	comment, err := ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    c.Name,
+		Comment: &v1pb.Memo{Content: "comment on c", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	graph, err := ts.Service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Name: a.Name})
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 3)
+	require.Equal(t, a.Name, graph.Nodes[0].Name)
+	require.Len(t, graph.Edges, 2)
+
+	// Deeper walks follow comments too.
+	graph, err = ts.Service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Name: a.Name, Depth: 3})
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 5)
+	require.Len(t, graph.Edges, 4)
+	require.Equal(t, comment.Name, graph.Nodes[4].Name)
+	require.Equal(t, int32(3), graph.Nodes[4].Depth)
+	require.Contains(t, graph.Edges, &v1pb.MemoGraph_Edge{Source: comment.Name, Target: c.Name, Type: v1pb.MemoRelation_COMMENT})
+
+	// Other users never walk into memos they can't see.
+	graph, err = ts.Service.GetMemoGraph(otherUserCtx, &v1pb.GetMemoGraphRequest{Name: a.Name, Depth: 3})
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 4)
+	for _, node := range graph.Nodes {
+		require.NotEqual(t, p.Name, node.Name)
+	}
+	_, err = ts.Service.GetMemoGraph(otherUserCtx, &v1pb.GetMemoGraphRequest{Name: p.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The graph is capped at max_nodes.
+	graph, err = ts.Service.GetMemoGraph(userCtx, &v1pb.GetMemoGraphRequest{Name: a.Name, Depth: 3, MaxNodes: 2})
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 2)
+	require.True(t, graph.Truncated)
+
+	// Orphans are flagged when starting from a filter.
+	graph, err = ts.Service.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{Filter: `tag in ["graph"]`})
+	require.NoError(t, err)
+	orphans := []string{}
+	for _, node := range graph.Nodes {
+		if node.Orphan {
+			orphans = append(orphans, node.Name)
+		}
+	}
+	require.Equal(t, []string{o.Name}, orphans)
+}
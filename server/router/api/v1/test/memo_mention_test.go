@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoMentions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	listMentions := func(userCtx context.Context, userID int32) []*v1pb.Inbox {
+		inboxes, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", userID)})
+		require.NoError(t, err)
+		mentions := []*v1pb.Inbox{}
+		for _, inbox := range inboxes.Inboxes {
+			if inbox.Type == v1pb.Inbox_MEMO_MENTION {
+				mentions = append(mentions, inbox)
+			}
+		}
+		return mentions
+	}
+
+	// Email addresses, code, unknown users and the author themselves aren't mentions.
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Hi @alice, mail me at me@bob.com `@bob` @nobody @author",
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	aliceMentions := listMentions(aliceCtx, alice.ID)
+	require.Len(t, aliceMentions, 1)
+	require.Empty(t, listMentions(bobCtx, bob.ID))
+	require.Empty(t, listMentions(authorCtx, author.ID))
+	activity, err := ts.Service.GetActivity(aliceCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", *aliceMentions[0].ActivityId)})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_MENTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoMention().Memo)
+	require.Equal(t, fmt.Sprintf("users/%d", alice.ID), activity.Payload.GetMemoMention().User)
+
+	// Users who can't see the memo aren't notified.
+	_, err = ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Secret plans for @bob", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Empty(t, listMentions(bobCtx, bob.ID))
+
+	// Only users newly mentioned by an update are notified.
+	_, err = ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "Hi @alice and @bob"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(aliceCtx, alice.ID), 1)
+	require.Len(t, listMentions(bobCtx, bob.ID), 1)
+
+	// Mentions in comments notify too, from the comment author.
+	_, err = ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "@alice what do you think?", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	aliceMentions = listMentions(aliceCtx, alice.ID)
+	require.Len(t, aliceMentions, 2)
+	require.Equal(t, fmt.Sprintf("users/%d", bob.ID), aliceMentions[0].Sender)
+
+	// Memos can be filtered by mentions.
+	memos, err := ts.Service.ListMemos(authorCtx, &v1pb.ListMemosRequest{Filter: `mentions.exists(m, m == "bob")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 2)
+	memos, err = ts.Service.ListMemos(aliceCtx, &v1pb.ListMemosRequest{Filter: `mentions.exists(m, m == "bob")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, memo.Name, memos.Memos[0].Name)
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoReactionAndReferenceNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+
+	listInboxes := func(inboxType v1pb.Inbox_Type) []*v1pb.Inbox {
+		inboxes, err := ts.Service.ListInboxes(authorCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", author.ID)})
+		require.NoError(t, err)
+		list := []*v1pb.Inbox{}
+		for _, inbox := range inboxes.Inboxes {
+			if inbox.Type == inboxType {
+				list = append(list, inbox)
+			}
+		}
+		return list
+	}
+	react := func(userCtx context.Context, memo *v1pb.Memo, reactionType string) {
+		_, err := ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: reactionType},
+		})
+		require.NoError(t, err)
+	}
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	// Reacting to your own memo isn't a notification.
+	react(authorCtx, memo, "👍")
+	require.Empty(t, listInboxes(v1pb.Inbox_MEMO_REACTION))
+
+	// Reactions to the same memo are aggregated, the latest sender first.
+	userCtxs := []context.Context{}
+	userNames := []string{}
+	for i := 0; i < 3; i++ {
+		user, err := ts.CreateRegularUser(ctx, fmt.Sprintf("user%d", i))
+		require.NoError(t, err)
+		userCtxs = append(userCtxs, ts.CreateUserContext(ctx, user.ID))
+		userNames = append(userNames, fmt.Sprintf("users/%d", user.ID))
+		react(userCtxs[i], memo, "👍")
+	}
+	react(userCtxs[0], memo, "❤️")
+	reactionInboxes := listInboxes(v1pb.Inbox_MEMO_REACTION)
+	require.Len(t, reactionInboxes, 1)
+	require.Equal(t, userNames[0], reactionInboxes[0].Sender)
+	require.Equal(t, []string{userNames[0], userNames[2], userNames[1]}, reactionInboxes[0].Senders)
+	activity, err := ts.Service.GetActivity(authorCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", *reactionInboxes[0].ActivityId)})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_REACTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoReaction().Memo)
+	require.Equal(t, "❤️", activity.Payload.GetMemoReaction().ReactionType)
+
+	// Once read, new reactions start a new notification.
+	_, err = ts.Service.UpdateInbox(authorCtx, &v1pb.UpdateInboxRequest{
+		Inbox:      &v1pb.Inbox{Name: reactionInboxes[0].Name, Status: v1pb.Inbox_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	})
+	require.NoError(t, err)
+	react(userCtxs[1], memo, "❤️")
+	require.Len(t, listInboxes(v1pb.Inbox_MEMO_REACTION), 2)
+
+	// References from memos the author can't see aren't notifications.
+	relations := []*v1pb.MemoRelation{{RelatedMemo: &v1pb.MemoRelation_Memo{Name: memo.Name}, Type: v1pb.MemoRelation_REFERENCE}}
+	_, err = ts.Service.CreateMemo(userCtxs[0], &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Private notes", Visibility: v1pb.Visibility_PRIVATE, Relations: relations},
+	})
+	require.NoError(t, err)
+	require.Empty(t, listInboxes(v1pb.Inbox_MEMO_REFERENCE))
+	reference, err := ts.Service.CreateMemo(userCtxs[0], &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "See this", Visibility: v1pb.Visibility_PROTECTED, Relations: relations},
+	})
+	require.NoError(t, err)
+	referenceInboxes := listInboxes(v1pb.Inbox_MEMO_REFERENCE)
+	require.Len(t, referenceInboxes, 1)
+	activity, err = ts.Service.GetActivity(authorCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", *referenceInboxes[0].ActivityId)})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_REFERENCE, activity.Type)
+	require.Equal(t, reference.Name, activity.Payload.GetMemoReference().Memo)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoReference().RelatedMemo)
+
+	// Setting the same references again doesn't notify twice.
+	_, err = ts.Service.SetMemoRelations(userCtxs[0], &v1pb.SetMemoRelationsRequest{Name: reference.Name, Relations: relations})
+	require.NoError(t, err)
+	require.Len(t, listInboxes(v1pb.Inbox_MEMO_REFERENCE), 1)
+
+	// Muting a type is a preference without channels.
+	settingName := fmt.Sprintf("users/%d/settings/NOTIFICATIONS", author.ID)
+	setting, err := ts.Service.UpdateUserSetting(authorCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: settingName,
+			Value: &v1pb.UserSetting_NotificationsSetting_{
+				NotificationsSetting: &v1pb.UserSetting_NotificationsSetting{
+					Preferences: []*v1pb.UserSetting_NotificationsSetting_Preference{{Type: v1pb.Inbox_MEMO_REACTION}},
+				},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, setting.GetNotificationsSetting().Preferences, 1)
+	require.Empty(t, setting.GetNotificationsSetting().Preferences[0].Channels)
+
+	// Muted notifications aren't sent.
+	react(userCtxs[2], memo, "🎉")
+	reactionInboxes = listInboxes(v1pb.Inbox_MEMO_REACTION)
+	require.Len(t, reactionInboxes, 2)
+	for _, inbox := range reactionInboxes {
+		if inbox.Status == v1pb.Inbox_UNREAD {
+			require.Equal(t, []string{userNames[1]}, inbox.Senders)
+		}
+	}
+}
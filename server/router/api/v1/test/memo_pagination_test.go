@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemosCursorPagination(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for i := 0; i < 5; i++ {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    fmt.Sprintf("memo %d", i),
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	firstPage, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, firstPage.Memos, 2)
+	require.NotEmpty(t, firstPage.NextPageToken)
+
+	// Memos created while paging don't shift the following pages.
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo created while paging",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, memo := range firstPage.Memos {
+		names = append(names, memo.Name)
+	}
+	pageToken := firstPage.NextPageToken
+	for pageToken != "" {
+		page, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: pageToken})
+		require.NoError(t, err)
+		for _, memo := range page.Memos {
+			require.NotContains(t, names, memo.Name)
+			require.NotEqual(t, "memo created while paging", memo.Content)
+			names = append(names, memo.Name)
+		}
+		pageToken = page.NextPageToken
+	}
+	require.Len(t, names, 5)
+}
+
+func TestListMemoCommentsPagination(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo with comments",
+			Visibility: apiv1.Visibility_PUBLIC,
+		},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := ts.Service.CreateMemoComment(userCtx, &apiv1.CreateMemoCommentRequest{
+			Name: memo.Name,
+			Comment: &apiv1.Memo{
+				Content:    fmt.Sprintf("comment %d", i),
+				Visibility: apiv1.Visibility_PUBLIC,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Without a page size all comments are returned.
+	response, err := ts.Service.ListMemoComments(userCtx, &apiv1.ListMemoCommentsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 3)
+	require.Empty(t, response.NextPageToken)
+
+	response, err = ts.Service.ListMemoComments(userCtx, &apiv1.ListMemoCommentsRequest{Name: memo.Name, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.ListMemoComments(userCtx, &apiv1.ListMemoCommentsRequest{Name: memo.Name, PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+	require.Empty(t, response.NextPageToken)
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoReferenceSync(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	target, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "target memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	other, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "other memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	// Wiki-links and links to memos in the content become references.
+	source, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "first line\nsee [[" + target.Name + "]] for details\n[other](https://memos.example.com/" + other.Name + ")",
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	resp, err := ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      source.Name,
+		Direction: v1pb.ListMemoRelationsRequest_OUTGOING,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Relations, 2)
+
+	// The target lists the source as a backlink with the line referencing it.
+	resp, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      target.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Relations, 1)
+	require.Equal(t, source.Name, resp.Relations[0].Memo.Name)
+	require.Equal(t, v1pb.MemoRelation_REFERENCE, resp.Relations[0].Type)
+	require.Equal(t, "see [["+target.Name+"]] for details", resp.Relations[0].ContextSnippet)
+	resp, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      target.Name,
+		Direction: v1pb.ListMemoRelationsRequest_OUTGOING,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Relations, 0)
+
+	// Relations set explicitly are kept when references are removed from the content.
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name: other.Name,
+		Relations: []*v1pb.MemoRelation{
+			{RelatedMemo: &v1pb.MemoRelation_Memo{Name: target.Name}, Type: v1pb.MemoRelation_REFERENCE},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: source.Name, Content: "no more references"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	resp, err = ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      target.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Relations, 1)
+	require.Equal(t, other.Name, resp.Relations[0].Memo.Name)
+	require.Empty(t, resp.Relations[0].ContextSnippet)
+}
+
+func TestMemoRelationCustomTypes(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	updateRelationTypes := func(relationTypes ...*v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/MEMO_RELATED",
+				Value: &v1pb.WorkspaceSetting_MemoRelatedSetting_{
+					MemoRelatedSetting: &v1pb.WorkspaceSetting_MemoRelatedSetting{
+						ContentLengthLimit: 8192,
+						RelationTypes:      relationTypes,
+					},
+				},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateRelationTypes(&v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{Name: "Blocks", InverseLabel: "blocked by"})))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateRelationTypes(&v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{Name: "blocks"})))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateRelationTypes(
+		&v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{Name: "blocks", InverseLabel: "blocked by"},
+		&v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{Name: "blocks", InverseLabel: "blocked by"},
+	)))
+	require.NoError(t, updateRelationTypes(&v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{Name: "blocks", InverseLabel: "blocked by"}))
+
+	blocker, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "blocker memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	blocked, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "blocked memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name: blocker.Name,
+		Relations: []*v1pb.MemoRelation{
+			{RelatedMemo: &v1pb.MemoRelation_Memo{Name: blocked.Name}, Type: v1pb.MemoRelation_CUSTOM, CustomType: "duplicates"},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name: blocker.Name,
+		Relations: []*v1pb.MemoRelation{
+			{RelatedMemo: &v1pb.MemoRelation_Memo{Name: blocked.Name}, Type: v1pb.MemoRelation_CUSTOM, CustomType: "blocks"},
+		},
+	})
+	require.NoError(t, err)
+
+	// The related memo sees the relation with its inverse label.
+	resp, err := ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{
+		Name:      blocked.Name,
+		Direction: v1pb.ListMemoRelationsRequest_INCOMING,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Relations, 1)
+	require.Equal(t, blocker.Name, resp.Relations[0].Memo.Name)
+	require.Equal(t, v1pb.MemoRelation_CUSTOM, resp.Relations[0].Type)
+	require.Equal(t, "blocks", resp.Relations[0].CustomType)
+	require.Equal(t, "blocked by", resp.Relations[0].InverseLabel)
+
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `has_relation("blocks")`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, blocker.Name, memos.Memos[0].Name)
+
+	// Setting relations again replaces the custom ones.
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{Name: blocker.Name})
+	require.NoError(t, err)
+	memos, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `has_relation("blocks")`})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/reminder"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoReminders(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	past := time.Now().Add(-time.Hour).In(time.Local)
+	future := time.Now().Add(48 * time.Hour).In(time.Local)
+	content := fmt.Sprintf("Call mom @remind(%s)\n- [ ] pay rent 📅 %s\n- [x] file taxes 📅 %s\n- [ ] renew passport 📅 %s\n```\n@remind(%s)\n```",
+		past.Format("2006-01-02 15:04"), past.Format("2006-01-02 15:04"), past.Format("2006-01-02"), future.Format("2006-01-02"), past.Format("2006-01-02 15:04"))
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// Completed tasks and code blocks don't remind, date-only reminders are due in the morning.
+	memoUID := memo.Name[len("memos/"):]
+	stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	require.Len(t, stored.Payload.Reminders, 3)
+	require.Equal(t, "Call mom", stored.Payload.Reminders[0].Content)
+	require.Equal(t, past.Truncate(time.Minute).Unix(), stored.Payload.Reminders[0].DueTs)
+	require.Equal(t, "pay rent", stored.Payload.Reminders[1].Content)
+	require.Equal(t, "renew passport", stored.Payload.Reminders[2].Content)
+	dueTime := time.Unix(stored.Payload.Reminders[2].DueTs, 0)
+	require.Equal(t, 9, dueTime.Hour())
+	require.Equal(t, 0, dueTime.Minute())
+
+	// Due reminders are sent once.
+	runner := reminder.NewRunner(ts.Store, ts.Service.NotificationDispatcher)
+	runner.RunOnce(ctx)
+	runner.RunOnce(ctx)
+	inboxes, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 2)
+	for _, inbox := range inboxes.Inboxes {
+		require.Equal(t, v1pb.Inbox_REMINDER, inbox.Type)
+		require.Equal(t, v1pb.Inbox_UNREAD, inbox.Status)
+	}
+	activity, err := ts.Service.GetActivity(userCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", *inboxes.Inboxes[0].ActivityId)})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_REMINDER, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoReminder().Memo)
+
+	// Editing the memo keeps track of the reminders already sent.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: content + "\nmore notes"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	inboxes, err = ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 2)
+
+	// Only reminders can be snoozed, and only into the future.
+	_, err = ts.Service.UpdateInbox(userCtx, &v1pb.UpdateInboxRequest{
+		Inbox:      &v1pb.Inbox{Name: inboxes.Inboxes[0].Name, SnoozeTime: timestamppb.New(time.Now().Add(-time.Minute))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"snooze_time"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Snoozing archives the notification until the reminder comes back.
+	snoozed, err := ts.Service.UpdateInbox(userCtx, &v1pb.UpdateInboxRequest{
+		Inbox:      &v1pb.Inbox{Name: inboxes.Inboxes[0].Name, SnoozeTime: timestamppb.New(time.Now().Add(time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"snooze_time"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Inbox_ARCHIVED, snoozed.Status)
+	runner.RunOnce(ctx)
+	inboxes, err = ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 2)
+
+	// Once the snooze time has passed the reminder is sent again.
+	stored, err = ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	snoozedCount := 0
+	for _, reminder := range stored.Payload.Reminders {
+		if reminder.SnoozeTs > 0 {
+			require.False(t, reminder.Notified)
+			reminder.SnoozeTs = time.Now().Add(-time.Minute).Unix()
+			snoozedCount++
+		}
+	}
+	require.Equal(t, 1, snoozedCount)
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, Payload: stored.Payload}))
+	runner.RunOnce(ctx)
+	inboxes, err = ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 3)
+
+	// Dismissing a reminder archives it for good.
+	dismissed, err := ts.Service.UpdateInbox(userCtx, &v1pb.UpdateInboxRequest{
+		Inbox:      &v1pb.Inbox{Name: inboxes.Inboxes[0].Name, Status: v1pb.Inbox_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Inbox_ARCHIVED, dismissed.Status)
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "line one\nline two",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	// Updating a field other than content or visibility doesn't record a revision.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	response, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, response.Revisions, 0)
+
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "line one\nline three", Visibility: apiv1.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility"}},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, response.Revisions, 1)
+	require.Equal(t, "line one\nline two", response.Revisions[0].Content)
+	require.Equal(t, apiv1.Visibility_PRIVATE, response.Revisions[0].Visibility)
+
+	// Other users can't see the revision history.
+	_, err = ts.Service.ListMemoRevisions(otherUserCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+	require.Error(t, err)
+
+	revision, err := ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: response.Revisions[0].Name})
+	require.NoError(t, err)
+	require.Equal(t, []*apiv1.MemoRevision_DiffLine{
+		{Operation: apiv1.MemoRevision_DiffLine_EQUAL, Text: "line one"},
+		{Operation: apiv1.MemoRevision_DiffLine_DELETE, Text: "line two"},
+		{Operation: apiv1.MemoRevision_DiffLine_INSERT, Text: "line three"},
+	}, revision.Diff)
+
+	restored, err := ts.Service.RestoreMemoRevision(userCtx, &apiv1.RestoreMemoRevisionRequest{Name: revision.Name})
+	require.NoError(t, err)
+	require.Equal(t, "line one\nline two", restored.Content)
+	require.Equal(t, apiv1.Visibility_PRIVATE, restored.Visibility)
+
+	// The restore itself is recorded as a revision.
+	response, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, response.Revisions, 2)
+	require.Equal(t, "line one\nline three", response.Revisions[0].Content)
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/publish"
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledMemoPublishing(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Memos can only be scheduled in the future and while they aren't public.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "past", Visibility: v1pb.Visibility_PRIVATE, PublishTime: timestamppb.New(time.Now().Add(-time.Hour))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "public", Visibility: v1pb.Visibility_PUBLIC, PublishTime: timestamppb.New(time.Now().Add(time.Hour))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	later, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "later", Visibility: v1pb.Visibility_PRIVATE, PublishTime: timestamppb.New(time.Now().Add(2 * time.Hour))},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, later.PublishTime)
+	sooner, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "sooner", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	sooner, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: sooner.Name, PublishTime: timestamppb.New(time.Now().Add(time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, sooner.PublishTime)
+
+	// The scheduled queue is ordered by publish time.
+	scheduled, err := ts.Service.ListScheduledMemos(userCtx, &v1pb.ListScheduledMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, scheduled.Memos, 2)
+	require.Equal(t, sooner.Name, scheduled.Memos[0].Name)
+	require.Equal(t, later.Name, scheduled.Memos[1].Name)
+
+	// Nothing is due yet.
+	runner := publish.NewRunner(ts.Store, ts.Service)
+	runner.RunOnce(ctx)
+	memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: sooner.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+
+	// Once the publish time has passed the runner makes the memo public and drops the schedule.
+	memoUID := sooner.Name[len("memos/"):]
+	stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	publishTs := time.Now().Add(-time.Minute).Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, PublishTs: &publishTs}))
+	runner.RunOnce(ctx)
+	memo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: sooner.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+	require.Nil(t, memo.PublishTime)
+	revisions, err := ts.Service.ListMemoRevisions(userCtx, &v1pb.ListMemoRevisionsRequest{Parent: sooner.Name})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 1)
+	require.Equal(t, v1pb.Visibility_PROTECTED, revisions.Revisions[0].Visibility)
+
+	// Making a scheduled memo public by hand drops its schedule too.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: later.Name, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Nil(t, memo.PublishTime)
+	scheduled, err = ts.Service.ListScheduledMemos(userCtx, &v1pb.ListScheduledMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, scheduled.Memos)
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemosWithSearch(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	contents := []string{
+		"Grocery list: apples and bananas",
+		"Weekly review: apples in the garden, apples in the kitchen, apples everywhere",
+		"Nothing relevant here",
+	}
+	memos := []*apiv1.Memo{}
+	for _, content := range contents {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+
+	response, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter:  `search("apples")`,
+		OrderBy: "relevance",
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	// The memo mentioning the term more often ranks first.
+	require.Equal(t, memos[1].Name, response.Memos[0].Name)
+	require.Equal(t, memos[0].Name, response.Memos[1].Name)
+	require.Equal(t, "Grocery list: <mark>apples</mark> and bananas", response.Memos[1].Highlight)
+
+	// The index follows content updates.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo: &apiv1.Memo{
+			Name:    memos[2].Name,
+			Content: "Apples are relevant now",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter: `search("apples") && !search("bananas")`,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	highlights := map[string]string{}
+	for _, memo := range response.Memos {
+		highlights[memo.Name] = memo.Highlight
+	}
+	require.Equal(t, "<mark>Apples</mark> are relevant now", highlights[memos[2].Name])
+
+	// The highlight is HTML escaped, including the HTML kept in code.
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "Pears & `<img src=x onerror=alert(1)>`",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter: `search("pears")`,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+	require.Equal(t, "<mark>Pears</mark> &amp; &lt;img src=x onerror=alert(1)&gt;", response.Memos[0].Highlight)
+
+	// Memos without a search filter have no highlight.
+	response, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 4)
+	require.Empty(t, response.Memos[0].Highlight)
+
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		OrderBy: "relevance asc",
+	})
+	require.Error(t, err)
+}
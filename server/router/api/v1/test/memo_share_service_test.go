@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShare(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &apiv1.CreateAttachmentRequest{
+		Attachment: &apiv1.Attachment{
+			Filename: "hello.txt",
+			Size:     5,
+			Type:     "text/plain",
+			Content:  []byte("hello"),
+		},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "private memo",
+			Visibility:  apiv1.Visibility_PRIVATE,
+			Attachments: []*apiv1.Attachment{attachment},
+		},
+	})
+	require.NoError(t, err)
+
+	// Only the creator can share the memo.
+	_, err = ts.Service.CreateMemoShare(otherUserCtx, &apiv1.CreateMemoShareRequest{Parent: memo.Name, MemoShare: &apiv1.MemoShare{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	share, err := ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{Parent: memo.Name, MemoShare: &apiv1.MemoShare{}})
+	require.NoError(t, err)
+	require.Len(t, share.Token, 32)
+	require.Nil(t, share.ExpireTime)
+
+	// Anonymous users can read the memo and its attachments with the token.
+	_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: memo.Name, ShareToken: "wrong-token"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	shared, err := ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: memo.Name, ShareToken: share.Token})
+	require.NoError(t, err)
+	require.Equal(t, "private memo", shared.Content)
+	_, err = ts.Service.GetAttachmentBinary(ctx, &apiv1.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "hello.txt"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	binary, err := ts.Service.GetAttachmentBinary(ctx, &apiv1.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "hello.txt", ShareToken: share.Token})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), binary.Data)
+
+	// Tokens only work for the memo they were created for.
+	otherMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "another private memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: otherMemo.Name, ShareToken: share.Token})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	expiringShare, err := ts.Service.CreateMemoShare(userCtx, &apiv1.CreateMemoShareRequest{
+		Parent:    memo.Name,
+		MemoShare: &apiv1.MemoShare{ExpireTime: timestamppb.New(time.Now().Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, expiringShare.ExpireTime)
+
+	resp, err := ts.Service.ListMemoShares(userCtx, &apiv1.ListMemoSharesRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, resp.MemoShares, 2)
+
+	_, err = ts.Service.RevokeMemoShare(userCtx, &apiv1.RevokeMemoShareRequest{Name: share.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: memo.Name, ShareToken: share.Token})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RevokeMemoShare(userCtx, &apiv1.RevokeMemoShareRequest{Name: share.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMemoShareExpired(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "private memo",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	memoUID := strings.TrimPrefix(memo.Name, "memos/")
+	storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+
+	expiresTs := time.Now().Add(-time.Minute).Unix()
+	_, err = ts.Store.CreateMemoShare(ctx, &store.MemoShare{
+		UID:       "expired-share",
+		Token:     "expired-token",
+		MemoID:    storeMemo.ID,
+		CreatorID: user.ID,
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: memo.Name, ShareToken: "expired-token"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListTags(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, content := range []string{
+		"#work/projectA/meeting notes",
+		"#work/projectA #work/projectB",
+		"#home",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := ts.Service.ListTags(userCtx, &apiv1.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 2)
+	require.Equal(t, "home", resp.Tags[0].Name)
+	require.Equal(t, int32(1), resp.Tags[0].MemoCount)
+
+	work := resp.Tags[1]
+	require.Equal(t, "work", work.Tag)
+	// Memos with several child tags are counted once.
+	require.Equal(t, int32(2), work.MemoCount)
+	require.Len(t, work.Children, 2)
+	projectA := work.Children[0]
+	require.Equal(t, "work/projectA", projectA.Tag)
+	require.Equal(t, int32(2), projectA.MemoCount)
+	require.Len(t, projectA.Children, 1)
+	require.Equal(t, "meeting", projectA.Children[0].Name)
+	require.Equal(t, "work/projectA/meeting", projectA.Children[0].Tag)
+	require.Equal(t, int32(1), projectA.Children[0].MemoCount)
+	require.Equal(t, "work/projectB", work.Children[1].Tag)
+
+	// Private memos are not visible to anonymous users.
+	resp, err = ts.Service.ListTags(ctx, &apiv1.ListTagsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Empty(t, resp.Tags)
+
+	memos, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: `tag in_tree "work/projectA"`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 2)
+}
+
+func TestRenameMemoTagCascade(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "#work #work/meeting #workshop",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.RenameMemoTag(userCtx, &apiv1.RenameMemoTagRequest{
+		Parent: "memos/-",
+		OldTag: "work",
+		NewTag: "job",
+	})
+	require.NoError(t, err)
+
+	memo, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	// Tags that only share a prefix with the renamed tag are left alone.
+	require.Equal(t, "#job #job/meeting #workshop", memo.Content)
+	require.Equal(t, []string{"job", "job/meeting", "workshop"}, memo.Tags)
+}
+
+func TestDeleteMemoTagCascade(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "notes #work/meeting #home",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.DeleteMemoTag(userCtx, &apiv1.DeleteMemoTagRequest{
+		Parent: "memos/-",
+		Tag:    "work",
+	})
+	require.NoError(t, err)
+
+	memo, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{"home"}, memo.Tags)
+}
+
+func TestDeleteMemoTagMatchesExactly(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memos := []*apiv1.Memo{}
+	for _, content := range []string{"#to_do", "#to-do", "#TO_DO", "#to_do/later"} {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+
+	response, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: `tag in_tree "to_do"`})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+
+	// Underscores and case don't match other tags.
+	_, err = ts.Service.DeleteMemoTag(userCtx, &apiv1.DeleteMemoTagRequest{
+		Parent:             "memos/-",
+		Tag:                "to_do",
+		DeleteRelatedMemos: true,
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	names := []string{}
+	for _, memo := range response.Memos {
+		names = append(names, memo.Name)
+	}
+	require.ElementsMatch(t, []string{memos[1].Name, memos[2].Name}, names)
+}
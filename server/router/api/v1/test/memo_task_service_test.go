@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListTasks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	content := "# Groceries\n" +
+		"- [ ] milk due:2025-03-01\n" +
+		"- [x] bread\n" +
+		"  - [ ] **whole grain**\n" +
+		"```\n" +
+		"- [ ] not a task\n" +
+		"```\n" +
+		"* [ ] eggs 📅 2025-03-02"
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    content,
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "no tasks here",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.ListTasks(userCtx, &apiv1.ListTasksRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 4)
+	require.Equal(t, fmt.Sprintf("%s/tasks/1", memo.Name), resp.Tasks[0].Name)
+	require.Equal(t, memo.Name, resp.Tasks[0].Memo)
+	require.Equal(t, "milk due:2025-03-01", resp.Tasks[0].Text)
+	require.False(t, resp.Tasks[0].Completed)
+	require.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), resp.Tasks[0].DueTime.AsTime())
+	require.Equal(t, int32(2), resp.Tasks[1].LineIndex)
+	require.True(t, resp.Tasks[1].Completed)
+	require.Equal(t, int32(3), resp.Tasks[2].LineIndex)
+	require.Equal(t, "whole grain", resp.Tasks[2].Text)
+	require.Nil(t, resp.Tasks[2].DueTime)
+	// Lines inside the code block are not tasks.
+	require.Equal(t, int32(7), resp.Tasks[3].LineIndex)
+	require.Equal(t, time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), resp.Tasks[3].DueTime.AsTime())
+
+	completed := false
+	resp, err = ts.Service.ListTasks(userCtx, &apiv1.ListTasksRequest{Completed: &completed})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 3)
+
+	// Memos without matching tasks don't count towards the page size.
+	for _, content := range []string{"- [x] done", "- [ ] newest"} {
+		_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+	resp, err = ts.Service.ListTasks(userCtx, &apiv1.ListTasksRequest{PageSize: 1, Completed: &completed})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 1)
+	require.Equal(t, "newest", resp.Tasks[0].Text)
+	require.NotEmpty(t, resp.NextPageToken)
+	resp, err = ts.Service.ListTasks(userCtx, &apiv1.ListTasksRequest{PageToken: resp.NextPageToken, Completed: &completed})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 3)
+	require.Equal(t, memo.Name, resp.Tasks[0].Memo)
+	require.Empty(t, resp.NextPageToken)
+
+	// Private memos are not visible to other users.
+	resp, err = ts.Service.ListTasks(otherUserCtx, &apiv1.ListTasksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Tasks)
+}
+
+func TestToggleTask(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "Todo\n- [ ] first\n- [x] second",
+			Visibility: apiv1.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+
+	task, err := ts.Service.ToggleTask(userCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/1", Etag: memo.Etag})
+	require.NoError(t, err)
+	require.True(t, task.Completed)
+	require.Equal(t, "first", task.Text)
+	task, err = ts.Service.ToggleTask(userCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/2"})
+	require.NoError(t, err)
+	require.False(t, task.Completed)
+
+	updated, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Todo\n- [x] first\n- [ ] second", updated.Content)
+	require.True(t, updated.Property.HasIncompleteTasks)
+
+	// The memo has changed since the etag was read.
+	_, err = ts.Service.ToggleTask(userCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/1", Etag: memo.Etag})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	// The first line is not a task.
+	_, err = ts.Service.ToggleTask(userCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/0"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// Only the creator can toggle tasks, and access is checked before the task is looked up.
+	_, err = ts.Service.ToggleTask(otherUserCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.ToggleTask(otherUserCtx, &apiv1.ToggleTaskRequest{Name: memo.Name + "/tasks/0"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Private memos of other users look the same as memos that don't exist.
+	privateMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "- [X] done",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.ToggleTask(otherUserCtx, &apiv1.ToggleTaskRequest{Name: privateMemo.Name + "/tasks/0"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.ToggleTask(otherUserCtx, &apiv1.ToggleTaskRequest{Name: privateMemo.Name + "/tasks/1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.ToggleTask(otherUserCtx, &apiv1.ToggleTaskRequest{Name: "memos/missing/tasks/0"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Uppercase marks are checked boxes too.
+	task, err = ts.Service.ToggleTask(userCtx, &apiv1.ToggleTaskRequest{Name: privateMemo.Name + "/tasks/0"})
+	require.NoError(t, err)
+	require.False(t, task.Completed)
+	updated, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: privateMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] done", updated.Content)
+}
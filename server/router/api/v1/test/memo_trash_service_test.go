@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTrash(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "memo to trash",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	// Deleting a memo moves it to the trash.
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+	listResponse, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Memos, 0)
+
+	trashResponse, err := ts.Service.ListTrashedMemos(userCtx, &apiv1.ListTrashedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, trashResponse.Memos, 1)
+	require.Equal(t, memo.Name, trashResponse.Memos[0].Name)
+	require.NotNil(t, trashResponse.Memos[0].TrashTime)
+
+	// Other users can't restore the memo.
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	_, err = ts.Service.RestoreMemo(ts.CreateUserContext(ctx, otherUser.ID), &apiv1.RestoreMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+
+	restored, err := ts.Service.RestoreMemo(userCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "memo to trash", restored.Content)
+	require.Nil(t, restored.TrashTime)
+
+	// Admins can restore private memos of other users, which they can't view.
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	restored, err = ts.Service.RestoreMemo(ts.CreateUserContext(ctx, hostUser.ID), &apiv1.RestoreMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "memo to trash", restored.Content)
+	require.Nil(t, restored.TrashTime)
+
+	// Emptying the trash permanently deletes the memo.
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.EmptyTrash(userCtx, &apiv1.EmptyTrashRequest{})
+	require.NoError(t, err)
+	trashResponse, err = ts.Service.ListTrashedMemos(userCtx, &apiv1.ListTrashedMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, trashResponse.Memos, 0)
+	_, err = ts.Service.RestoreMemo(userCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+}
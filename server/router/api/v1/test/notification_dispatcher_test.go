@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+)
+
+func TestNotificationDispatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+
+	// The push endpoint fails the first time, the delivery is retried.
+	var mu sync.Mutex
+	requests := 0
+	payloads := []*notification.PushPayload{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		payload := &notification.PushPayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	// The default push sender refuses to connect to internal addresses like the one of the test server.
+	err = notification.NewPushSender().Send(ctx, &notification.Message{
+		Setting: &storepb.NotificationsUserSetting{PushUrl: server.URL},
+		Sender:  author,
+	})
+	require.ErrorContains(t, err, "is not allowed")
+	err = notification.NewPushSender().Send(ctx, &notification.Message{
+		Setting: &storepb.NotificationsUserSetting{PushUrl: "file:///etc/passwd"},
+		Sender:  author,
+	})
+	require.ErrorContains(t, err, "invalid push endpoint")
+	require.Equal(t, 0, requests)
+	ts.Service.NotificationDispatcher.RegisterSender(storepb.NotificationsUserSetting_PUSH, &notification.PushSender{Client: server.Client()})
+	ts.Service.NotificationDispatcher.RetryInterval = 10 * time.Millisecond
+	go ts.Service.NotificationDispatcher.Run(ctx)
+
+	updateSetting := func(notificationsSetting *v1pb.UserSetting_NotificationsSetting) error {
+		_, err := ts.Service.UpdateUserSetting(authorCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/NOTIFICATIONS", author.ID),
+				Value: &v1pb.UserSetting_NotificationsSetting_{NotificationsSetting: notificationsSetting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences", "pushEndpoint"}},
+		})
+		return err
+	}
+	pushComments := []*v1pb.UserSetting_NotificationsSetting_Preference{{
+		Type:     v1pb.Inbox_MEMO_COMMENT,
+		Channels: []v1pb.UserSetting_NotificationsSetting_Channel{v1pb.UserSetting_NotificationsSetting_PUSH},
+	}}
+
+	// Only some types can be configured, and pushing needs a valid endpoint.
+	err = updateSetting(&v1pb.UserSetting_NotificationsSetting{
+		Preferences: []*v1pb.UserSetting_NotificationsSetting_Preference{{Type: v1pb.Inbox_VERSION_UPDATE}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = updateSetting(&v1pb.UserSetting_NotificationsSetting{Preferences: pushComments})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = updateSetting(&v1pb.UserSetting_NotificationsSetting{Preferences: pushComments, PushEndpoint: "ftp://example.com"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, updateSetting(&v1pb.UserSetting_NotificationsSetting{Preferences: pushComments, PushEndpoint: server.URL}))
+
+	// Comments are pushed instead of delivered to the inbox.
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "Nice memo", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(payloads) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	require.Equal(t, 2, requests)
+	require.Equal(t, "MEMO_COMMENT", payloads[0].Type)
+	require.Equal(t, fmt.Sprintf("users/%d", commenter.ID), payloads[0].Sender)
+	require.Equal(t, "commenter commented on your memo", payloads[0].Title)
+	require.Equal(t, "Nice memo", payloads[0].Content)
+	require.Equal(t, comment.Name, payloads[0].Memo)
+	mu.Unlock()
+	inboxes, err := ts.Service.ListInboxes(authorCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", author.ID)})
+	require.NoError(t, err)
+	require.Empty(t, inboxes.Inboxes)
+
+	// Types without a preference go to the inbox only.
+	_, err = ts.Service.UpsertMemoReaction(commenterCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+	inboxes, err = ts.Service.ListInboxes(authorCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", author.ID)})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 1)
+	require.Equal(t, v1pb.Inbox_MEMO_REACTION, inboxes.Inboxes[0].Type)
+	mu.Lock()
+	require.Len(t, payloads, 1)
+	mu.Unlock()
+}
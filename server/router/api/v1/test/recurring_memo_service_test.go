@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/recurring"
+	"github.com/usememos/memos/store"
+)
+
+func TestRecurringMemoCRUD(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	template, err := ts.Service.CreateTemplate(userCtx, &v1pb.CreateTemplateRequest{
+		Parent:   fmt.Sprintf("users/%d", user.ID),
+		Template: &v1pb.Template{Title: "Stand-up", Content: "# Stand-up {{date}} {{team}}"},
+	})
+	require.NoError(t, err)
+
+	for _, invalid := range []*v1pb.RecurringMemo{
+		{Schedule: "every day", Content: "daily"},
+		{Schedule: "0 9 * * 1-5"},
+		{Schedule: "0 9 * * 1-5", Content: "daily", Template: template.Name},
+		// Nobody is there to prompt for variables when the memo runs.
+		{Schedule: "0 9 * * 1-5", Template: template.Name},
+	} {
+		_, err = ts.Service.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{RecurringMemo: invalid})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	// Templates of other users can't be used.
+	_, err = ts.Service.CreateRecurringMemo(otherUserCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{Schedule: "0 9 * * 1-5", Template: template.Name, Variables: map[string]string{"team": "web"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	recurringMemo, err := ts.Service.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{
+			Schedule:   "0 9 * * 1-5",
+			Template:   template.Name,
+			Variables:  map[string]string{"team": "platform"},
+			Visibility: v1pb.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.State_NORMAL, recurringMemo.State)
+	require.NotNil(t, recurringMemo.NextRunTime)
+	require.Equal(t, 9, recurringMemo.NextRunTime.AsTime().In(time.Local).Hour())
+	require.Nil(t, recurringMemo.LastRunTime)
+
+	_, err = ts.Service.GetRecurringMemo(otherUserCtx, &v1pb.GetRecurringMemoRequest{Name: recurringMemo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Paused recurring memos have no next run.
+	recurringMemo, err = ts.Service.UpdateRecurringMemo(userCtx, &v1pb.UpdateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{Name: recurringMemo.Name, State: v1pb.State_ARCHIVED},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.State_ARCHIVED, recurringMemo.State)
+	require.Nil(t, recurringMemo.NextRunTime)
+
+	resp, err := ts.Service.ListRecurringMemos(userCtx, &v1pb.ListRecurringMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.RecurringMemos, 1)
+	resp, err = ts.Service.ListRecurringMemos(otherUserCtx, &v1pb.ListRecurringMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.RecurringMemos)
+
+	_, err = ts.Service.DeleteRecurringMemo(userCtx, &v1pb.DeleteRecurringMemoRequest{Name: recurringMemo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetRecurringMemo(userCtx, &v1pb.GetRecurringMemoRequest{Name: recurringMemo.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRecurringMemoRunner(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	recurringMemo, err := ts.Service.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{
+			Schedule:   "CRON_TZ=UTC 0 * * * *",
+			Content:    "# Check-in {{date}} {{time}} by {{user}}",
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	runner := recurring.NewRunner(ts.Store, ts.Service)
+
+	// Nothing is due right after creation.
+	runner.RunOnce(ctx)
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+
+	// Simulate the server being down for the last three hourly runs.
+	uid := strings.TrimPrefix(recurringMemo.Name, "recurringMemos/")
+	stored, err := ts.Store.GetRecurringMemo(ctx, &store.FindRecurringMemo{UID: &uid})
+	require.NoError(t, err)
+	lastHour := time.Now().UTC().Truncate(time.Hour)
+	updatedTs := lastHour.Add(-3 * time.Hour).Add(time.Minute).Unix()
+	_, err = ts.Store.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{ID: stored.ID, UpdatedTs: &updatedTs})
+	require.NoError(t, err)
+
+	// Only the latest missed run creates a memo, the ones before it are counted as missed.
+	runner.RunOnce(ctx)
+	runner.RunOnce(ctx)
+	memos, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, "# Check-in "+lastHour.Format("2006-01-02 15:04")+" by test-user", memos.Memos[0].Content)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memos.Memos[0].Visibility)
+
+	recurringMemo, err = ts.Service.GetRecurringMemo(userCtx, &v1pb.GetRecurringMemoRequest{Name: recurringMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), recurringMemo.MissedRuns)
+	require.Equal(t, lastHour.Unix(), recurringMemo.LastRunTime.AsTime().Unix())
+	require.Equal(t, lastHour.Add(time.Hour).Unix(), recurringMemo.NextRunTime.AsTime().Unix())
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestTemplateCRUD(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateTemplate(userCtx, &v1pb.CreateTemplateRequest{
+		Parent:   parent,
+		Template: &v1pb.Template{Title: "Empty"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	template, err := ts.Service.CreateTemplate(userCtx, &v1pb.CreateTemplateRequest{
+		Parent: parent,
+		Template: &v1pb.Template{
+			Title:   "Stand-up",
+			Content: "# Stand-up {{date}}\n{{yesterday}}\n{{today}}",
+		},
+	})
+	require.NoError(t, err)
+	require.Regexp(t, "^"+parent+"/templates/", template.Name)
+	require.Equal(t, []string{"yesterday", "today"}, template.Variables)
+
+	template, err = ts.Service.UpdateTemplate(userCtx, &v1pb.UpdateTemplateRequest{
+		Template: &v1pb.Template{
+			Name:       template.Name,
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, template.Visibility)
+	require.Equal(t, "Stand-up", template.Title)
+
+	// User templates are private to the user.
+	_, err = ts.Service.GetTemplate(otherUserCtx, &v1pb.GetTemplateRequest{Name: template.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	resp, err := ts.Service.ListTemplates(userCtx, &v1pb.ListTemplatesRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, resp.Templates, 1)
+
+	_, err = ts.Service.DeleteTemplate(userCtx, &v1pb.DeleteTemplateRequest{Name: template.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetTemplate(userCtx, &v1pb.GetTemplateRequest{Name: template.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWorkspaceTemplate(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Only admins can manage workspace templates.
+	_, err = ts.Service.CreateTemplate(userCtx, &v1pb.CreateTemplateRequest{
+		Parent:   "workspace",
+		Template: &v1pb.Template{Title: "Incident", Content: "# Incident"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	template, err := ts.Service.CreateTemplate(hostCtx, &v1pb.CreateTemplateRequest{
+		Parent: "workspace",
+		Template: &v1pb.Template{
+			Title:      "Incident",
+			Content:    "# Incident {{date}} {{time}}\nReported by @{{user}}\nService: {{service}}",
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	require.Regexp(t, "^workspace/templates/", template.Name)
+	resp, err := ts.Service.ListTemplates(userCtx, &v1pb.ListTemplatesRequest{Parent: "workspace"})
+	require.NoError(t, err)
+	require.Len(t, resp.Templates, 1)
+
+	_, err = ts.Service.CreateMemoFromTemplate(userCtx, &v1pb.CreateMemoFromTemplateRequest{Name: template.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	memo, err := ts.Service.CreateMemoFromTemplate(userCtx, &v1pb.CreateMemoFromTemplateRequest{
+		Name:      template.Name,
+		Variables: map[string]string{"service": "api", "time": "09:00"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), memo.Creator)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	require.Equal(t, "# Incident "+time.Now().Format("2006-01-02")+" 09:00\nReported by @test-user\nService: api", memo.Content)
+
+	memo, err = ts.Service.CreateMemoFromTemplate(userCtx, &v1pb.CreateMemoFromTemplateRequest{
+		Name:       template.Name,
+		Variables:  map[string]string{"service": "web"},
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/server/notification"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
@@ -37,9 +38,10 @@ func NewTestService(t *testing.T) *TestService {
 	// Create APIV1Service with nil grpcServer since we're testing direct calls
 	secret := "test-secret"
 	service := &apiv1.APIV1Service{
-		Secret:  secret,
-		Profile: testProfile,
-		Store:   testStore,
+		Secret:                 secret,
+		Profile:                testProfile,
+		Store:                  testStore,
+		NotificationDispatcher: notification.NewDispatcher(testStore, testProfile.InstanceURL),
 	}
 
 	return &TestService{
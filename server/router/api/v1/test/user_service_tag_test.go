@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUserTagCRUD(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	tag, err := ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: parent,
+		Tag: &v1pb.UserTag{
+			Tag:         "#work/meeting",
+			Color:       "#3b82f6",
+			Description: "Meeting notes",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, parent+"/tags/work/meeting", tag.Name)
+	require.Equal(t, "work/meeting", tag.Tag)
+
+	_, err = ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: parent,
+		Tag:    &v1pb.UserTag{Tag: "work/meeting"},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: parent,
+		Tag:    &v1pb.UserTag{Tag: "home", Color: "blue"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: parent,
+		Tag:    &v1pb.UserTag{Tag: "kubernetes", Aliases: []string{"k8s", "#kube"}},
+	})
+	require.NoError(t, err)
+	// An alias can only belong to one tag.
+	_, err = ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: parent,
+		Tag:    &v1pb.UserTag{Tag: "containers", Aliases: []string{"k8s"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tag, err = ts.Service.UpdateUserTag(userCtx, &v1pb.UpdateUserTagRequest{
+		Tag: &v1pb.UserTag{
+			Name:   parent + "/tags/kubernetes",
+			Pinned: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	require.True(t, tag.Pinned)
+	require.Equal(t, []string{"k8s", "kube"}, tag.Aliases)
+
+	resp, err := ts.Service.ListUserTags(userCtx, &v1pb.ListUserTagsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 2)
+	require.Equal(t, "kubernetes", resp.Tags[0].Tag)
+	require.Equal(t, "work/meeting", resp.Tags[1].Tag)
+
+	_, err = ts.Service.ListUserTags(otherUserCtx, &v1pb.ListUserTagsRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.DeleteUserTag(userCtx, &v1pb.DeleteUserTagRequest{Name: parent + "/tags/work/meeting"})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteUserTag(userCtx, &v1pb.DeleteUserTagRequest{Name: parent + "/tags/work/meeting"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUserTagAliases(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "other-user")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	for _, content := range []string{
+		"#kubernetes cluster upgrade",
+		"#k8s pod limits",
+		"#k8s #kubernetes both",
+		"#docker images",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{
+				Content:    content,
+				Visibility: v1pb.Visibility_PROTECTED,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = ts.Service.CreateUserTag(userCtx, &v1pb.CreateUserTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Tag:    &v1pb.UserTag{Tag: "kubernetes", Aliases: []string{"k8s"}},
+	})
+	require.NoError(t, err)
+
+	memos, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `tag in ["k8s"]`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 3)
+	memos, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `"kubernetes" in tags`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 3)
+
+	// Aliases belong to the user who defined them.
+	memos, err = ts.Service.ListMemos(otherUserCtx, &v1pb.ListMemosRequest{Filter: `tag in ["k8s"]`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 2)
+
+	stats, err := ts.Service.GetUserStats(userCtx, &v1pb.GetUserStatsRequest{Name: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int32{"kubernetes": 3, "docker": 1}, stats.TagCount)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/usememos/memos/plugin/filter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
 	"github.com/usememos/memos/store"
 )
 
@@ -145,7 +146,33 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		} else {
 			// Unauthenticated or non-HOST users can only create normal users
 			roleToAssign = store.RoleUser
+			// Signing up needs an invitation when user registration is disallowed.
+			if request.InvitationToken == "" {
+				workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+				}
+				if workspaceGeneralSetting.DisallowUserRegistration {
+					return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
+				}
+			}
+		}
+	}
+
+	// Invited users sign up with the invited email address, once.
+	if request.InvitationToken != "" {
+		invitedEmail, err := s.parseInvitationToken(request.InvitationToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid invitation token: %v", err)
+		}
+		existingUser, err := s.Store.GetUser(ctx, &store.FindUser{Email: &invitedEmail})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if existingUser != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "the invitation has already been accepted")
 		}
+		request.User.Email = invitedEmail
 	}
 
 	if !base.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
@@ -342,7 +369,11 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, request *v1pb.GetUser
 		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
 	}
 
-	return convertUserSettingFromStore(userSetting, userID, storeKey), nil
+	setting := convertUserSettingFromStore(userSetting, userID, storeKey)
+	if err := s.setEmailIngestAddress(ctx, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get email ingest address: %v", err)
+	}
+	return setting, nil
 }
 
 func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
@@ -372,8 +403,14 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	// Only GENERAL, NOTIFICATIONS and EMAIL_INGEST settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
+	if storeKey == storepb.UserSetting_NOTIFICATIONS {
+		return s.updateUserNotificationsSetting(ctx, request, userID)
+	}
+	if storeKey == storepb.UserSetting_EMAIL_INGEST {
+		return s.updateUserEmailIngestSetting(ctx, request, userID)
+	}
 	if storeKey != storepb.UserSetting_GENERAL {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
 	}
@@ -430,6 +467,49 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
 }
 
+func (s *APIV1Service) updateUserNotificationsSetting(ctx context.Context, request *v1pb.UpdateUserSettingRequest, userID int32) (*v1pb.UserSetting, error) {
+	incomingNotifications := request.Setting.GetNotificationsSetting()
+	// The setting is read and saved in one transaction, so that concurrent updates don't overwrite each other.
+	if err := s.Store.UpdateUserSetting(ctx, userID, storepb.UserSetting_NOTIFICATIONS, func(userSetting *storepb.UserSetting) error {
+		updatedNotifications := convertNotificationsSettingFromStore(userSetting.GetNotifications())
+		for _, field := range request.UpdateMask.Paths {
+			switch field {
+			case "preferences":
+				updatedNotifications.Preferences = incomingNotifications.GetPreferences()
+			case "pushEndpoint":
+				updatedNotifications.PushEndpoint = incomingNotifications.GetPushEndpoint()
+			case "digestFrequency":
+				updatedNotifications.DigestFrequency = incomingNotifications.GetDigestFrequency()
+			}
+		}
+		if err := validateNotificationsSetting(updatedNotifications); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid notifications setting: %v", err)
+		}
+
+		updatedSetting := &v1pb.UserSetting{
+			Name: request.Setting.Name,
+			Value: &v1pb.UserSetting_NotificationsSetting_{
+				NotificationsSetting: updatedNotifications,
+			},
+		}
+		storeSetting, err := convertUserSettingToStore(updatedSetting, userID, storepb.UserSetting_NOTIFICATIONS)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
+		}
+		// The last digest time is kept by the digest runner, not by users.
+		storeSetting.GetNotifications().LastDigestTs = userSetting.GetNotifications().GetLastDigestTs()
+		userSetting.Value = storeSetting.Value
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update user setting: %v", err)
+	}
+
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
 func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListUserSettingsRequest) (*v1pb.ListUserSettingsResponse, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -457,6 +537,9 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 	for _, storeSetting := range userSettings {
 		apiSetting := convertUserSettingFromStore(storeSetting, userID, storeSetting.Key)
 		if apiSetting != nil {
+			if err := s.setEmailIngestAddress(ctx, apiSetting); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get email ingest address: %v", err)
+			}
 			settings = append(settings, apiSetting)
 		}
 	}
@@ -1088,6 +1171,10 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_ACCESS_TOKENS, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATIONS)]:
+		return storepb.UserSetting_NOTIFICATIONS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_EMAIL_INGEST)]:
+		return storepb.UserSetting_EMAIL_INGEST, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1104,8 +1191,16 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_ACCESS_TOKENS)]
 	case storepb.UserSetting_SHORTCUTS:
 		return "SHORTCUTS" // Not defined in API proto
+	case storepb.UserSetting_TAGS:
+		return "TAGS" // Not defined in API proto
+	case storepb.UserSetting_TEMPLATES:
+		return "TEMPLATES" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_NOTIFICATIONS:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATIONS)]
+	case storepb.UserSetting_EMAIL_INGEST:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_EMAIL_INGEST)]
 	default:
 		return "unknown"
 	}
@@ -1143,6 +1238,18 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_NOTIFICATIONS:
+			setting.Value = &v1pb.UserSetting_NotificationsSetting_{
+				NotificationsSetting: &v1pb.UserSetting_NotificationsSetting{
+					Preferences: []*v1pb.UserSetting_NotificationsSetting_Preference{},
+				},
+			}
+		case storepb.UserSetting_EMAIL_INGEST:
+			setting.Value = &v1pb.UserSetting_EmailIngestSetting_{
+				EmailIngestSetting: &v1pb.UserSetting_EmailIngestSetting{
+					AllowedSenders: []string{},
+				},
+			}
 		}
 		return setting
 	}
@@ -1223,6 +1330,14 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_NOTIFICATIONS:
+		setting.Value = &v1pb.UserSetting_NotificationsSetting_{
+			NotificationsSetting: convertNotificationsSettingFromStore(storeSetting.GetNotifications()),
+		}
+	case storepb.UserSetting_EMAIL_INGEST:
+		setting.Value = &v1pb.UserSetting_EmailIngestSetting_{
+			EmailIngestSetting: convertEmailIngestSettingFromStore(storeSetting.GetEmailIngest()),
+		}
 	}
 
 	return setting
@@ -1311,6 +1426,14 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_NOTIFICATIONS:
+		if notifications := apiSetting.GetNotificationsSetting(); notifications != nil {
+			storeSetting.Value = &storepb.UserSetting_Notifications{
+				Notifications: convertNotificationsSettingToStore(notifications),
+			}
+		} else {
+			return nil, errors.Errorf("notifications setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1361,3 +1484,73 @@ func (s *APIV1Service) validateUserFilter(_ context.Context, filterStr string) e
 	}
 	return nil
 }
+
+func convertNotificationsSettingFromStore(setting *storepb.NotificationsUserSetting) *v1pb.UserSetting_NotificationsSetting {
+	preferences := []*v1pb.UserSetting_NotificationsSetting_Preference{}
+	for _, preference := range setting.GetPreferences() {
+		channels := make([]v1pb.UserSetting_NotificationsSetting_Channel, 0, len(preference.Channels))
+		for _, channel := range preference.Channels {
+			channels = append(channels, v1pb.UserSetting_NotificationsSetting_Channel(channel))
+		}
+		preferences = append(preferences, &v1pb.UserSetting_NotificationsSetting_Preference{
+			Type:     v1pb.Inbox_Type(preference.Type),
+			Channels: channels,
+		})
+	}
+	return &v1pb.UserSetting_NotificationsSetting{
+		Preferences:     preferences,
+		PushEndpoint:    setting.GetPushUrl(),
+		DigestFrequency: v1pb.UserSetting_NotificationsSetting_DigestFrequency(setting.GetDigestFrequency()),
+	}
+}
+
+func convertNotificationsSettingToStore(setting *v1pb.UserSetting_NotificationsSetting) *storepb.NotificationsUserSetting {
+	preferences := make([]*storepb.NotificationsUserSetting_Preference, 0, len(setting.Preferences))
+	for _, preference := range setting.Preferences {
+		channels := make([]storepb.NotificationsUserSetting_Channel, 0, len(preference.Channels))
+		for _, channel := range preference.Channels {
+			channels = append(channels, storepb.NotificationsUserSetting_Channel(channel))
+		}
+		preferences = append(preferences, &storepb.NotificationsUserSetting_Preference{
+			Type:     storepb.InboxMessage_Type(preference.Type),
+			Channels: channels,
+		})
+	}
+	return &storepb.NotificationsUserSetting{
+		Preferences:     preferences,
+		PushUrl:         setting.PushEndpoint,
+		DigestFrequency: storepb.NotificationsUserSetting_DigestFrequency(setting.DigestFrequency),
+	}
+}
+
+// validateNotificationsSetting checks that the preferences are for configurable types, each at most once,
+// and that there's a valid push endpoint if any type is pushed.
+func validateNotificationsSetting(setting *v1pb.UserSetting_NotificationsSetting) error {
+	types := map[v1pb.Inbox_Type]bool{}
+	pushed := false
+	for _, preference := range setting.Preferences {
+		if !notification.IsConfigurableType(storepb.InboxMessage_Type(preference.Type)) {
+			return errors.Errorf("delivery of %s notifications cannot be configured", preference.Type.String())
+		}
+		if types[preference.Type] {
+			return errors.Errorf("duplicate preference for %s notifications", preference.Type.String())
+		}
+		types[preference.Type] = true
+		for _, channel := range preference.Channels {
+			if channel == v1pb.UserSetting_NotificationsSetting_CHANNEL_UNSPECIFIED {
+				return errors.Errorf("unspecified channel for %s notifications", preference.Type.String())
+			}
+			if channel == v1pb.UserSetting_NotificationsSetting_PUSH {
+				pushed = true
+			}
+		}
+	}
+	if setting.PushEndpoint != "" {
+		if err := notification.ValidatePushURL(setting.PushEndpoint); err != nil {
+			return err
+		}
+	} else if pushed {
+		return errors.New("push endpoint is required for push notifications")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// emailIngestTokenLength is the length of the tokens emails are authenticated with.
+const emailIngestTokenLength = 32
+
+func (s *APIV1Service) updateUserEmailIngestSetting(ctx context.Context, request *v1pb.UpdateUserSettingRequest, userID int32) (*v1pb.UserSetting, error) {
+	incomingEmailIngest := request.Setting.GetEmailIngestSetting()
+	var allowedSenders []string
+	if slices.Contains(request.UpdateMask.Paths, "allowedSenders") {
+		var err error
+		if allowedSenders, err = normalizeAllowedSenders(incomingEmailIngest.GetAllowedSenders()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid allowed senders: %v", err)
+		}
+	}
+
+	// The setting is read and saved in one transaction, so that concurrent updates don't overwrite each other.
+	if err := s.Store.UpdateUserSetting(ctx, userID, storepb.UserSetting_EMAIL_INGEST, func(userSetting *storepb.UserSetting) error {
+		emailIngestSetting := userSetting.GetEmailIngest()
+		if emailIngestSetting == nil {
+			emailIngestSetting = &storepb.EmailIngestUserSetting{}
+		}
+		var err error
+		for _, field := range request.UpdateMask.Paths {
+			switch field {
+			case "enabled":
+				if !incomingEmailIngest.GetEnabled() {
+					emailIngestSetting.Token = ""
+				} else if emailIngestSetting.Token == "" {
+					if emailIngestSetting.Token, err = generateEmailIngestToken(); err != nil {
+						return errors.Wrap(err, "failed to generate token")
+					}
+				}
+			case "token":
+				if emailIngestSetting.Token, err = generateEmailIngestToken(); err != nil {
+					return errors.Wrap(err, "failed to generate token")
+				}
+			case "allowedSenders":
+				emailIngestSetting.AllowedSenders = allowedSenders
+			}
+		}
+		userSetting.Value = &storepb.UserSetting_EmailIngest{
+			EmailIngest: emailIngestSetting,
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user setting: %v", err)
+	}
+
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// setEmailIngestAddress sets the address of an enabled email ingest setting.
+func (s *APIV1Service) setEmailIngestAddress(ctx context.Context, setting *v1pb.UserSetting) error {
+	emailIngestSetting := setting.GetEmailIngestSetting()
+	if emailIngestSetting == nil || emailIngestSetting.Token == "" {
+		return nil
+	}
+	workspaceEmailIngestSetting, err := s.Store.GetWorkspaceEmailIngestSetting(ctx)
+	if err != nil {
+		return err
+	}
+	domain := workspaceEmailIngestSetting.Domain
+	if domain == "" {
+		domain = "localhost"
+		if instanceURL, err := url.Parse(s.Profile.InstanceURL); err == nil && instanceURL.Hostname() != "" {
+			domain = instanceURL.Hostname()
+		}
+	}
+	emailIngestSetting.Address = emailIngestSetting.Token + "@" + domain
+	return nil
+}
+
+func generateEmailIngestToken() (string, error) {
+	token, err := util.RandomString(emailIngestTokenLength)
+	if err != nil {
+		return "", err
+	}
+	// Mail servers don't always keep the case of addresses.
+	return strings.ToLower(token), nil
+}
+
+// normalizeAllowedSenders checks that the allowed senders are addresses or domains like @example.com.
+func normalizeAllowedSenders(allowedSenders []string) ([]string, error) {
+	normalized := []string{}
+	for _, sender := range allowedSenders {
+		sender = strings.ToLower(strings.TrimSpace(sender))
+		if sender == "" {
+			continue
+		}
+		address := sender
+		if strings.HasPrefix(sender, "@") {
+			address = "sender" + sender
+		}
+		if !util.ValidateEmail(address) || strings.ContainsAny(sender, "<> ") {
+			return nil, errors.Errorf("invalid sender %q", sender)
+		}
+		normalized = append(normalized, sender)
+	}
+	return normalized, nil
+}
+
+func convertEmailIngestSettingFromStore(setting *storepb.EmailIngestUserSetting) *v1pb.UserSetting_EmailIngestSetting {
+	allowedSenders := setting.GetAllowedSenders()
+	if allowedSenders == nil {
+		allowedSenders = []string{}
+	}
+	return &v1pb.UserSetting_EmailIngestSetting{
+		Enabled:        setting.GetToken() != "",
+		Token:          setting.GetToken(),
+		AllowedSenders: allowedSenders,
+	}
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+// invitationDuration is how long invitation links are valid.
+const invitationDuration = 7 * 24 * time.Hour
+
+func (s *APIV1Service) InviteUser(ctx context.Context, request *v1pb.InviteUserRequest) (*emptypb.Empty, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	address, err := mail.ParseAddress(strings.TrimSpace(request.Email))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", request.Email)
+	}
+	existingUser, err := s.Store.GetUser(ctx, &store.FindUser{Email: &address.Address})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if existingUser != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a user with the email already exists")
+	}
+
+	token, err := generateToken(address.Address, currentUser.ID, InvitationAudienceName, time.Now().Add(invitationDuration), []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate invitation token: %v", err)
+	}
+	inviter := currentUser.Nickname
+	if inviter == "" {
+		inviter = currentUser.Username
+	}
+	message, err := email.NewMessage([]string{address.Address}, fmt.Sprintf("%s invited you to Memos", inviter), email.TemplateInvitation, &email.InvitationData{
+		Inviter:   inviter,
+		Link:      notification.GetLink(s.Profile.InstanceURL, "auth/signup?invitation="+url.QueryEscape(token)),
+		ExpiresIn: "7 days",
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build email: %v", err)
+	}
+	if err := notification.SendEmail(ctx, s.Store, message); err != nil {
+		if errors.Is(err, notification.ErrEmailDisabled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "sending email is not enabled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to send invitation email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// parseInvitationToken returns the email address the token invites.
+func (s *APIV1Service) parseInvitationToken(token string) (string, error) {
+	claims := &ClaimsMessage{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		return []byte(s.Secret), nil
+	}, jwt.WithAudience(InvitationAudienceName), jwt.WithIssuer(Issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", err
+	}
+	return claims.Name, nil
+}
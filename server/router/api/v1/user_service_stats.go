@@ -36,7 +36,7 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else {
 		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+			filter := getMemoVisibilityFilter(currentUser.ID)
 			memoFind.Filters = append(memoFind.Filters, filter)
 		} else if *memoFind.CreatorID != currentUser.ID {
 			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
@@ -106,6 +106,13 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
 	}
 
+	userTags, err := s.Store.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user tags: %v", err)
+	}
+	// Aliased tags are counted under the tag they belong to.
+	canonicalTags := getCanonicalTags(userTags)
+
 	displayTimestamps := []*timestamppb.Timestamp{}
 	tagCount := make(map[string]int32)
 	linkCount := int32(0)
@@ -122,8 +129,15 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 		displayTimestamps = append(displayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
 		// Count different memo types based on content.
 		if memo.Payload != nil {
+			memoTags := map[string]bool{}
 			for _, tag := range memo.Payload.Tags {
-				tagCount[tag]++
+				if canonicalTag, ok := canonicalTags[tag]; ok {
+					tag = canonicalTag
+				}
+				if !memoTags[tag] {
+					tagCount[tag]++
+					memoTags[tag] = true
+				}
 			}
 			if memo.Payload.Property != nil {
 				if memo.Payload.Property.HasLink {
@@ -0,0 +1,284 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+var tagColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *APIV1Service) ListUserTags(ctx context.Context, request *v1pb.ListUserTagsRequest) (*v1pb.ListUserTagsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if err := s.checkUserTagPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.Store.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user tags: %v", err)
+	}
+
+	userTags := make([]*v1pb.UserTag, 0, len(tags))
+	for _, tag := range tags {
+		userTags = append(userTags, convertUserTagFromUserSetting(tag, userID))
+	}
+	// Pinned tags come first, the rest keep the order they were created in.
+	slices.SortStableFunc(userTags, func(a, b *v1pb.UserTag) int {
+		if a.Pinned == b.Pinned {
+			return 0
+		}
+		if a.Pinned {
+			return -1
+		}
+		return 1
+	})
+
+	return &v1pb.ListUserTagsResponse{
+		Tags: userTags,
+	}, nil
+}
+
+func (s *APIV1Service) CreateUserTag(ctx context.Context, request *v1pb.CreateUserTagRequest) (*v1pb.UserTag, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if err := s.checkUserTagPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+
+	tags, err := s.Store.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user tags: %v", err)
+	}
+
+	tag := &storepb.TagsUserSetting_Tag{
+		Tag:         normalizeTagName(request.Tag.Tag),
+		Color:       request.Tag.Color,
+		Description: request.Tag.Description,
+		Aliases:     request.Tag.Aliases,
+		Pinned:      request.Tag.Pinned,
+	}
+	for _, existing := range tags {
+		if existing.Tag == tag.Tag {
+			return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists", tag.Tag)
+		}
+	}
+	if err := validateUserTag(tag, tags); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+
+	if err := s.Store.UpsertUserTag(ctx, userID, tag); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tag: %v", err)
+	}
+
+	return convertUserTagFromUserSetting(tag, userID), nil
+}
+
+func (s *APIV1Service) UpdateUserTag(ctx context.Context, request *v1pb.UpdateUserTagRequest) (*v1pb.UserTag, error) {
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	userID, tagName, err := parseUserTagName(request.Tag.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
+	}
+	if err := s.checkUserTagPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	tags, err := s.Store.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user tags: %v", err)
+	}
+
+	var targetTag *storepb.TagsUserSetting_Tag
+	for _, tag := range tags {
+		if tag.Tag == tagName {
+			targetTag = tag
+			break
+		}
+	}
+	if targetTag == nil {
+		return nil, status.Errorf(codes.NotFound, "tag not found")
+	}
+
+	updatedTag := &storepb.TagsUserSetting_Tag{
+		Tag:         targetTag.Tag,
+		Color:       targetTag.Color,
+		Description: targetTag.Description,
+		Aliases:     targetTag.Aliases,
+		Pinned:      targetTag.Pinned,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "color":
+			updatedTag.Color = request.Tag.Color
+		case "description":
+			updatedTag.Description = request.Tag.Description
+		case "aliases":
+			updatedTag.Aliases = request.Tag.Aliases
+		case "pinned":
+			updatedTag.Pinned = request.Tag.Pinned
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := validateUserTag(updatedTag, tags); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+
+	if err := s.Store.UpsertUserTag(ctx, userID, updatedTag); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag: %v", err)
+	}
+
+	return convertUserTagFromUserSetting(updatedTag, userID), nil
+}
+
+func (s *APIV1Service) DeleteUserTag(ctx context.Context, request *v1pb.DeleteUserTagRequest) (*emptypb.Empty, error) {
+	userID, tagName, err := parseUserTagName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
+	}
+	if err := s.checkUserTagPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.Store.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user tags: %v", err)
+	}
+	if !slices.ContainsFunc(tags, func(tag *storepb.TagsUserSetting_Tag) bool {
+		return tag.Tag == tagName
+	}) {
+		return nil, status.Errorf(codes.NotFound, "tag not found")
+	}
+
+	if err := s.Store.RemoveUserTag(ctx, userID, tagName); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) checkUserTagPermission(ctx context.Context, userID int32) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// validateUserTag normalizes the aliases of the tag and checks it against the other tags of the user.
+func validateUserTag(tag *storepb.TagsUserSetting_Tag, tags []*storepb.TagsUserSetting_Tag) error {
+	if tag.Tag == "" || strings.ContainsAny(tag.Tag, " \t\n") {
+		return errors.Errorf("invalid tag %q", tag.Tag)
+	}
+	if tag.Color != "" && !tagColorRegexp.MatchString(tag.Color) {
+		return errors.Errorf("invalid color %q, expected a hex color like #3b82f6", tag.Color)
+	}
+
+	// An alias can only mean one tag, otherwise filters on it would be ambiguous.
+	takenNames := map[string]string{}
+	for _, other := range tags {
+		if other.Tag == tag.Tag {
+			continue
+		}
+		takenNames[other.Tag] = other.Tag
+		for _, alias := range other.Aliases {
+			takenNames[alias] = other.Tag
+		}
+	}
+	if owner, ok := takenNames[tag.Tag]; ok && owner != tag.Tag {
+		return errors.Errorf("tag %q is already an alias of %q", tag.Tag, owner)
+	}
+	aliases := []string{}
+	for _, alias := range tag.Aliases {
+		alias = normalizeTagName(alias)
+		if alias == "" || strings.ContainsAny(alias, " \t\n") {
+			return errors.Errorf("invalid alias %q", alias)
+		}
+		if alias == tag.Tag || slices.Contains(aliases, alias) {
+			continue
+		}
+		if owner, ok := takenNames[alias]; ok {
+			return errors.Errorf("alias %q is already used by tag %q", alias, owner)
+		}
+		aliases = append(aliases, alias)
+	}
+	tag.Aliases = aliases
+	return nil
+}
+
+// normalizeTagName strips the leading "#" and surrounding slashes of a tag.
+func normalizeTagName(tag string) string {
+	return strings.Trim(strings.TrimPrefix(strings.TrimSpace(tag), "#"), "/")
+}
+
+// parseUserTagName parses a tag name and returns the user ID and the tag.
+// Format: users/{user}/tags/{tag}, the tag may contain "/".
+func parseUserTagName(name string) (int32, string, error) {
+	parts := strings.SplitN(name, "/", 4)
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "tags" {
+		return 0, "", errors.Errorf("invalid tag name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	tag := normalizeTagName(parts[3])
+	if tag == "" {
+		return 0, "", errors.Errorf("empty tag in name: %s", name)
+	}
+
+	return userID, tag, nil
+}
+
+// convertUserTagFromUserSetting converts a storepb tag to a v1pb UserTag.
+func convertUserTagFromUserSetting(tag *storepb.TagsUserSetting_Tag, userID int32) *v1pb.UserTag {
+	return &v1pb.UserTag{
+		Name:        fmt.Sprintf("users/%d/tags/%s", userID, tag.Tag),
+		Tag:         tag.Tag,
+		Color:       tag.Color,
+		Description: tag.Description,
+		Aliases:     tag.Aliases,
+		Pinned:      tag.Pinned,
+	}
+}
+
+// getCanonicalTags maps every alias of the tags to the tag it belongs to.
+func getCanonicalTags(tags []*storepb.TagsUserSetting_Tag) map[string]string {
+	canonicalTags := map[string]string{}
+	for _, tag := range tags {
+		for _, alias := range tag.Aliases {
+			canonicalTags[alias] = tag.Tag
+		}
+	}
+	return canonicalTags
+}
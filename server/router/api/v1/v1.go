@@ -16,6 +16,7 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/notification"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,10 +33,14 @@ type APIV1Service struct {
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedMarkdownServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedGroupServiceServer
+	v1pb.UnimplementedTemplateServiceServer
+	v1pb.UnimplementedRecurringMemoServiceServer
 
-	Secret  string
-	Profile *profile.Profile
-	Store   *store.Store
+	Secret                 string
+	Profile                *profile.Profile
+	Store                  *store.Store
+	NotificationDispatcher *notification.Dispatcher
 
 	grpcServer *grpc.Server
 }
@@ -43,10 +48,11 @@ type APIV1Service struct {
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
 	grpc.EnableTracing = true
 	apiv1Service := &APIV1Service{
-		Secret:     secret,
-		Profile:    profile,
-		Store:      store,
-		grpcServer: grpcServer,
+		Secret:                 secret,
+		Profile:                profile,
+		Store:                  store,
+		NotificationDispatcher: notification.NewDispatcher(store, profile.InstanceURL),
+		grpcServer:             grpcServer,
 	}
 	grpc_health_v1.RegisterHealthServer(grpcServer, apiv1Service)
 	v1pb.RegisterWorkspaceServiceServer(grpcServer, apiv1Service)
@@ -59,6 +65,9 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterMarkdownServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTemplateServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterRecurringMemoServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
 	return apiv1Service
 }
@@ -115,6 +124,15 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterGroupServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTemplateServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterRecurringMemoServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORS())
 	handler := echo.WrapHandler(gwMux)
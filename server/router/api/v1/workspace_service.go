@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/mail"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -50,6 +52,10 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		_, err = s.Store.GetWorkspaceStorageSetting(ctx)
 	case storepb.WorkspaceSettingKey_AI:
 		_, err = s.Store.GetWorkspaceAISetting(ctx)
+	case storepb.WorkspaceSettingKey_SMTP:
+		_, err = s.Store.GetWorkspaceSMTPSetting(ctx)
+	case storepb.WorkspaceSettingKey_EMAIL_INGEST:
+		_, err = s.Store.GetWorkspaceEmailIngestSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported workspace setting key: %v", workspaceSettingKey)
 	}
@@ -67,8 +73,9 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.NotFound, "workspace setting not found")
 	}
 
-	// For storage and AI settings, only host can get them.
-	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE || workspaceSetting.Key == storepb.WorkspaceSettingKey_AI {
+	// For storage, AI, SMTP and email ingest settings, only host can get them.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE || workspaceSetting.Key == storepb.WorkspaceSettingKey_AI ||
+		workspaceSetting.Key == storepb.WorkspaceSettingKey_SMTP || workspaceSetting.Key == storepb.WorkspaceSettingKey_EMAIL_INGEST {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -93,6 +100,22 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	// TODO: Apply update_mask if specified
 	_ = request.UpdateMask
 
+	if memoRelatedSetting := request.Setting.GetMemoRelatedSetting(); memoRelatedSetting != nil {
+		if err := validateMemoRelationTypes(memoRelatedSetting.RelationTypes); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid relation types: %v", err)
+		}
+	}
+	if smtpSetting := request.Setting.GetSmtpSetting(); smtpSetting != nil {
+		if err := validateWorkspaceSMTPSetting(smtpSetting); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SMTP setting: %v", err)
+		}
+	}
+	if emailIngestSetting := request.Setting.GetEmailIngestSetting(); emailIngestSetting != nil {
+		if err := validateWorkspaceEmailIngestSetting(emailIngestSetting); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email ingest setting: %v", err)
+		}
+	}
+
 	updateSetting := convertWorkspaceSettingToStore(request.Setting)
 	workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, updateSetting)
 	if err != nil {
@@ -123,6 +146,14 @@ func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.W
 		workspaceSetting.Value = &v1pb.WorkspaceSetting_AiSetting_{
 			AiSetting: convertWorkspaceAISettingFromStore(setting.GetAiSetting()),
 		}
+	case *storepb.WorkspaceSetting_SmtpSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_SmtpSetting_{
+			SmtpSetting: convertWorkspaceSMTPSettingFromStore(setting.GetSmtpSetting()),
+		}
+	case *storepb.WorkspaceSetting_EmailIngestSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_EmailIngestSetting_{
+			EmailIngestSetting: convertWorkspaceEmailIngestSettingFromStore(setting.GetEmailIngestSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -152,6 +183,14 @@ func convertWorkspaceSettingToStore(setting *v1pb.WorkspaceSetting) *storepb.Wor
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiSetting{
 			AiSetting: convertWorkspaceAISettingToStore(setting.GetAiSetting()),
 		}
+	case storepb.WorkspaceSettingKey_SMTP:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SmtpSetting{
+			SmtpSetting: convertWorkspaceSMTPSettingToStore(setting.GetSmtpSetting()),
+		}
+	case storepb.WorkspaceSettingKey_EMAIL_INGEST:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmailIngestSetting{
+			EmailIngestSetting: convertWorkspaceEmailIngestSettingToStore(setting.GetEmailIngestSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -270,6 +309,8 @@ func convertWorkspaceMemoRelatedSettingFromStore(setting *storepb.WorkspaceMemoR
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
+		RelationTypes:            convertWorkspaceMemoRelationTypesFromStore(setting.RelationTypes),
 	}
 }
 
@@ -287,9 +328,33 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceSetting_Me
 		DisableMarkdownShortcuts: setting.DisableMarkdownShortcuts,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
+		RelationTypes:            convertWorkspaceMemoRelationTypesToStore(setting.RelationTypes),
 	}
 }
 
+func convertWorkspaceMemoRelationTypesFromStore(relationTypes []*storepb.WorkspaceMemoRelatedSetting_RelationType) []*v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType {
+	result := []*v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{}
+	for _, relationType := range relationTypes {
+		result = append(result, &v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType{
+			Name:         relationType.Name,
+			InverseLabel: relationType.InverseLabel,
+		})
+	}
+	return result
+}
+
+func convertWorkspaceMemoRelationTypesToStore(relationTypes []*v1pb.WorkspaceSetting_MemoRelatedSetting_RelationType) []*storepb.WorkspaceMemoRelatedSetting_RelationType {
+	result := []*storepb.WorkspaceMemoRelatedSetting_RelationType{}
+	for _, relationType := range relationTypes {
+		result = append(result, &storepb.WorkspaceMemoRelatedSetting_RelationType{
+			Name:         relationType.Name,
+			InverseLabel: strings.TrimSpace(relationType.InverseLabel),
+		})
+	}
+	return result
+}
+
 func convertWorkspaceAISettingFromStore(setting *storepb.WorkspaceAISetting) *v1pb.WorkspaceSetting_AiSetting {
 	if setting == nil {
 		return &v1pb.WorkspaceSetting_AiSetting{
@@ -350,6 +415,88 @@ func convertWorkspaceAISettingToStore(setting *v1pb.WorkspaceSetting_AiSetting)
 	return result
 }
 
+func convertWorkspaceSMTPSettingFromStore(setting *storepb.WorkspaceSMTPSetting) *v1pb.WorkspaceSetting_SmtpSetting {
+	if setting == nil {
+		return &v1pb.WorkspaceSetting_SmtpSetting{}
+	}
+	return &v1pb.WorkspaceSetting_SmtpSetting{
+		EnableEmail: setting.EnableEmail,
+		Host:        setting.Host,
+		Port:        setting.Port,
+		Username:    setting.Username,
+		Password:    setting.Password,
+		Security:    v1pb.WorkspaceSetting_SmtpSetting_Security(setting.Security),
+		FromEmail:   setting.FromEmail,
+		FromName:    setting.FromName,
+	}
+}
+
+func convertWorkspaceSMTPSettingToStore(setting *v1pb.WorkspaceSetting_SmtpSetting) *storepb.WorkspaceSMTPSetting {
+	if setting == nil {
+		return &storepb.WorkspaceSMTPSetting{}
+	}
+	return &storepb.WorkspaceSMTPSetting{
+		EnableEmail: setting.EnableEmail,
+		Host:        strings.TrimSpace(setting.Host),
+		Port:        setting.Port,
+		Username:    setting.Username,
+		Password:    setting.Password,
+		Security:    storepb.WorkspaceSMTPSetting_Security(setting.Security),
+		FromEmail:   strings.TrimSpace(setting.FromEmail),
+		FromName:    setting.FromName,
+	}
+}
+
+// validateWorkspaceSMTPSetting checks that there's a server and a sender to send email with when it's enabled.
+func validateWorkspaceSMTPSetting(setting *v1pb.WorkspaceSetting_SmtpSetting) error {
+	if setting.Port < 0 || setting.Port > 65535 {
+		return errors.Errorf("invalid port %d", setting.Port)
+	}
+	if !setting.EnableEmail {
+		return nil
+	}
+	if strings.TrimSpace(setting.Host) == "" {
+		return errors.New("host is required")
+	}
+	if _, err := mail.ParseAddress(strings.TrimSpace(setting.FromEmail)); err != nil {
+		return errors.Errorf("invalid from email %q", setting.FromEmail)
+	}
+	return nil
+}
+
+func convertWorkspaceEmailIngestSettingFromStore(setting *storepb.WorkspaceEmailIngestSetting) *v1pb.WorkspaceSetting_EmailIngestSetting {
+	if setting == nil {
+		return &v1pb.WorkspaceSetting_EmailIngestSetting{}
+	}
+	return &v1pb.WorkspaceSetting_EmailIngestSetting{
+		Domain:           setting.Domain,
+		MaxMessageSizeMb: setting.MaxMessageSizeMb,
+	}
+}
+
+func convertWorkspaceEmailIngestSettingToStore(setting *v1pb.WorkspaceSetting_EmailIngestSetting) *storepb.WorkspaceEmailIngestSetting {
+	if setting == nil {
+		return &storepb.WorkspaceEmailIngestSetting{}
+	}
+	return &storepb.WorkspaceEmailIngestSetting{
+		Domain:           strings.ToLower(strings.TrimSpace(setting.Domain)),
+		MaxMessageSizeMb: setting.MaxMessageSizeMb,
+	}
+}
+
+// validateWorkspaceEmailIngestSetting checks that the domain can be the domain of an email address.
+func validateWorkspaceEmailIngestSetting(setting *v1pb.WorkspaceSetting_EmailIngestSetting) error {
+	if setting.MaxMessageSizeMb < 0 {
+		return errors.Errorf("invalid max message size %d", setting.MaxMessageSizeMb)
+	}
+	if domain := strings.TrimSpace(setting.Domain); domain != "" {
+		if _, err := mail.ParseAddress("memos@" + domain); err != nil || strings.ContainsAny(domain, "@ ") {
+			return errors.Errorf("invalid domain %q", setting.Domain)
+		}
+	}
+	return nil
+}
+
 var ownerCache *v1pb.User
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
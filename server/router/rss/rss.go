@@ -46,6 +46,7 @@ func (s *RSSService) RegisterRoutes(g *echo.Group) {
 func (s *RSSService) GetExploreRSS(c echo.Context) error {
 	ctx := c.Request().Context()
 	normalStatus := store.Normal
+	// Feeds are read anonymously, so protected, private and group memos are never included.
 	memoFind := store.FindMemo{
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
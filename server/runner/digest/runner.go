@@ -0,0 +1,226 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// InstanceURL is the URL links in digests point to.
+	InstanceURL string
+}
+
+func NewRunner(store *store.Store, instanceURL string) *Runner {
+	return &Runner{
+		Store:       store,
+		InstanceURL: instanceURL,
+	}
+}
+
+// digestHour is the hour of the morning the digests are sent at.
+const digestHour = 8
+
+// Send the digests every morning at digestHour.
+var runnerSchedule = fmt.Sprintf("0 %d * * *", digestHour)
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule digest runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce emails the digests that came due since the last digest of each user, daily ones every morning
+// and weekly ones on the morning of the first day of the week, with the unread inbox items of the period.
+// Running it again before the next digest is due sends nothing, so it's also run at startup to catch up.
+func (r *Runner) RunOnce(ctx context.Context) {
+	smtpSetting, err := r.Store.GetWorkspaceSMTPSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace SMTP setting", "err", err)
+		return
+	}
+	if !smtpSetting.EnableEmail {
+		return
+	}
+	workspaceGeneralSetting, err := r.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace general setting", "err", err)
+		return
+	}
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_NOTIFICATIONS,
+	})
+	if err != nil {
+		slog.Error("failed to list user notifications settings", "err", err)
+		return
+	}
+
+	now := time.Now()
+	for _, userSetting := range userSettings {
+		var period string
+		var days int
+		switch userSetting.GetNotifications().GetDigestFrequency() {
+		case storepb.NotificationsUserSetting_DAILY:
+			period, days = "day", 1
+		case storepb.NotificationsUserSetting_WEEKLY:
+			period, days = "week", 7
+		default:
+			continue
+		}
+		dueTime := getDigestDueTime(now, days, workspaceGeneralSetting.WeekStartDayOffset)
+		lastDigestTime := time.Unix(userSetting.GetNotifications().GetLastDigestTs(), 0)
+		if !lastDigestTime.Before(dueTime) {
+			continue
+		}
+		// The digest covers the period before it came due, without the items of the previous digest.
+		since := dueTime.AddDate(0, 0, -days)
+		if lastDigestTime.After(since) {
+			since = lastDigestTime
+		}
+		if err := r.sendDigest(ctx, userSetting.UserId, period, since); err != nil {
+			slog.Error("failed to send digest", "err", err, "userID", userSetting.UserId)
+			continue
+		}
+		if err := r.Store.UpdateUserSetting(ctx, userSetting.UserId, storepb.UserSetting_NOTIFICATIONS, func(userSetting *storepb.UserSetting) error {
+			notificationsSetting := userSetting.GetNotifications()
+			if notificationsSetting == nil {
+				notificationsSetting = &storepb.NotificationsUserSetting{}
+			}
+			notificationsSetting.LastDigestTs = now.Unix()
+			userSetting.Value = &storepb.UserSetting_Notifications{Notifications: notificationsSetting}
+			return nil
+		}); err != nil {
+			slog.Error("failed to save last digest time", "err", err, "userID", userSetting.UserId)
+		}
+	}
+}
+
+// getDigestDueTime returns the last time at or before now a digest sent every number of days was due.
+// Weekly digests are due on the first day of the week, whose offset from Sunday is -1 for Saturday.
+func getDigestDueTime(now time.Time, days int, weekStartDayOffset int32) time.Time {
+	dueTime := time.Date(now.Year(), now.Month(), now.Day(), digestHour, 0, 0, 0, now.Location())
+	if dueTime.After(now) {
+		dueTime = dueTime.AddDate(0, 0, -1)
+	}
+	if days == 7 {
+		weekStartDay := time.Weekday((weekStartDayOffset%7 + 7) % 7)
+		for dueTime.Weekday() != weekStartDay {
+			dueTime = dueTime.AddDate(0, 0, -1)
+		}
+	}
+	return dueTime
+}
+
+// sendDigest emails the unread inbox items of the user received since the time, if there are any.
+func (r *Runner) sendDigest(ctx context.Context, userID int32, period string, since time.Time) error {
+	user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal || user.Email == "" {
+		return nil
+	}
+	unreadStatus := store.UNREAD
+	inboxes, err := r.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &userID,
+		Status:     &unreadStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+
+	items := []*email.NotificationData{}
+	for _, inbox := range inboxes {
+		if inbox.CreatedTs < since.Unix() {
+			continue
+		}
+		item, err := r.getDigestItem(ctx, inbox)
+		if err != nil {
+			slog.Warn("failed to get digest item", "err", err, "inboxID", inbox.ID)
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	subject := "Your daily Memos digest"
+	if period == "week" {
+		subject = "Your weekly Memos digest"
+	}
+	message, err := email.NewMessage([]string{user.Email}, subject, email.TemplateDigest, &email.DigestData{
+		Period:    period,
+		Items:     items,
+		InboxLink: notification.GetLink(r.InstanceURL, "inbox"),
+	})
+	if err != nil {
+		return err
+	}
+	return notification.SendEmail(ctx, r.Store, message)
+}
+
+func (r *Runner) getDigestItem(ctx context.Context, inbox *store.Inbox) (*email.NotificationData, error) {
+	n := &notification.Notification{
+		Type:       inbox.Message.Type,
+		SenderID:   inbox.SenderID,
+		ReceiverID: inbox.ReceiverID,
+	}
+	if inbox.Message.ActivityId != nil {
+		activity, err := r.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get activity")
+		}
+		if activity != nil {
+			payload := activity.Payload
+			switch {
+			case payload.GetMemoComment() != nil:
+				n.MemoID = payload.GetMemoComment().MemoId
+			case payload.GetMemoGrant() != nil:
+				n.MemoID = payload.GetMemoGrant().MemoId
+			case payload.GetMemoReminder() != nil:
+				n.MemoID = payload.GetMemoReminder().MemoId
+				n.Content = payload.GetMemoReminder().Content
+			case payload.GetMemoMention() != nil:
+				n.MemoID = payload.GetMemoMention().MemoId
+			case payload.GetMemoReaction() != nil:
+				n.MemoID = payload.GetMemoReaction().MemoId
+			case payload.GetMemoReference() != nil:
+				n.MemoID = payload.GetMemoReference().MemoId
+			}
+		}
+	}
+
+	message, err := notification.BuildMessage(ctx, r.Store, n, nil)
+	if err != nil {
+		return nil, err
+	}
+	item := &email.NotificationData{
+		Title:   message.Title,
+		Content: message.Content,
+	}
+	if message.Memo != "" {
+		item.Link = notification.GetLink(r.InstanceURL, message.Memo)
+	}
+	if senders := len(inbox.Message.SenderIds); senders > 1 {
+		item.Title = fmt.Sprintf("%s (and %d others)", item.Title, senders-1)
+	}
+	return item, nil
+}
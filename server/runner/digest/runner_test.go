@@ -0,0 +1,30 @@
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDigestDueTime(t *testing.T) {
+	// 2025-06-11 is a Wednesday.
+	morning := time.Date(2025, 6, 11, 7, 0, 0, 0, time.UTC)
+	noon := time.Date(2025, 6, 11, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		now                time.Time
+		days               int
+		weekStartDayOffset int32
+		want               time.Time
+	}{
+		{now: noon, days: 1, want: time.Date(2025, 6, 11, 8, 0, 0, 0, time.UTC)},
+		{now: morning, days: 1, want: time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC)},
+		{now: noon, days: 7, weekStartDayOffset: 3, want: time.Date(2025, 6, 11, 8, 0, 0, 0, time.UTC)},
+		{now: morning, days: 7, weekStartDayOffset: 3, want: time.Date(2025, 6, 4, 8, 0, 0, 0, time.UTC)},
+		{now: noon, days: 7, weekStartDayOffset: 1, want: time.Date(2025, 6, 9, 8, 0, 0, 0, time.UTC)},
+		{now: noon, days: 7, weekStartDayOffset: -1, want: time.Date(2025, 6, 7, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, getDigestDueTime(test.now, test.days, test.weekStartDayOffset))
+	}
+}
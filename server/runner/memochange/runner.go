@@ -0,0 +1,44 @@
+package memochange
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Prune the change log once a day.
+const runnerSchedule = "@daily"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule memo change runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce deletes the changes older than the retention period, sync tokens issued before them have expired.
+func (r *Runner) RunOnce(ctx context.Context) {
+	createdBefore := time.Now().Add(-store.MemoChangeRetention).Unix()
+	if err := r.Store.DeleteMemoChange(ctx, &store.DeleteMemoChange{CreatedBefore: &createdBefore}); err != nil {
+		slog.Error("failed to prune memo changes", "err", err)
+	}
+}
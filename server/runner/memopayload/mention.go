@@ -0,0 +1,33 @@
+package memopayload
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/usememos/gomark/ast"
+
+	"github.com/usememos/memos/internal/base"
+)
+
+// mentionRegexp matches @username mentions that don't follow a word character, e.g. in an email address.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@/.])@([a-zA-Z0-9][a-zA-Z0-9-]*)`)
+
+// getMentions returns the usernames mentioned in the text node.
+// Function-like markers such as @remind(...) aren't mentions.
+func getMentions(node ast.Node) []string {
+	text, ok := node.(*ast.Text)
+	if !ok {
+		return nil
+	}
+	mentions := []string{}
+	for _, match := range mentionRegexp.FindAllStringSubmatchIndex(text.Content, -1) {
+		if strings.HasPrefix(text.Content[match[1]:], "(") {
+			continue
+		}
+		username := strings.TrimRight(text.Content[match[2]:match[3]], "-")
+		if base.UIDMatcher.MatchString(username) {
+			mentions = append(mentions, username)
+		}
+	}
+	return mentions
+}
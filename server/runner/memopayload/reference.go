@@ -0,0 +1,106 @@
+package memopayload
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// memoLinkPathRegexp matches the path of a link to a memo, e.g. https://memos.example.com/memos/{uid}.
+var memoLinkPathRegexp = regexp.MustCompile(`^/?memos/([a-zA-Z0-9_-]+)/?$`)
+
+// getMemoLinkReference returns the memo reference of the link, in the memos/{uid} format.
+func getMemoLinkReference(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	matches := memoLinkPathRegexp.FindStringSubmatch(u.Path)
+	if matches == nil {
+		return "", false
+	}
+	return "memos/" + matches[1], true
+}
+
+// getReferencedMemoUID returns the uid of the memo a reference points to.
+// References to other resources, e.g. embedded attachments, are skipped.
+func getReferencedMemoUID(reference string) (string, bool) {
+	uid, ok := strings.CutPrefix(reference, "memos/")
+	if !ok || uid == "" || strings.Contains(uid, "/") {
+		return "", false
+	}
+	return uid, true
+}
+
+// SyncMemoReferences syncs the memo references in the payload of the memo into REFERENCE relations.
+// Only relations of the references that were removed since previousReferences are deleted,
+// so relations set explicitly through SetMemoRelations are kept.
+func SyncMemoReferences(ctx context.Context, stores *store.Store, memo *store.Memo, previousReferences []string) error {
+	references := memo.Payload.GetProperty().GetReferences()
+	referenceType := store.MemoRelationReference
+	for _, reference := range previousReferences {
+		if slices.Contains(references, reference) {
+			continue
+		}
+		relatedMemo, err := getReferencedMemo(ctx, stores, reference)
+		if err != nil {
+			return err
+		}
+		if relatedMemo == nil {
+			continue
+		}
+		if err := stores.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relatedMemo.ID,
+			Type:          &referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+
+	relations, err := stores.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID, Type: &referenceType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, reference := range references {
+		relatedMemo, err := getReferencedMemo(ctx, stores, reference)
+		if err != nil {
+			return err
+		}
+		// Ignore dangling and reflexive references.
+		if relatedMemo == nil || relatedMemo.ID == memo.ID {
+			continue
+		}
+		if slices.ContainsFunc(relations, func(relation *store.MemoRelation) bool {
+			return relation.RelatedMemoID == relatedMemo.ID
+		}) {
+			continue
+		}
+		if _, err := stores.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemo.ID,
+			Type:          referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+	return nil
+}
+
+func getReferencedMemo(ctx context.Context, stores *store.Store, reference string) (*store.Memo, error) {
+	uid, ok := getReferencedMemoUID(reference)
+	if !ok {
+		return nil, nil
+	}
+	memo, err := stores.GetMemo(ctx, &store.FindMemo{UID: &uid, ExcludeContent: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get referenced memo")
+	}
+	return memo, nil
+}
@@ -0,0 +1,91 @@
+package memopayload
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/restore"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+var (
+	// remindRegexp matches inline reminders, e.g. @remind(2026-11-01 09:00) or @remind(2026-11-01).
+	remindRegexp = regexp.MustCompile(`@remind\((\d{4}-\d{2}-\d{2})(?:[ T](\d{2}:\d{2}))?\)`)
+	// dueDateRegexp matches the due date of a task, e.g. 📅 2026-11-01.
+	dueDateRegexp = regexp.MustCompile(`📅\s*(\d{4}-\d{2}-\d{2})(?:[ T](\d{2}:\d{2}))?`)
+)
+
+// defaultReminderTime is the time of day reminders without a time are due, in the server time zone.
+const defaultReminderTime = "09:00"
+
+// getReminders returns the reminders written in the text of the node.
+// Only incomplete tasks remind of their due dates.
+func getReminders(node ast.Node) []*storepb.MemoPayload_Reminder {
+	var children []ast.Node
+	dueDate := false
+	switch n := node.(type) {
+	case *ast.Paragraph:
+		children = n.Children
+	case *ast.Heading:
+		children = n.Children
+	case *ast.OrderedListItem:
+		children = n.Children
+	case *ast.UnorderedListItem:
+		children = n.Children
+	case *ast.TaskListItem:
+		children = n.Children
+		dueDate = !n.Complete
+	default:
+		return nil
+	}
+
+	text := restore.Restore(children)
+	matches := remindRegexp.FindAllStringSubmatch(text, -1)
+	content := remindRegexp.ReplaceAllString(text, "")
+	if dueDate {
+		matches = append(matches, dueDateRegexp.FindAllStringSubmatch(text, -1)...)
+		content = dueDateRegexp.ReplaceAllString(content, "")
+	}
+	content = strings.Join(strings.Fields(content), " ")
+
+	reminders := []*storepb.MemoPayload_Reminder{}
+	for _, match := range matches {
+		clock := match[2]
+		if clock == "" {
+			clock = defaultReminderTime
+		}
+		dueTime, err := time.ParseInLocation("2006-01-02 15:04", match[1]+" "+clock, time.Local)
+		if err != nil {
+			continue
+		}
+		reminders = append(reminders, &storepb.MemoPayload_Reminder{
+			DueTs:   dueTime.Unix(),
+			Content: content,
+		})
+	}
+	return reminders
+}
+
+// mergeReminders keeps the snooze and notification state of the previous reminders that are still in the content.
+func mergeReminders(reminders, previousReminders []*storepb.MemoPayload_Reminder) {
+	for _, reminder := range reminders {
+		for _, previous := range previousReminders {
+			if previous.DueTs == reminder.DueTs && previous.Content == reminder.Content {
+				reminder.SnoozeTs = previous.SnoozeTs
+				reminder.Notified = previous.Notified
+				break
+			}
+		}
+	}
+}
+
+// GetReminderRemindTs returns the time the reminder should be sent, which is the snooze time if it's snoozed.
+func GetReminderRemindTs(reminder *storepb.MemoPayload_Reminder) int64 {
+	if reminder.SnoozeTs > 0 {
+		return reminder.SnoozeTs
+	}
+	return reminder.DueTs
+}
@@ -24,7 +24,7 @@ func NewRunner(store *store.Store) *Runner {
 	}
 }
 
-// RunOnce rebuilds the payload of all memos.
+// RunOnce rebuilds the payload of all memos and syncs their references into relations.
 func (r *Runner) RunOnce(ctx context.Context) {
 	// Process memos in batches to avoid loading all memos into memory at once
 	const batchSize = 100
@@ -50,6 +50,7 @@ func (r *Runner) RunOnce(ctx context.Context) {
 		// Process batch
 		batchSuccessCount := 0
 		for _, memo := range memos {
+			previousReferences := memo.Payload.GetProperty().GetReferences()
 			if err := RebuildMemoPayload(memo); err != nil {
 				slog.Error("failed to rebuild memo payload", "err", err, "memoID", memo.ID)
 				continue
@@ -61,6 +62,10 @@ func (r *Runner) RunOnce(ctx context.Context) {
 				slog.Error("failed to update memo", "err", err, "memoID", memo.ID)
 				continue
 			}
+			if err := SyncMemoReferences(ctx, r.Store, memo, previousReferences); err != nil {
+				slog.Error("failed to sync memo references", "err", err, "memoID", memo.ID)
+				continue
+			}
 			batchSuccessCount++
 		}
 
@@ -83,15 +88,31 @@ func RebuildMemoPayload(memo *store.Memo) error {
 	}
 	tags := []string{}
 	property := &storepb.MemoPayload_Property{}
+	reminders := []*storepb.MemoPayload_Reminder{}
+	mentions := []string{}
 	TraverseASTNodes(nodes, func(node ast.Node) {
+		reminders = append(reminders, getReminders(node)...)
+		for _, mention := range getMentions(node) {
+			if !slices.Contains(mentions, mention) {
+				mentions = append(mentions, mention)
+			}
+		}
 		switch n := node.(type) {
 		case *ast.Tag:
 			tag := n.Content
 			if !slices.Contains(tags, tag) {
 				tags = append(tags, tag)
 			}
-		case *ast.Link, *ast.AutoLink:
+		case *ast.Link:
 			property.HasLink = true
+			if reference, ok := getMemoLinkReference(n.URL); ok && !slices.Contains(property.References, reference) {
+				property.References = append(property.References, reference)
+			}
+		case *ast.AutoLink:
+			property.HasLink = true
+			if reference, ok := getMemoLinkReference(n.URL); ok && !slices.Contains(property.References, reference) {
+				property.References = append(property.References, reference)
+			}
 		case *ast.TaskListItem:
 			property.HasTaskList = true
 			if !n.Complete {
@@ -101,11 +122,20 @@ func RebuildMemoPayload(memo *store.Memo) error {
 			property.HasCode = true
 		case *ast.EmbeddedContent:
 			// TODO: validate references.
-			property.References = append(property.References, n.ResourceName)
+			if !slices.Contains(property.References, n.ResourceName) {
+				property.References = append(property.References, n.ResourceName)
+			}
+		case *ast.ReferencedContent:
+			if !slices.Contains(property.References, n.ResourceName) {
+				property.References = append(property.References, n.ResourceName)
+			}
 		}
 	})
+	mergeReminders(reminders, memo.Payload.Reminders)
 	memo.Payload.Tags = tags
 	memo.Payload.Property = property
+	memo.Payload.Reminders = reminders
+	memo.Payload.Mentions = mentions
 	return nil
 }
 
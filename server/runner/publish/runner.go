@@ -0,0 +1,61 @@
+package publish
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+// MemoPublisher makes a scheduled memo public the same way the API updates memos.
+type MemoPublisher interface {
+	PublishMemo(ctx context.Context, memo *store.Memo) error
+}
+
+type Runner struct {
+	Store         *store.Store
+	MemoPublisher MemoPublisher
+}
+
+func NewRunner(store *store.Store, memoPublisher MemoPublisher) *Runner {
+	return &Runner{
+		Store:         store,
+		MemoPublisher: memoPublisher,
+	}
+}
+
+// Check for memos due to be published at the start of every minute.
+const runnerSchedule = "* * * * *"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule publish runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce publishes the scheduled memos whose publish time has passed.
+func (r *Runner) RunOnce(ctx context.Context) {
+	now := time.Now().Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		Scheduled:     true,
+		PublishBefore: &now,
+	})
+	if err != nil {
+		slog.Error("failed to list scheduled memos", "err", err)
+		return
+	}
+	for _, memo := range memos {
+		if err := r.MemoPublisher.PublishMemo(ctx, memo); err != nil {
+			slog.Error("failed to publish memo", "err", err, "memoID", memo.ID)
+		}
+	}
+}
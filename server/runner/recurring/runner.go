@@ -0,0 +1,110 @@
+package recurring
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+// MemoCreator creates the memo of a recurring memo run the same way the API creates memos.
+type MemoCreator interface {
+	RunRecurringMemo(ctx context.Context, recurringMemo *store.RecurringMemo, runTime time.Time) error
+}
+
+type Runner struct {
+	Store       *store.Store
+	MemoCreator MemoCreator
+}
+
+func NewRunner(store *store.Store, memoCreator MemoCreator) *Runner {
+	return &Runner{
+		Store:       store,
+		MemoCreator: memoCreator,
+	}
+}
+
+// Check for due recurring memos at the start of every minute.
+const runnerSchedule = "* * * * *"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule recurring memo runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce runs the recurring memos that are due.
+func (r *Runner) RunOnce(ctx context.Context) {
+	normalStatus := store.Normal
+	recurringMemos, err := r.Store.ListRecurringMemos(ctx, &store.FindRecurringMemo{
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		slog.Error("failed to list recurring memos", "err", err)
+		return
+	}
+	now := time.Now()
+	for _, recurringMemo := range recurringMemos {
+		if err := r.runRecurringMemo(ctx, recurringMemo, now); err != nil {
+			slog.Error("failed to run recurring memo", "err", err, "recurringMemoID", recurringMemo.ID)
+		}
+	}
+}
+
+// runRecurringMemo creates the memo of the latest scheduled run if it's due.
+// Runs missed while the server was down are made up only once, the ones before it are counted as missed.
+func (r *Runner) runRecurringMemo(ctx context.Context, recurringMemo *store.RecurringMemo, now time.Time) error {
+	schedule, err := cron.ParseStandard(recurringMemo.Schedule)
+	if err != nil {
+		return errors.Wrap(err, "invalid schedule")
+	}
+	runTime := schedule.Next(getScheduleStartTime(recurringMemo))
+	if runTime.IsZero() || runTime.After(now) {
+		return nil
+	}
+	missedRuns := recurringMemo.MissedRuns
+	for next := schedule.Next(runTime); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		runTime = next
+		missedRuns++
+	}
+
+	if err := r.MemoCreator.RunRecurringMemo(ctx, recurringMemo, runTime); err != nil {
+		slog.Error("failed to create recurring memo", "err", err, "recurringMemoID", recurringMemo.ID)
+		missedRuns++
+	}
+	lastRunTs := runTime.Unix()
+	if _, err := r.Store.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:         recurringMemo.ID,
+		LastRunTs:  &lastRunTs,
+		MissedRuns: &missedRuns,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update recurring memo")
+	}
+	return nil
+}
+
+// GetNextRunTime returns the next scheduled run of the recurring memo.
+func GetNextRunTime(recurringMemo *store.RecurringMemo) (time.Time, error) {
+	schedule, err := cron.ParseStandard(recurringMemo.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(getScheduleStartTime(recurringMemo)), nil
+}
+
+// getScheduleStartTime returns the time the schedule runs from: the last run, or the last update
+// so that resuming or rescheduling a recurring memo doesn't make up for the runs before it.
+func getScheduleStartTime(recurringMemo *store.RecurringMemo) time.Time {
+	return time.Unix(max(recurringMemo.LastRunTs, recurringMemo.UpdatedTs), 0)
+}
@@ -0,0 +1,127 @@
+package reminder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/notification"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store                  *store.Store
+	NotificationDispatcher *notification.Dispatcher
+}
+
+func NewRunner(store *store.Store, notificationDispatcher *notification.Dispatcher) *Runner {
+	return &Runner{
+		Store:                  store,
+		NotificationDispatcher: notificationDispatcher,
+	}
+}
+
+// Check for due reminders at the start of every minute.
+const runnerSchedule = "* * * * *"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule reminder runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce sends the reminders that came due to the memo creators.
+func (r *Runner) RunOnce(ctx context.Context) {
+	normalStatus := store.Normal
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:      &normalStatus,
+		HasReminders:   true,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		slog.Error("failed to list memos with reminders", "err", err)
+		return
+	}
+	now := time.Now().Unix()
+	for _, memo := range memos {
+		if err := r.sendDueReminders(ctx, memo, now); err != nil {
+			slog.Error("failed to send memo reminders", "err", err, "memoID", memo.ID)
+		}
+	}
+}
+
+// sendDueReminders sends the due reminders of the memo and marks them as notified.
+func (r *Runner) sendDueReminders(ctx context.Context, memo *store.Memo, now int64) error {
+	sent := false
+	var err error
+	for _, reminder := range memo.Payload.Reminders {
+		if reminder.Notified || memopayload.GetReminderRemindTs(reminder) > now {
+			continue
+		}
+		if err = r.sendReminder(ctx, memo, reminder); err != nil {
+			break
+		}
+		reminder.Notified = true
+		sent = true
+	}
+	// The reminders sent so far are marked either way, so they're not sent twice.
+	if sent {
+		if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Payload: memo.Payload,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update memo payload")
+		}
+	}
+	return err
+}
+
+func (r *Runner) sendReminder(ctx context.Context, memo *store.Memo, reminder *storepb.MemoPayload_Reminder) error {
+	return r.NotificationDispatcher.Dispatch(ctx, &notification.Notification{
+		Type:       storepb.InboxMessage_REMINDER,
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		MemoID:     memo.ID,
+		Content:    reminder.Content,
+	}, func() error {
+		activity, err := r.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeMemoReminder,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoReminder: &storepb.ActivityMemoReminderPayload{
+					MemoId:  memo.ID,
+					DueTs:   reminder.DueTs,
+					Content: reminder.Content,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		if _, err := r.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: memo.CreatorID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_REMINDER,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		return nil
+	})
+}
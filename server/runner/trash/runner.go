@@ -0,0 +1,158 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Purge expired memos from the trash at the start of every hour.
+const runnerSchedule = "@hourly"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	if _, err := c.AddFunc(runnerSchedule, func() {
+		r.RunOnce(ctx)
+	}); err != nil {
+		slog.Error("failed to schedule trash runner", "err", err)
+		return
+	}
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunOnce permanently deletes memos that have been in the trash longer than the retention period.
+func (r *Runner) RunOnce(ctx context.Context) {
+	workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace memo related setting", "err", err)
+		return
+	}
+	retention := time.Duration(workspaceMemoRelatedSetting.TrashRetentionDays) * 24 * time.Hour
+	trashedBefore := time.Now().Add(-retention).Unix()
+
+	const batchSize = 100
+	// Memos that fail to be purged stay first in the list, so they are skipped with the offset and retried on the next run.
+	failed := 0
+	for {
+		limit := batchSize
+		offset := failed
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			InTrash:        true,
+			TrashedBefore:  &trashedBefore,
+			ExcludeContent: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			slog.Error("failed to list expired memos in trash", "err", err)
+			return
+		}
+		if len(memos) == 0 {
+			return
+		}
+		purged := 0
+		for _, memo := range memos {
+			if err := PurgeMemo(ctx, r.Store, memo); err != nil {
+				slog.Error("failed to purge memo", "err", err, "memoID", memo.ID)
+				failed++
+				continue
+			}
+			purged++
+		}
+		slog.Info("Purged expired memos from trash", "count", purged)
+	}
+}
+
+// PurgeMemo permanently deletes a memo along with its comments, relations, reactions, attachments, revisions and share links.
+func PurgeMemo(ctx context.Context, s *store.Store, memo *store.Memo) error {
+	// Delete memo comments, including the ones in the trash.
+	commentType := store.MemoRelationComment
+	relations, err := s.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	for _, relation := range relations {
+		comment, err := s.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID, ExcludeContent: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo comment")
+		}
+		if comment == nil {
+			comment, err = s.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID, ExcludeContent: true, InTrash: true})
+			if err != nil {
+				return errors.Wrap(err, "failed to get memo comment")
+			}
+		}
+		if comment == nil {
+			continue
+		}
+		if err := PurgeMemo(ctx, s, comment); err != nil {
+			return errors.Wrap(err, "failed to purge memo comment")
+		}
+	}
+
+	// Delete memo relations in both directions.
+	if err := s.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo relations")
+	}
+	if err := s.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo references")
+	}
+
+	// Delete memo reactions.
+	contentID := fmt.Sprintf("memos/%s", memo.UID)
+	reactions, err := s.ListReactions(ctx, &store.FindReaction{ContentID: &contentID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		if err := s.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete reaction")
+		}
+	}
+
+	// Delete related attachments.
+	attachments, err := s.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	for _, attachment := range attachments {
+		if err := s.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete attachment")
+		}
+	}
+
+	if err := s.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo revisions")
+	}
+	if err := s.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo shares")
+	}
+	if err := s.DeleteMemoGrant(ctx, &store.DeleteMemoGrant{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo grants")
+	}
+	if err := s.DeleteMemoGroup(ctx, &store.DeleteMemoGroup{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo groups")
+	}
+	if err := s.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo")
+	}
+	return nil
+}
@@ -21,11 +21,18 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/ingest"
 	"github.com/usememos/memos/server/profiler"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/digest"
+	"github.com/usememos/memos/server/runner/memochange"
+	"github.com/usememos/memos/server/runner/publish"
+	"github.com/usememos/memos/server/runner/recurring"
+	"github.com/usememos/memos/server/runner/reminder"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/trash"
 	"github.com/usememos/memos/store"
 )
 
@@ -38,6 +45,8 @@ type Server struct {
 	grpcServer        *grpc.Server
 	profiler          *profiler.Profiler
 	runnerCancelFuncs []context.CancelFunc
+	apiV1Service      *apiv1.APIV1Service
+	ingestService     *ingest.Service
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -98,6 +107,7 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	s.grpcServer = grpcServer
 
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	s.apiV1Service = apiV1Service
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -159,6 +169,23 @@ func (s *Server) Start(ctx context.Context) error {
 			slog.Error("mux server listen error", "error", err)
 		}
 	}()
+	// Create memos from emails if the SMTP listener is enabled.
+	if s.Profile.SMTPAddr != "" {
+		smtpListener, err := net.Listen("tcp", s.Profile.SMTPAddr)
+		if err != nil {
+			return errors.Wrap(err, "failed to listen for SMTP")
+		}
+		s.ingestService, err = ingest.NewService(s.Profile, s.Store, s.apiV1Service)
+		if err != nil {
+			smtpListener.Close()
+			return errors.Wrap(err, "failed to create email ingest service")
+		}
+		go func() {
+			if err := s.ingestService.Serve(ctx, smtpListener); err != nil {
+				slog.Error("failed to serve SMTP", "error", err)
+			}
+		}()
+	}
 	s.StartBackgroundRunners(ctx)
 
 	return nil
@@ -185,6 +212,13 @@ func (s *Server) Shutdown(ctx context.Context) {
 	// Shutdown gRPC server.
 	s.grpcServer.GracefulStop()
 
+	// Stop receiving emails.
+	if s.ingestService != nil {
+		if err := s.ingestService.Close(); err != nil {
+			slog.Error("failed to shutdown SMTP listener", slog.String("error", err.Error()))
+		}
+	}
+
 	// Stop the profiler
 	if s.profiler != nil {
 		slog.Info("stopping profiler")
@@ -225,6 +259,81 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 		slog.Info("s3presign runner stopped")
 	}()
 
+	trashContext, trashCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, trashCancel)
+
+	// Purge expired memos from the trash on startup, then on schedule.
+	trashRunner := trash.NewRunner(s.Store)
+	trashRunner.RunOnce(ctx)
+	go func() {
+		trashRunner.Run(trashContext)
+		slog.Info("trash runner stopped")
+	}()
+
+	memoChangeContext, memoChangeCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoChangeCancel)
+
+	// Prune the memo change log on startup, then on schedule.
+	memoChangeRunner := memochange.NewRunner(s.Store)
+	memoChangeRunner.RunOnce(ctx)
+	go func() {
+		memoChangeRunner.Run(memoChangeContext)
+		slog.Info("memo change runner stopped")
+	}()
+
+	recurringContext, recurringCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, recurringCancel)
+
+	// Make up for recurring memos missed while the server was down, then check every minute.
+	recurringRunner := recurring.NewRunner(s.Store, s.apiV1Service)
+	recurringRunner.RunOnce(ctx)
+	go func() {
+		recurringRunner.Run(recurringContext)
+		slog.Info("recurring memo runner stopped")
+	}()
+
+	publishContext, publishCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, publishCancel)
+
+	// Publish memos that came due while the server was down, then check every minute.
+	publishRunner := publish.NewRunner(s.Store, s.apiV1Service)
+	publishRunner.RunOnce(ctx)
+	go func() {
+		publishRunner.Run(publishContext)
+		slog.Info("publish runner stopped")
+	}()
+
+	reminderContext, reminderCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, reminderCancel)
+
+	// Send reminders that came due while the server was down, then check every minute.
+	reminderRunner := reminder.NewRunner(s.Store, s.apiV1Service.NotificationDispatcher)
+	reminderRunner.RunOnce(ctx)
+	go func() {
+		reminderRunner.Run(reminderContext)
+		slog.Info("reminder runner stopped")
+	}()
+
+	notificationContext, notificationCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, notificationCancel)
+
+	// Deliver email and push notifications in the background.
+	go func() {
+		s.apiV1Service.NotificationDispatcher.Run(notificationContext)
+		slog.Info("notification dispatcher stopped")
+	}()
+
+	digestContext, digestCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, digestCancel)
+
+	// Send digests that came due while the server was down, then send them every morning.
+	digestRunner := digest.NewRunner(s.Store, s.Profile.InstanceURL)
+	digestRunner.RunOnce(ctx)
+	go func() {
+		digestRunner.Run(digestContext)
+		slog.Info("digest runner stopped")
+	}()
+
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
 }
@@ -9,7 +9,12 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment   ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoGrant     ActivityType = "MEMO_GRANT"
+	ActivityTypeMemoReminder  ActivityType = "MEMO_REMINDER"
+	ActivityTypeMemoMention   ActivityType = "MEMO_MENTION"
+	ActivityTypeMemoReaction  ActivityType = "MEMO_REACTION"
+	ActivityTypeMemoReference ActivityType = "MEMO_REFERENCE"
 )
 
 func (t ActivityType) String() string {
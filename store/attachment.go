@@ -52,6 +52,7 @@ type FindAttachment struct {
 	StorageType    *storepb.AttachmentStorageType
 	Limit          *int
 	Offset         *int
+	Cursor         *Cursor
 	Filters        []string
 }
 
@@ -74,7 +75,18 @@ func (s *Store) CreateAttachment(ctx context.Context, create *Attachment) (*Atta
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateAttachment(ctx, create)
+	var attachment *Attachment
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		attachment, err = s.driver.CreateAttachment(ctx, create)
+		if err != nil {
+			return err
+		}
+		return s.recordAttachmentChange(ctx, attachment.MemoID, attachment.UID)
+	}); err != nil {
+		return nil, err
+	}
+	return attachment, nil
 }
 
 func (s *Store) ListAttachments(ctx context.Context, find *FindAttachment) ([]*Attachment, error) {
@@ -109,7 +121,44 @@ func (s *Store) UpdateAttachment(ctx context.Context, update *UpdateAttachment)
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateAttachment(ctx, update)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		attachment, err := s.GetAttachment(ctx, &FindAttachment{ID: &update.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get attachment")
+		}
+		if err := s.driver.UpdateAttachment(ctx, update); err != nil {
+			return err
+		}
+		if attachment == nil {
+			return nil
+		}
+		if err := s.recordAttachmentChange(ctx, attachment.MemoID, attachment.UID); err != nil {
+			return err
+		}
+		// Moving the attachment to another memo or renaming it also changes the new memo.
+		if update.MemoID != nil || update.UID != nil {
+			memoID, uid := attachment.MemoID, attachment.UID
+			if update.MemoID != nil {
+				memoID = update.MemoID
+			}
+			if update.UID != nil {
+				uid = *update.UID
+			}
+			return s.recordAttachmentChange(ctx, memoID, uid)
+		}
+		return nil
+	})
+}
+
+func (s *Store) recordAttachmentChange(ctx context.Context, memoID *int32, attachmentUID string) error {
+	if memoID == nil {
+		return nil
+	}
+	memo, err := s.getMemoForChange(ctx, &FindMemo{ID: memoID})
+	if err != nil || memo == nil {
+		return err
+	}
+	return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeAttachment, attachmentUID)
 }
 
 func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment) error {
@@ -166,5 +215,10 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		}
 	}
 
-	return s.driver.DeleteAttachment(ctx, delete)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		if err := s.driver.DeleteAttachment(ctx, delete); err != nil {
+			return err
+		}
+		return s.recordAttachmentChange(ctx, attachment.MemoID, attachment.UID)
+	})
 }
@@ -22,3 +22,11 @@ const (
 func (r RowStatus) String() string {
 	return string(r)
 }
+
+// Cursor is the position of the last item of a page, used for keyset pagination.
+type Cursor struct {
+	// Timestamp is the value of the column the list is sorted by.
+	Timestamp int64
+	// ID breaks ties between items with the same timestamp.
+	ID int32
+}
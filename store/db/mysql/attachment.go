@@ -32,7 +32,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
 
 	stmt := "INSERT INTO `resource` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +93,10 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	if find.StorageType != nil {
 		where, args = append(where, "`resource`.`storage_type` = ?"), append(args, find.StorageType.String())
 	}
+	if v := find.Cursor; v != nil {
+		where = append(where, "(UNIX_TIMESTAMP(`resource`.`updated_ts`) < ? OR (UNIX_TIMESTAMP(`resource`.`updated_ts`) = ? AND `resource`.`id` < ?))")
+		args = append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
 
 	fields := []string{
 		"`resource`.`id` AS `id`",
@@ -116,7 +120,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	query := "SELECT " + strings.Join(fields, ", ") + " FROM `resource`" + " " +
 		"LEFT JOIN `memo` ON `resource`.`memo_id` = `memo`.`id`" + " " +
 		"WHERE " + strings.Join(where, " AND ") + " " +
-		"ORDER BY `updated_ts` DESC"
+		"ORDER BY `updated_ts` DESC, `resource`.`id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
@@ -124,7 +128,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +221,7 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 
 	args = append(args, update.ID)
 	stmt := "UPDATE `resource` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -229,7 +233,7 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 
 func (d *DB) DeleteAttachment(ctx context.Context, delete *store.DeleteAttachment) error {
 	stmt := "DELETE FROM `resource` WHERE `id` = ?"
-	result, err := d.db.ExecContext(ctx, stmt, delete.ID)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, delete.ID)
 	if err != nil {
 		return err
 	}
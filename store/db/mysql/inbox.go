@@ -2,12 +2,14 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -27,7 +29,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
 
 	stmt := "INSERT INTO `inbox` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -46,29 +48,19 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 }
 
 func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.Inbox, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	if find.ID != nil {
-		where, args = append(where, "`id` = ?"), append(args, *find.ID)
-	}
-	if find.SenderID != nil {
-		where, args = append(where, "`sender_id` = ?"), append(args, *find.SenderID)
-	}
-	if find.ReceiverID != nil {
-		where, args = append(where, "`receiver_id` = ?"), append(args, *find.ReceiverID)
-	}
-	if find.Status != nil {
-		where, args = append(where, "`status` = ?"), append(args, *find.Status)
+	where, args, err := buildInboxWhere(find)
+	if err != nil {
+		return nil, err
 	}
 
-	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
 			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,11 +107,72 @@ func (d *DB) GetInbox(ctx context.Context, find *store.FindInbox) (*store.Inbox,
 	return list[0], nil
 }
 
+func buildInboxWhere(find *store.FindInbox) ([]string, []any, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	for _, filterStr := range find.Filters {
+		// Parse filter string and return the parsed expression.
+		// The filter string should be a CEL expression.
+		parsedExpr, err := filter.Parse(filterStr, filter.InboxFilterCELAttributes...)
+		if err != nil {
+			return nil, nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		// ConvertExprToSQL converts the parsed expression to a SQL condition string.
+		converter := filter.NewCommonSQLConverter(&filter.MySQLDialect{})
+		if err := converter.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, nil, err
+		}
+		condition := convertCtx.Buffer.String()
+		if condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.SenderID != nil {
+		where, args = append(where, "`sender_id` = ?"), append(args, *find.SenderID)
+	}
+	if find.ReceiverID != nil {
+		where, args = append(where, "`receiver_id` = ?"), append(args, *find.ReceiverID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, *find.Status)
+	}
+	if find.MessageType != nil {
+		where, args = append(where, (&filter.MySQLDialect{}).GetInboxMessageType()+" = ?"), append(args, find.MessageType.String())
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "CAST(JSON_EXTRACT(`message`, '$.memoId') AS SIGNED) = ?"), append(args, *find.MemoID)
+	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "(UNIX_TIMESTAMP(`created_ts`) < ? OR (UNIX_TIMESTAMP(`created_ts`) = ? AND `id` < ?))"), append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
+
+	return where, args, nil
+}
+
 func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store.Inbox, error) {
 	set, args := []string{"`status` = ?"}, []any{update.Status.String()}
+	if v := update.CreatedTs; v != nil {
+		set, args = append(set, "`created_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.SenderID; v != nil {
+		set, args = append(set, "`sender_id` = ?"), append(args, *v)
+	}
+	if v := update.Message; v != nil {
+		bytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal inbox message")
+		}
+		set, args = append(set, "`message` = ?"), append(args, string(bytes))
+	}
 	args = append(args, update.ID)
 	query := "UPDATE `inbox` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, query, args...); err != nil {
 		return nil, errors.Wrap(err, "failed to update inbox")
 	}
 	inbox, err := d.GetInbox(ctx, &store.FindInbox{ID: &update.ID})
@@ -129,8 +182,50 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 	return inbox, nil
 }
 
+func (d *DB) UpdateInboxes(ctx context.Context, update *store.UpdateInboxes) (int64, error) {
+	where, args, err := buildInboxWhere(update.Find)
+	if err != nil {
+		return 0, err
+	}
+	args = append([]any{update.Status.String()}, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, "UPDATE `inbox` SET `status` = ? WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *DB) ListInboxStats(ctx context.Context, find *store.FindInbox) ([]*store.InboxStats, error) {
+	where, args, err := buildInboxWhere(find)
+	if err != nil {
+		return nil, err
+	}
+	messageTypeField := (&filter.MySQLDialect{}).GetInboxMessageType()
+	query := "SELECT `status`, " + messageTypeField + ", COUNT(*) FROM `inbox` WHERE " + strings.Join(where, " AND ") + " GROUP BY `status`, " + messageTypeField
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.InboxStats{}
+	for rows.Next() {
+		stats := &store.InboxStats{}
+		var messageType sql.NullString
+		if err := rows.Scan(&stats.Status, &messageType, &stats.Count); err != nil {
+			return nil, err
+		}
+		stats.Type = storepb.InboxMessage_Type(storepb.InboxMessage_Type_value[messageType.String])
+		list = append(list, stats)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete inbox")
 	}
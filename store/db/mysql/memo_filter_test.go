@@ -145,6 +145,56 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') = CAST('true' AS JSON)",
 			args:   []any{},
 		},
+		{
+			filter: `search("hello world")`,
+			want:   "MATCH(`memo`.`content`) AGAINST (? IN NATURAL LANGUAGE MODE)",
+			args:   []any{"hello world"},
+		},
+		{
+			filter: `tag in_tree "work"`,
+			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?, '!') IS NOT NULL)",
+			args:   []any{`"work"`, "work/%"},
+		},
+		{
+			filter: `tag in_tree "to_do"`,
+			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?, '!') IS NOT NULL)",
+			args:   []any{`"to_do"`, "to!_do/%"},
+		},
+		{
+			filter: `tag in_tree "say \"hi\"\\"`,
+			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?, '!') IS NOT NULL)",
+			args:   []any{`"say \"hi\"\\"`, `say "hi"\/%`},
+		},
+		{
+			filter: `creator_id == 1 || granted_to(2)`,
+			want:   "(`memo`.`creator_id` = ? OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_grant` WHERE `user_id` = ?))",
+			args:   []any{int64(1), int64(2)},
+		},
+		{
+			filter: `in_groups_of(3)`,
+			want:   "(`memo`.`visibility` = 'GROUP' AND `memo`.`id` IN (SELECT `memo_group`.`memo_id` FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `user_group_member`.`user_id` = ?))",
+			args:   []any{int64(3)},
+		},
+		{
+			filter: `has_relation("blocks")`,
+			want:   "`memo`.`id` IN (SELECT `memo_id` FROM `memo_relation` WHERE `type` = ?)",
+			args:   []any{"blocks"},
+		},
+		{
+			filter: `mentions.exists(m, m == "alice")`,
+			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.mentions'), ?)",
+			args:   []any{`"alice"`},
+		},
+		{
+			filter: `"alice" in mentions`,
+			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.mentions'), ?)",
+			args:   []any{`"alice"`},
+		},
+		{
+			filter: `"a\"b" in mentions`,
+			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.mentions'), ?)",
+			args:   []any{`"a\"b"`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -8,7 +8,7 @@ import (
 
 func (d *DB) FindMigrationHistoryList(ctx context.Context, _ *store.FindMigrationHistory) ([]*store.MigrationHistory, error) {
 	query := "SELECT `version`, UNIX_TIMESTAMP(`created_ts`) FROM `migration_history` ORDER BY `created_ts` DESC"
-	rows, err := d.db.QueryContext(ctx, query)
+	rows, err := d.conn(ctx).QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -36,14 +36,14 @@ func (d *DB) FindMigrationHistoryList(ctx context.Context, _ *store.FindMigratio
 
 func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
 	stmt := "INSERT INTO `migration_history` (`version`) VALUES (?) ON DUPLICATE KEY UPDATE `version` = ?"
-	_, err := d.db.ExecContext(ctx, stmt, upsert.Version, upsert.Version)
+	_, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.Version, upsert.Version)
 	if err != nil {
 		return nil, err
 	}
 
 	var migrationHistory store.MigrationHistory
 	stmt = "SELECT `version`, UNIX_TIMESTAMP(`created_ts`) FROM `migration_history` WHERE `version` = ?"
-	if err := d.db.QueryRowContext(ctx, stmt, upsert.Version).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, upsert.Version).Scan(
 		&migrationHistory.Version,
 		&migrationHistory.CreatedTs,
 	); err != nil {
@@ -16,7 +16,7 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	placeholder := []string{"?", "?", "?"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO `reaction` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 		where, args = append(where, "`content_id` = ?"), append(args, *find.ContentID)
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn(ctx).QueryContext(ctx, `
 		SELECT
 			id,
 			UNIX_TIMESTAMP(created_ts) AS created_ts,
@@ -122,6 +122,6 @@ func (d *DB) GetReaction(ctx context.Context, find *store.FindReaction) (*store.
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
+	_, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
 	return err
 }
@@ -10,7 +10,7 @@ import (
 
 func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (*store.UserSetting, error) {
 	stmt := "INSERT INTO `user_setting` (`user_id`, `key`, `value`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `value` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value, upsert.Value); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value, upsert.Value); err != nil {
 		return nil, err
 	}
 	return upsert, nil
@@ -25,9 +25,15 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
 	}
+	if v := find.EmailIngestToken; v != nil {
+		where, args = append(where, "`key` = ?", "JSON_UNQUOTE(JSON_EXTRACT(`value`, '$.token')) = ?"), append(args, storepb.UserSetting_EMAIL_INGEST.String(), *v)
+	}
 
 	query := "SELECT `user_id`, `key`, `value` FROM `user_setting` WHERE " + strings.Join(where, " AND ")
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	if find.ForUpdate {
+		query += " FOR UPDATE"
+	}
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +31,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
 
 	stmt := "INSERT INTO resource (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
 		return nil, err
 	}
 	return create, nil
@@ -84,6 +84,10 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	if v := find.StorageType; v != nil {
 		where, args = append(where, "resource.storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
+	if v := find.Cursor; v != nil {
+		where = append(where, fmt.Sprintf("(resource.updated_ts < %s OR (resource.updated_ts = %s AND resource.id < %s))", placeholder(len(args)+1), placeholder(len(args)+2), placeholder(len(args)+3)))
+		args = append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
 
 	fields := []string{
 		"resource.id AS id",
@@ -110,7 +114,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		FROM resource
 		LEFT JOIN memo ON resource.memo_id = memo.id
 		WHERE %s
-		ORDER BY resource.updated_ts DESC
+		ORDER BY resource.updated_ts DESC, resource.id DESC
 	`, strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -119,7 +123,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +204,7 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 
 	stmt := `UPDATE resource SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -212,7 +216,7 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 
 func (d *DB) DeleteAttachment(ctx context.Context, delete *store.DeleteAttachment) error {
 	stmt := `DELETE FROM resource WHERE id = $1`
-	result, err := d.db.ExecContext(ctx, stmt, delete.ID)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, delete.ID)
 	if err != nil {
 		return err
 	}
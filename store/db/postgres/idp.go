@@ -12,7 +12,7 @@ func (d *DB) CreateIdentityProvider(ctx context.Context, create *store.IdentityP
 	fields := []string{"name", "type", "identifier_filter", "config"}
 	args := []any{create.Name, create.Type.String(), create.IdentifierFilter, create.Config}
 	stmt := "INSERT INTO idp (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
 		return nil, err
 	}
 
@@ -26,7 +26,7 @@ func (d *DB) ListIdentityProviders(ctx context.Context, find *store.FindIdentity
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn(ctx).QueryContext(ctx, `
 		SELECT
 			id,
 			name,
@@ -89,7 +89,7 @@ func (d *DB) UpdateIdentityProvider(ctx context.Context, update *store.UpdateIde
 
 	var identityProvider store.IdentityProvider
 	var typeString string
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
 		&identityProvider.ID,
 		&identityProvider.Name,
 		&typeString,
@@ -106,7 +106,7 @@ func (d *DB) UpdateIdentityProvider(ctx context.Context, update *store.UpdateIde
 func (d *DB) DeleteIdentityProvider(ctx context.Context, delete *store.DeleteIdentityProvider) error {
 	where, args := []string{"id = $1"}, []any{delete.ID}
 	stmt := `DELETE FROM idp WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
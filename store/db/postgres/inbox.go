@@ -2,12 +2,14 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -25,7 +27,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	fields := []string{"sender_id", "receiver_id", "status", "message"}
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
 	stmt := "INSERT INTO inbox (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -36,29 +38,19 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 }
 
 func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.Inbox, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	if find.ID != nil {
-		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
-	}
-	if find.SenderID != nil {
-		where, args = append(where, "sender_id = "+placeholder(len(args)+1)), append(args, *find.SenderID)
-	}
-	if find.ReceiverID != nil {
-		where, args = append(where, "receiver_id = "+placeholder(len(args)+1)), append(args, *find.ReceiverID)
-	}
-	if find.Status != nil {
-		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
+	where, args, err := buildInboxWhere(find)
+	if err != nil {
+		return nil, err
 	}
 
-	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
+	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
 			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -105,13 +97,75 @@ func (d *DB) GetInbox(ctx context.Context, find *store.FindInbox) (*store.Inbox,
 	return list[0], nil
 }
 
+func buildInboxWhere(find *store.FindInbox) ([]string, []any, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	for _, filterStr := range find.Filters {
+		// Parse filter string and return the parsed expression.
+		// The filter string should be a CEL expression.
+		parsedExpr, err := filter.Parse(filterStr, filter.InboxFilterCELAttributes...)
+		if err != nil {
+			return nil, nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		// ConvertExprToSQL converts the parsed expression to a SQL condition string.
+		converter := filter.NewCommonSQLConverterWithOffset(&filter.PostgreSQLDialect{}, len(args))
+		if err := converter.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, nil, err
+		}
+		condition := convertCtx.Buffer.String()
+		if condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.SenderID != nil {
+		where, args = append(where, "sender_id = "+placeholder(len(args)+1)), append(args, *find.SenderID)
+	}
+	if find.ReceiverID != nil {
+		where, args = append(where, "receiver_id = "+placeholder(len(args)+1)), append(args, *find.ReceiverID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
+	}
+	if find.MessageType != nil {
+		where, args = append(where, (&filter.PostgreSQLDialect{}).GetInboxMessageType()+" = "+placeholder(len(args)+1)), append(args, find.MessageType.String())
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "(message::jsonb->>'memoId')::INTEGER = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if v := find.Cursor; v != nil {
+		where = append(where, fmt.Sprintf("(created_ts < %s OR (created_ts = %s AND id < %s))", placeholder(len(args)+1), placeholder(len(args)+2), placeholder(len(args)+3)))
+		args = append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
+
+	return where, args, nil
+}
+
 func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store.Inbox, error) {
 	set, args := []string{"status = $1"}, []any{update.Status.String()}
+	if v := update.CreatedTs; v != nil {
+		set, args = append(set, "created_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.SenderID; v != nil {
+		set, args = append(set, "sender_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Message; v != nil {
+		bytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal inbox message")
+		}
+		set, args = append(set, "message = "+placeholder(len(args)+1)), append(args, string(bytes))
+	}
 	args = append(args, update.ID)
-	query := "UPDATE inbox SET " + strings.Join(set, ", ") + " WHERE id = $2 RETURNING id, created_ts, sender_id, receiver_id, status, message"
+	query := "UPDATE inbox SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)) + " RETURNING id, created_ts, sender_id, receiver_id, status, message"
 	inbox := &store.Inbox{}
 	var messageBytes []byte
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, query, args...).Scan(
 		&inbox.ID,
 		&inbox.CreatedTs,
 		&inbox.SenderID,
@@ -129,8 +183,50 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 	return inbox, nil
 }
 
+func (d *DB) UpdateInboxes(ctx context.Context, update *store.UpdateInboxes) (int64, error) {
+	where, args, err := buildInboxWhere(update.Find)
+	if err != nil {
+		return 0, err
+	}
+	args = append(args, update.Status.String())
+	result, err := d.conn(ctx).ExecContext(ctx, "UPDATE inbox SET status = "+placeholder(len(args))+" WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *DB) ListInboxStats(ctx context.Context, find *store.FindInbox) ([]*store.InboxStats, error) {
+	where, args, err := buildInboxWhere(find)
+	if err != nil {
+		return nil, err
+	}
+	messageTypeField := (&filter.PostgreSQLDialect{}).GetInboxMessageType()
+	query := "SELECT status, " + messageTypeField + ", COUNT(*) FROM inbox WHERE " + strings.Join(where, " AND ") + " GROUP BY status, " + messageTypeField
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.InboxStats{}
+	for rows.Next() {
+		stats := &store.InboxStats{}
+		var messageType sql.NullString
+		if err := rows.Scan(&stats.Status, &messageType, &stats.Count); err != nil {
+			return nil, err
+		}
+		stats.Type = storepb.InboxMessage_Type(storepb.InboxMessage_Type_value[messageType.String])
+		list = append(list, stats)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM inbox WHERE id = $1", delete.ID)
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM inbox WHERE id = $1", delete.ID)
 	if err != nil {
 		return err
 	}
@@ -34,9 +34,13 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		fields = append(fields, "updated_ts")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.PublishTs != 0 {
+		fields = append(fields, "publish_ts")
+		args = append(args, create.PublishTs)
+	}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -51,6 +55,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
+	searchQueries := []string{}
 	for _, filterStr := range find.Filters {
 		// Parse filter string and return the parsed expression.
 		// The filter string should be a CEL expression.
@@ -59,6 +64,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			return nil, err
 		}
 		convertCtx := filter.NewConvertContext()
+		convertCtx.TagAliases = find.TagAliases
 		convertCtx.ArgsOffset = len(args)
 		// ConvertExprToSQL converts the parsed expression to a SQL condition string.
 		converter := filter.NewCommonSQLConverterWithOffset(&filter.PostgreSQLDialect{}, convertCtx.ArgsOffset+len(convertCtx.Args))
@@ -70,6 +76,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where = append(where, fmt.Sprintf("(%s)", condition))
 			args = append(args, convertCtx.Args...)
 		}
+		searchQueries = append(searchQueries, convertCtx.SearchQueries...)
 	}
 	if v := find.ID; v != nil {
 		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
@@ -83,6 +90,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if !find.IncludeTrash {
+		if find.InTrash {
+			where = append(where, "memo.trashed_ts > 0")
+		} else {
+			where = append(where, "memo.trashed_ts = 0")
+		}
+	}
+	if v := find.TrashedBefore; v != nil {
+		where, args = append(where, "memo.trashed_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if find.Scheduled {
+		where = append(where, "memo.publish_ts > 0")
+	}
+	if find.HasReminders {
+		where = append(where, "memo.payload->'reminders' IS NOT NULL")
+	}
+	if v := find.PublishBefore; v != nil {
+		where, args = append(where, "memo.publish_ts > 0 AND memo.publish_ts <= "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		holders := []string{}
 		for _, visibility := range v {
@@ -94,23 +120,50 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "parent_memo.id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		field, operator := "memo.created_ts", "<"
+		if find.OrderByUpdatedTs {
+			field = "memo.updated_ts"
+		}
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		where = append(where, fmt.Sprintf("(%s %s %s OR (%s = %s AND memo.id %s %s))", field, operator, placeholder(len(args)+1), field, placeholder(len(args)+2), operator, placeholder(len(args)+3)))
+		args = append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
 	orderBy := []string{}
+	if find.OrderByRelevance {
+		dialect := &filter.PostgreSQLDialect{}
+		for _, query := range searchQueries {
+			orderBy = append(orderBy, strings.Replace(dialect.GetFullTextRank(), "?", placeholder(len(args)+1), 1)+" DESC")
+			args = append(args, dialect.GetFullTextQuery(query))
+		}
+	}
+	if find.Scheduled {
+		orderBy = append(orderBy, "memo.publish_ts ASC")
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "updated_ts "+order)
 	} else {
 		orderBy = append(orderBy, "created_ts "+order)
 	}
+	orderBy = append(orderBy, "memo.id "+order)
 	fields := []string{
 		`memo.id AS id`,
 		`memo.uid AS uid`,
 		`memo.creator_id AS creator_id`,
 		`memo.created_ts AS created_ts`,
 		`memo.updated_ts AS updated_ts`,
+		`memo.trashed_ts AS trashed_ts`,
+		`memo.publish_ts AS publish_ts`,
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
@@ -134,7 +187,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +203,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.TrashedTs,
+			&memo.PublishTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
@@ -213,6 +268,12 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Pinned; v != nil {
 		set, args = append(set, "pinned = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		set, args = append(set, "trashed_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.PublishTs; v != nil {
+		set, args = append(set, "publish_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -226,7 +287,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 
 	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
@@ -235,7 +296,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
 	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete memo")
 	}
@@ -145,6 +145,46 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE",
 			args:   []any{},
 		},
+		{
+			filter: `search("hello world")`,
+			want:   "to_tsvector('simple', memo.content) @@ plainto_tsquery('simple', $1)",
+			args:   []any{"hello world"},
+		},
+		{
+			filter: `tag in_tree "work" || tag in ["home"]`,
+			want:   "(EXISTS (SELECT 1 FROM jsonb_array_elements_text(COALESCE(memo.payload->'tags', '[]'::jsonb)) AS memo_tag WHERE memo_tag = $1 OR memo_tag LIKE $2 ESCAPE '!') OR memo.payload->'tags' @> jsonb_build_array($3::json))",
+			args:   []any{"work", "work/%", `"home"`},
+		},
+		{
+			filter: `creator_id == 1 || granted_to(2)`,
+			want:   "(memo.creator_id = $1 OR memo.id IN (SELECT memo_id FROM memo_grant WHERE user_id = $2))",
+			args:   []any{int64(1), int64(2)},
+		},
+		{
+			filter: `in_groups_of(3)`,
+			want:   "(memo.visibility = 'GROUP' AND memo.id IN (SELECT memo_group.memo_id FROM memo_group JOIN user_group_member ON user_group_member.group_id = memo_group.group_id WHERE user_group_member.user_id = $1))",
+			args:   []any{int64(3)},
+		},
+		{
+			filter: `has_relation("blocks")`,
+			want:   "memo.id IN (SELECT memo_id FROM memo_relation WHERE type = $1)",
+			args:   []any{"blocks"},
+		},
+		{
+			filter: `mentions.exists(m, m == "alice")`,
+			want:   "memo.payload->'mentions' @> jsonb_build_array($1::json)",
+			args:   []any{`"alice"`},
+		},
+		{
+			filter: `"alice" in mentions`,
+			want:   "memo.payload->'mentions' @> jsonb_build_array($1::json)",
+			args:   []any{`"alice"`},
+		},
+		{
+			filter: `"a\"b" in mentions`,
+			want:   "memo.payload->'mentions' @> jsonb_build_array($1::json)",
+			args:   []any{`"a\"b"`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoGrant(ctx context.Context, upsert *store.MemoGrant) (*store.MemoGrant, error) {
+	stmt := "INSERT INTO memo_grant (memo_id, user_id, role, creator_id) VALUES (" + placeholders(4) + ") ON CONFLICT(memo_id, user_id) DO UPDATE SET role = EXCLUDED.role"
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.MemoID, upsert.UserID, upsert.Role, upsert.CreatorID); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListMemoGrants(ctx, &store.FindMemoGrant{MemoID: &upsert.MemoID, UserID: &upsert.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to find upserted memo grant")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoGrants(ctx context.Context, find *store.FindMemoGrant) ([]*store.MemoGrant, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := "SELECT id, memo_id, user_id, role, creator_id, created_ts FROM memo_grant WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts ASC, id ASC"
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoGrant{}
+	for rows.Next() {
+		grant := &store.MemoGrant{}
+		if err := rows.Scan(
+			&grant.ID,
+			&grant.MemoID,
+			&grant.UserID,
+			&grant.Role,
+			&grant.CreatorID,
+			&grant.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, grant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoGrant(ctx context.Context, delete *store.DeleteMemoGrant) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM memo_grant WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -43,13 +43,18 @@ func (d *DB) GetDB() *sql.DB {
 	return d.db
 }
 
+// conn returns the transaction the context runs in, or the database if it isn't in one.
+func (d *DB) conn(ctx context.Context) store.Querier {
+	return store.GetQuerier(ctx, d.db)
+}
+
 func (d *DB) Close() error {
 	return d.db.Close()
 }
 
 func (d *DB) IsInitialized(ctx context.Context) (bool, error) {
 	var exists bool
-	err := d.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'memo' AND table_type = 'BASE TABLE')").Scan(&exists)
+	err := d.conn(ctx).QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'memo' AND table_type = 'BASE TABLE')").Scan(&exists)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to check if database is initialized")
 	}
@@ -13,7 +13,7 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	fields := []string{"creator_id", "content_id", "reaction_type"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO reaction (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
 		&upsert.ID,
 		&upsert.CreatedTs,
 	); err != nil {
@@ -57,7 +57,7 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 		where, args = append(where, "content_id = "+placeholder(len(args)+1)), append(args, *find.ContentID)
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn(ctx).QueryContext(ctx, `
 		SELECT
 			id,
 			created_ts,
@@ -97,6 +97,6 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
+	_, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
 	return err
 }
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	fields := []string{"name", "description", "creator_id"}
+	args := []any{create.Name, create.Description, create.CreatorID}
+
+	stmt := "INSERT INTO user_group (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *find.Name)
+	}
+	if len(find.IDList) > 0 {
+		holders := []string{}
+		for _, id := range find.IDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(holders, ", ")))
+	}
+
+	query := "SELECT id, name, description, creator_id, created_ts, updated_ts FROM user_group WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		group := &store.UserGroup{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.Name,
+			&group.Description,
+			&group.CreatorID,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) (*store.UserGroup, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE user_group SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)) + " RETURNING id, name, description, creator_id, created_ts, updated_ts"
+	group := &store.UserGroup{}
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
+		&group.ID,
+		&group.Name,
+		&group.Description,
+		&group.CreatorID,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (d *DB) DeleteUserGroup(ctx context.Context, delete *store.DeleteUserGroup) error {
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM user_group WHERE id = "+placeholder(1), delete.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) UpsertUserGroupMember(ctx context.Context, upsert *store.UserGroupMember) (*store.UserGroupMember, error) {
+	stmt := "INSERT INTO user_group_member (group_id, user_id) VALUES (" + placeholders(2) + ") ON CONFLICT(group_id, user_id) DO UPDATE SET group_id = EXCLUDED.group_id RETURNING created_ts"
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, upsert.GroupID, upsert.UserID).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.GroupID != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *find.GroupID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := "SELECT group_id, user_id, created_ts FROM user_group_member WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts ASC, user_id ASC"
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		member := &store.UserGroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupMember(ctx context.Context, delete *store.DeleteUserGroupMember) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.GroupID != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *delete.GroupID)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM user_group_member WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) UpsertMemoGroup(ctx context.Context, upsert *store.MemoGroup) (*store.MemoGroup, error) {
+	stmt := "INSERT INTO memo_group (memo_id, group_id) VALUES (" + placeholders(2) + ") ON CONFLICT(memo_id, group_id) DO NOTHING"
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.MemoID, upsert.GroupID); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListMemoGroups(ctx context.Context, find *store.FindMemoGroup) ([]*store.MemoGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.GroupID != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *find.GroupID)
+	}
+
+	query := "SELECT memo_id, group_id FROM memo_group WHERE " + strings.Join(where, " AND ") + " ORDER BY memo_id ASC, group_id ASC"
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoGroup{}
+	for rows.Next() {
+		memoGroup := &store.MemoGroup{}
+		if err := rows.Scan(
+			&memoGroup.MemoID,
+			&memoGroup.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoGroup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoGroup(ctx context.Context, delete *store.DeleteMemoGroup) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	if delete.GroupID != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *delete.GroupID)
+	}
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM memo_group WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
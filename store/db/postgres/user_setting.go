@@ -17,7 +17,7 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (
 		ON CONFLICT(user_id, key) DO UPDATE 
 		SET value = EXCLUDED.value
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
 		return nil, err
 	}
 	return upsert, nil
@@ -32,6 +32,9 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
 	}
+	if v := find.EmailIngestToken; v != nil {
+		where, args = append(where, "key = "+placeholder(len(args)+1), "value::jsonb->>'token' = "+placeholder(len(args)+2)), append(args, storepb.UserSetting_EMAIL_INGEST.String(), *v)
+	}
 
 	query := `
 		SELECT
@@ -40,7 +43,10 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			value
 		FROM user_setting
 		WHERE ` + strings.Join(where, " AND ")
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	if find.ForUpdate {
+		query += " FOR UPDATE"
+	}
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -37,9 +37,14 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		placeholder = append(placeholder, "?")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.PublishTs != 0 {
+		fields = append(fields, "`publish_ts`")
+		placeholder = append(placeholder, "?")
+		args = append(args, create.PublishTs)
+	}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -54,6 +59,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
+	searchQueries := []string{}
 	for _, filterStr := range find.Filters {
 		// Parse filter string and return the parsed expression.
 		// The filter string should be a CEL expression.
@@ -62,6 +68,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			return nil, err
 		}
 		convertCtx := filter.NewConvertContext()
+		convertCtx.TagAliases = find.TagAliases
 		// ConvertExprToSQL converts the parsed expression to a SQL condition string.
 		converter := filter.NewCommonSQLConverter(&filter.SQLiteDialect{})
 		if err := converter.ConvertExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
@@ -72,6 +79,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where = append(where, fmt.Sprintf("(%s)", condition))
 			args = append(args, convertCtx.Args...)
 		}
+		searchQueries = append(searchQueries, convertCtx.SearchQueries...)
 	}
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
@@ -85,6 +93,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
 	}
+	if !find.IncludeTrash {
+		if find.InTrash {
+			where = append(where, "`memo`.`trashed_ts` > 0")
+		} else {
+			where = append(where, "`memo`.`trashed_ts` = 0")
+		}
+	}
+	if v := find.TrashedBefore; v != nil {
+		where, args = append(where, "`memo`.`trashed_ts` < ?"), append(args, *v)
+	}
+	if find.Scheduled {
+		where = append(where, "`memo`.`publish_ts` > 0")
+	}
+	if find.HasReminders {
+		where = append(where, "JSON_EXTRACT(`memo`.`payload`, '$.reminders') IS NOT NULL")
+	}
+	if v := find.PublishBefore; v != nil {
+		where, args = append(where, "`memo`.`publish_ts` > 0 AND `memo`.`publish_ts` <= ?"), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -96,23 +123,50 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_uid` IS NULL")
 	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "`parent_memo`.`id` = ?"), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		field, operator := "`memo`.`created_ts`", "<"
+		if find.OrderByUpdatedTs {
+			field = "`memo`.`updated_ts`"
+		}
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		where = append(where, fmt.Sprintf("(%s %s ? OR (%s = ? AND `memo`.`id` %s ?))", field, operator, field, operator))
+		args = append(args, v.Timestamp, v.Timestamp, v.ID)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
 	orderBy := []string{}
+	if find.OrderByRelevance {
+		dialect := &filter.SQLiteDialect{}
+		for _, query := range searchQueries {
+			orderBy = append(orderBy, dialect.GetFullTextRank()+" DESC")
+			args = append(args, dialect.GetFullTextQuery(query))
+		}
+	}
+	if find.Scheduled {
+		orderBy = append(orderBy, "`memo`.`publish_ts` ASC")
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
 		orderBy = append(orderBy, "`created_ts` "+order)
 	}
+	orderBy = append(orderBy, "`memo`.`id` "+order)
 	fields := []string{
 		"`memo`.`id` AS `id`",
 		"`memo`.`uid` AS `uid`",
 		"`memo`.`creator_id` AS `creator_id`",
 		"`memo`.`created_ts` AS `created_ts`",
 		"`memo`.`updated_ts` AS `updated_ts`",
+		"`memo`.`trashed_ts` AS `trashed_ts`",
+		"`memo`.`publish_ts` AS `publish_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
@@ -135,7 +189,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +205,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.TrashedTs,
+			&memo.PublishTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
@@ -201,6 +257,12 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Pinned; v != nil {
 		set, args = append(set, "`pinned` = ?"), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		set, args = append(set, "`trashed_ts` = ?"), append(args, *v)
+	}
+	if v := update.PublishTs; v != nil {
+		set, args = append(set, "`publish_ts` = ?"), append(args, *v)
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -214,7 +276,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
@@ -223,7 +285,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn(ctx).ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
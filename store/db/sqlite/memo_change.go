@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoChange(ctx context.Context, create *store.MemoChange) (*store.MemoChange, error) {
+	fields := []string{"`memo_id`", "`memo_uid`", "`creator_id`", "`visibility`", "`type`", "`resource_key`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.MemoID, create.MemoUID, create.CreatorID, create.Visibility, create.Type, create.ResourceKey}
+
+	stmt := "INSERT INTO `memo_change` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListMemoChanges(ctx context.Context, find *store.FindMemoChange) ([]*store.MemoChange, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.AfterID != nil {
+		where, args = append(where, "`id` > ?"), append(args, *find.AfterID)
+	}
+	if find.CreatedBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *find.CreatedBefore)
+	}
+
+	order := "ASC"
+	if find.OrderByIDDesc {
+		order = "DESC"
+	}
+	query := "SELECT `id`, `created_ts`, `memo_id`, `memo_uid`, `creator_id`, `visibility`, `type`, `resource_key` FROM `memo_change` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` " + order
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoChange{}
+	for rows.Next() {
+		change := &store.MemoChange{}
+		if err := rows.Scan(
+			&change.ID,
+			&change.CreatedTs,
+			&change.MemoID,
+			&change.MemoUID,
+			&change.CreatorID,
+			&change.Visibility,
+			&change.Type,
+			&change.ResourceKey,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoChange(ctx context.Context, delete *store.DeleteMemoChange) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatedBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *delete.CreatedBefore)
+	}
+	if _, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM `memo_change` WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
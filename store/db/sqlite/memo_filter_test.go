@@ -150,6 +150,56 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE",
 			args:   []any{},
 		},
+		{
+			filter: `search("hello \"world")`,
+			want:   "`memo`.`id` IN (SELECT `rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ?)",
+			args:   []any{`"hello" """world"`},
+		},
+		{
+			filter: `tag in_tree "work"`,
+			want:   "EXISTS (SELECT 1 FROM json_each(`memo`.`payload`, '$.tags') WHERE `value` = ? OR substr(`value`, 1, length(?)) = ?)",
+			args:   []any{"work", "work/", "work/"},
+		},
+		{
+			filter: `pinned && tag in_tree "work/projectA"`,
+			want:   "(`memo`.`pinned` IS TRUE AND EXISTS (SELECT 1 FROM json_each(`memo`.`payload`, '$.tags') WHERE `value` = ? OR substr(`value`, 1, length(?)) = ?))",
+			args:   []any{"work/projectA", "work/projectA/", "work/projectA/"},
+		},
+		{
+			filter: `content.contains("tag in_tree 'work'")`,
+			want:   "`memo`.`content` LIKE ?",
+			args:   []any{"%tag in_tree 'work'%"},
+		},
+		{
+			filter: `creator_id == 1 || granted_to(2)`,
+			want:   "(`memo`.`creator_id` = ? OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_grant` WHERE `user_id` = ?))",
+			args:   []any{int64(1), int64(2)},
+		},
+		{
+			filter: `in_groups_of(3)`,
+			want:   "(`memo`.`visibility` = 'GROUP' AND `memo`.`id` IN (SELECT `memo_group`.`memo_id` FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `user_group_member`.`user_id` = ?))",
+			args:   []any{int64(3)},
+		},
+		{
+			filter: `has_relation("blocks")`,
+			want:   "`memo`.`id` IN (SELECT `memo_id` FROM `memo_relation` WHERE `type` = ?)",
+			args:   []any{"blocks"},
+		},
+		{
+			filter: `mentions.exists(m, m == "alice")`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.mentions') LIKE ? ESCAPE '!'",
+			args:   []any{`%"alice"%`},
+		},
+		{
+			filter: `"alice" in mentions`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.mentions') LIKE ? ESCAPE '!'",
+			args:   []any{`%"alice"%`},
+		},
+		{
+			filter: `"a_b%" in mentions`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.mentions') LIKE ? ESCAPE '!'",
+			args:   []any{`%"a!_b!%"%`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateRecurringMemo(ctx context.Context, create *store.RecurringMemo) (*store.RecurringMemo, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = string(payloadBytes)
+	}
+	fields := []string{"`uid`", "`creator_id`", "`schedule`", "`visibility`", "`payload`", "`last_run_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.UID, create.CreatorID, create.Schedule, create.Visibility, payload, create.LastRunTs}
+
+	stmt := "INSERT INTO `recurring_memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`, `missed_runs`"
+	if err := d.conn(ctx).QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+		&create.RowStatus,
+		&create.MissedRuns,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListRecurringMemos(ctx context.Context, find *store.FindRecurringMemo) ([]*store.RecurringMemo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *find.UID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.RowStatus != nil {
+		where, args = append(where, "`row_status` = ?"), append(args, *find.RowStatus)
+	}
+
+	query := "SELECT `id`, `uid`, `creator_id`, `created_ts`, `updated_ts`, `row_status`, `schedule`, `visibility`, `payload`, `last_run_ts`, `missed_runs` FROM `recurring_memo` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.RecurringMemo{}
+	for rows.Next() {
+		recurringMemo := &store.RecurringMemo{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&recurringMemo.ID,
+			&recurringMemo.UID,
+			&recurringMemo.CreatorID,
+			&recurringMemo.CreatedTs,
+			&recurringMemo.UpdatedTs,
+			&recurringMemo.RowStatus,
+			&recurringMemo.Schedule,
+			&recurringMemo.Visibility,
+			&payloadBytes,
+			&recurringMemo.LastRunTs,
+			&recurringMemo.MissedRuns,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.RecurringMemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		recurringMemo.Payload = payload
+		list = append(list, recurringMemo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateRecurringMemo(ctx context.Context, update *store.UpdateRecurringMemo) (*store.RecurringMemo, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.RowStatus; v != nil {
+		set, args = append(set, "`row_status` = ?"), append(args, *v)
+	}
+	if v := update.Schedule; v != nil {
+		set, args = append(set, "`schedule` = ?"), append(args, *v)
+	}
+	if v := update.Visibility; v != nil {
+		set, args = append(set, "`visibility` = ?"), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
+	}
+	if v := update.LastRunTs; v != nil {
+		set, args = append(set, "`last_run_ts` = ?"), append(args, *v)
+	}
+	if v := update.MissedRuns; v != nil {
+		set, args = append(set, "`missed_runs` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `recurring_memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListRecurringMemos(ctx, &store.FindRecurringMemo{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to find updated recurring memo")
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteRecurringMemo(ctx context.Context, delete *store.DeleteRecurringMemo) error {
+	result, err := d.conn(ctx).ExecContext(ctx, "DELETE FROM `recurring_memo` WHERE `id` = ?", delete.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
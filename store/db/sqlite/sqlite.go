@@ -33,6 +33,8 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// good practice to be explicit and prevent future surprises on SQLite upgrades.
 	// - Journal mode set to WAL: it's the recommended journal mode for most applications
 	// as it prevents locking issues.
+	// - Transactions take the write lock when they begin: a deferred transaction that reads
+	// before writing fails instead of waiting when another connection wrote in between.
 	//
 	// Notes:
 	// - When using the `modernc.org/sqlite` driver, each pragma must be prefixed with `_pragma=`.
@@ -41,7 +43,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// - https://pkg.go.dev/modernc.org/sqlite#Driver.Open
 	// - https://www.sqlite.org/sharedcache.html
 	// - https://www.sqlite.org/pragma.html
-	sqliteDB, err := sql.Open("sqlite", profile.DSN+"?_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)")
+	sqliteDB, err := sql.Open("sqlite", profile.DSN+"?_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)&_txlock=immediate")
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db with dsn: %s", profile.DSN)
 	}
@@ -55,6 +57,11 @@ func (d *DB) GetDB() *sql.DB {
 	return d.db
 }
 
+// conn returns the transaction the context runs in, or the database if it isn't in one.
+func (d *DB) conn(ctx context.Context) store.Querier {
+	return store.GetQuerier(ctx, d.db)
+}
+
 func (d *DB) Close() error {
 	return d.db.Close()
 }
@@ -62,7 +69,7 @@ func (d *DB) Close() error {
 func (d *DB) IsInitialized(ctx context.Context) (bool, error) {
 	// Check if the database is initialized by checking if the memo table exists.
 	var exists bool
-	err := d.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type='table' AND name='memo')").Scan(&exists)
+	err := d.conn(ctx).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type='table' AND name='memo')").Scan(&exists)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to check if database is initialized")
 	}
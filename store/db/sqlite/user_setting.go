@@ -17,7 +17,7 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (
 		ON CONFLICT(user_id, key) DO UPDATE 
 		SET value = EXCLUDED.value
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
+	if _, err := d.conn(ctx).ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
 		return nil, err
 	}
 	return upsert, nil
@@ -32,6 +32,9 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	if v := find.UserID; v != nil {
 		where, args = append(where, "user_id = ?"), append(args, *find.UserID)
 	}
+	if v := find.EmailIngestToken; v != nil {
+		where, args = append(where, "key = ?", "JSON_EXTRACT(value, '$.token') = ?"), append(args, storepb.UserSetting_EMAIL_INGEST.String(), *v)
+	}
 
 	query := `
 		SELECT
@@ -40,7 +43,7 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			value
 		FROM user_setting
 		WHERE ` + strings.Join(where, " AND ")
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
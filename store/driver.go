@@ -38,6 +38,31 @@ type Driver interface {
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// MemoGrant model related methods.
+	UpsertMemoGrant(ctx context.Context, upsert *MemoGrant) (*MemoGrant, error)
+	ListMemoGrants(ctx context.Context, find *FindMemoGrant) ([]*MemoGrant, error)
+	DeleteMemoGrant(ctx context.Context, delete *DeleteMemoGrant) error
+
+	// MemoGroup model related methods.
+	UpsertMemoGroup(ctx context.Context, upsert *MemoGroup) (*MemoGroup, error)
+	ListMemoGroups(ctx context.Context, find *FindMemoGroup) ([]*MemoGroup, error)
+	DeleteMemoGroup(ctx context.Context, delete *DeleteMemoGroup) error
+
+	// MemoChange model related methods.
+	CreateMemoChange(ctx context.Context, create *MemoChange) (*MemoChange, error)
+	ListMemoChanges(ctx context.Context, find *FindMemoChange) ([]*MemoChange, error)
+	DeleteMemoChange(ctx context.Context, delete *DeleteMemoChange) error
+
 	// WorkspaceSetting model related methods.
 	UpsertWorkspaceSetting(ctx context.Context, upsert *WorkspaceSetting) (*WorkspaceSetting, error)
 	ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSetting) ([]*WorkspaceSetting, error)
@@ -53,6 +78,21 @@ type Driver interface {
 	UpsertUserSetting(ctx context.Context, upsert *UserSetting) (*UserSetting, error)
 	ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*UserSetting, error)
 
+	// UserGroup model related methods.
+	CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error)
+	ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error)
+	DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error
+	UpsertUserGroupMember(ctx context.Context, upsert *UserGroupMember) (*UserGroupMember, error)
+	ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error)
+	DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error
+
+	// RecurringMemo model related methods.
+	CreateRecurringMemo(ctx context.Context, create *RecurringMemo) (*RecurringMemo, error)
+	ListRecurringMemos(ctx context.Context, find *FindRecurringMemo) ([]*RecurringMemo, error)
+	UpdateRecurringMemo(ctx context.Context, update *UpdateRecurringMemo) (*RecurringMemo, error)
+	DeleteRecurringMemo(ctx context.Context, delete *DeleteRecurringMemo) error
+
 	// IdentityProvider model related methods.
 	CreateIdentityProvider(ctx context.Context, create *IdentityProvider) (*IdentityProvider, error)
 	ListIdentityProviders(ctx context.Context, find *FindIdentityProvider) ([]*IdentityProvider, error)
@@ -63,6 +103,8 @@ type Driver interface {
 	CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error)
 	ListInboxes(ctx context.Context, find *FindInbox) ([]*Inbox, error)
 	UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error)
+	UpdateInboxes(ctx context.Context, update *UpdateInboxes) (int64, error)
+	ListInboxStats(ctx context.Context, find *FindInbox) ([]*InboxStats, error)
 	DeleteInbox(ctx context.Context, delete *DeleteInbox) error
 
 	// Reaction model related methods.
@@ -30,6 +30,17 @@ type Inbox struct {
 type UpdateInbox struct {
 	ID     int32
 	Status InboxStatus
+
+	// Aggregated inboxes are updated with the latest sender and message, and moved to the top.
+	CreatedTs *int64
+	SenderID  *int32
+	Message   *storepb.InboxMessage
+}
+
+// UpdateInboxes updates the status of all the inboxes found.
+type UpdateInboxes struct {
+	Find   *FindInbox
+	Status InboxStatus
 }
 
 type FindInbox struct {
@@ -37,16 +48,30 @@ type FindInbox struct {
 	SenderID   *int32
 	ReceiverID *int32
 	Status     *InboxStatus
+	// MessageType and MemoID match the type and the memo of the inbox message.
+	MessageType *storepb.InboxMessage_Type
+	MemoID      *int32
+	// Filters are CEL expressions over the inbox status and message_type.
+	Filters []string
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor only matches inboxes sorted after the given position.
+	Cursor *Cursor
 }
 
 type DeleteInbox struct {
 	ID int32
 }
 
+// InboxStats is the number of inboxes with a status and message type.
+type InboxStats struct {
+	Status InboxStatus
+	Type   storepb.InboxMessage_Type
+	Count  int32
+}
+
 func (s *Store) CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error) {
 	return s.driver.CreateInbox(ctx, create)
 }
@@ -59,6 +84,16 @@ func (s *Store) UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, e
 	return s.driver.UpdateInbox(ctx, update)
 }
 
+// UpdateInboxes returns the number of inboxes updated.
+func (s *Store) UpdateInboxes(ctx context.Context, update *UpdateInboxes) (int64, error) {
+	return s.driver.UpdateInboxes(ctx, update)
+}
+
+// ListInboxStats returns the number of inboxes found by status and message type.
+func (s *Store) ListInboxStats(ctx context.Context, find *FindInbox) ([]*InboxStats, error) {
+	return s.driver.ListInboxStats(ctx, find)
+}
+
 func (s *Store) DeleteInbox(ctx context.Context, delete *DeleteInbox) error {
 	return s.driver.DeleteInbox(ctx, delete)
 }
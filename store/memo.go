@@ -19,6 +19,8 @@ const (
 	Protected Visibility = "PROTECTED"
 	// Private is the PRIVATE visibility.
 	Private Visibility = "PRIVATE"
+	// Group is the GROUP visibility, the memo is visible to the members of its target groups.
+	Group Visibility = "GROUP"
 )
 
 func (v Visibility) String() string {
@@ -29,6 +31,8 @@ func (v Visibility) String() string {
 		return "PROTECTED"
 	case Private:
 		return "PRIVATE"
+	case Group:
+		return "GROUP"
 	}
 	return "PRIVATE"
 }
@@ -44,6 +48,10 @@ type Memo struct {
 	CreatorID int32
 	CreatedTs int64
 	UpdatedTs int64
+	// TrashedTs is the time the memo was moved to the trash, or 0 if it isn't in the trash.
+	TrashedTs int64
+	// PublishTs is the time the memo is scheduled to become public, or 0 if it isn't scheduled.
+	PublishTs int64
 
 	// Domain specific fields
 	Content    string
@@ -68,14 +76,38 @@ type FindMemo struct {
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filters         []string
+	// TagAliases maps a tag to the other tags that mean the same, tag filters on any of them match all of them.
+	TagAliases map[string][]string
+	// ParentID only matches the comments of the given memo.
+	ParentID *int32
+	// HasReminders only matches memos with reminders in their payload.
+	HasReminders bool
+
+	// Trash
+	// InTrash lists memos in the trash instead of the ones outside of it.
+	InTrash bool
+	// IncludeTrash lists memos both in and outside of the trash, regardless of InTrash.
+	IncludeTrash bool
+	// TrashedBefore only matches memos moved to the trash before the given timestamp.
+	TrashedBefore *int64
+
+	// Scheduled publishing
+	// Scheduled only matches memos scheduled to become public, ordered by their publish time.
+	Scheduled bool
+	// PublishBefore only matches memos scheduled to become public at or before the given timestamp.
+	PublishBefore *int64
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor only matches memos sorted after the given position.
+	Cursor *Cursor
 
 	// Ordering
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
+	// OrderByRelevance orders memos by how well they match the search() queries in the filters first.
+	OrderByRelevance bool
 }
 
 type FindMemoPayload struct {
@@ -97,6 +129,8 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	TrashedTs  *int64
+	PublishTs  *int64
 }
 
 type DeleteMemo struct {
@@ -107,7 +141,18 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	var memo *Memo
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		memo, err = s.driver.CreateMemo(ctx, create)
+		if err != nil {
+			return err
+		}
+		return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeMemo, memo.UID)
+	}); err != nil {
+		return nil, err
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
@@ -131,9 +176,47 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		oldMemo, err := s.getMemoForChange(ctx, &FindMemo{ID: &update.ID})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.UpdateMemo(ctx, update); err != nil {
+			return err
+		}
+		if oldMemo == nil {
+			return nil
+		}
+		// Only the uid and the visibility of the memo go into its changes.
+		memo := *oldMemo
+		if update.UID != nil {
+			memo.UID = *update.UID
+		}
+		if update.Visibility != nil {
+			memo.Visibility = *update.Visibility
+		}
+		visibility := widerVisibility(oldMemo.Visibility, memo.Visibility)
+		if memo.UID != oldMemo.UID {
+			if err := s.recordMemoChange(ctx, oldMemo, visibility, MemoChangeMemo, oldMemo.UID); err != nil {
+				return err
+			}
+		}
+		return s.recordMemoChange(ctx, &memo, visibility, MemoChangeMemo, memo.UID)
+	})
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
-	return s.driver.DeleteMemo(ctx, delete)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		memo, err := s.getMemoForChange(ctx, &FindMemo{ID: &delete.ID})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.DeleteMemo(ctx, delete); err != nil {
+			return err
+		}
+		if memo == nil {
+			return nil
+		}
+		return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeMemo, memo.UID)
+	})
 }
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MemoChangeType is the type of the resource a memo change is about.
+type MemoChangeType string
+
+const (
+	MemoChangeMemo       MemoChangeType = "MEMO"
+	MemoChangeRelation   MemoChangeType = "RELATION"
+	MemoChangeReaction   MemoChangeType = "REACTION"
+	MemoChangeAttachment MemoChangeType = "ATTACHMENT"
+	// MemoChangeGrant changes whether the grantee can see the memo.
+	MemoChangeGrant MemoChangeType = "GRANT"
+	// MemoChangeGroup changes whether the members of the group can see the memo.
+	MemoChangeGroup MemoChangeType = "GROUP"
+)
+
+// MemoChangeRetention is how long changes are kept in the change log before they are pruned.
+const MemoChangeRetention = 30 * 24 * time.Hour
+
+// MemoChange records that a memo, or one of its relations, reactions or attachments, was created, updated or deleted.
+// It only points at the changed resource, readers look up its current state to tell updates from deletions.
+type MemoChange struct {
+	ID        int32
+	CreatedTs int64
+
+	// The memo the changed resource belongs to.
+	MemoID    int32
+	MemoUID   string
+	CreatorID int32
+	// Visibility is the widest visibility the memo had before or after the change,
+	// so that everyone who could have seen the resource learns about the change.
+	Visibility Visibility
+
+	Type MemoChangeType
+	// ResourceKey identifies the changed resource within its type:
+	// the memo uid, the reaction id, the attachment uid, the grantee id for grants, the group id for groups,
+	// or "<relation type>/<related memo uid>" for relations.
+	ResourceKey string
+}
+
+type FindMemoChange struct {
+	ID *int32
+	// AfterID only matches changes recorded after the given change.
+	AfterID *int32
+	// CreatedBefore only matches changes recorded before the given timestamp.
+	CreatedBefore *int64
+
+	// Pagination
+	Limit *int
+
+	// Ordering
+	OrderByIDDesc bool
+}
+
+type DeleteMemoChange struct {
+	// CreatedBefore deletes the changes recorded before the given timestamp.
+	CreatedBefore *int64
+}
+
+func (s *Store) CreateMemoChange(ctx context.Context, create *MemoChange) (*MemoChange, error) {
+	return s.driver.CreateMemoChange(ctx, create)
+}
+
+func (s *Store) ListMemoChanges(ctx context.Context, find *FindMemoChange) ([]*MemoChange, error) {
+	return s.driver.ListMemoChanges(ctx, find)
+}
+
+func (s *Store) DeleteMemoChange(ctx context.Context, delete *DeleteMemoChange) error {
+	return s.driver.DeleteMemoChange(ctx, delete)
+}
+
+// GetLatestMemoChange returns the most recently recorded change matching find, or nil if there is none.
+func (s *Store) GetLatestMemoChange(ctx context.Context, find *FindMemoChange) (*MemoChange, error) {
+	limit := 1
+	find.Limit = &limit
+	find.OrderByIDDesc = true
+	list, err := s.ListMemoChanges(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// GetMemoRelationChangeKey returns the resource key of a relation change.
+func GetMemoRelationChangeKey(relationType MemoRelationType, relatedMemoUID string) string {
+	return fmt.Sprintf("%s/%s", relationType, relatedMemoUID)
+}
+
+// ParseMemoRelationChangeKey returns the relation type and the related memo uid of a relation change key.
+func ParseMemoRelationChangeKey(key string) (MemoRelationType, string, bool) {
+	relationType, relatedMemoUID, ok := strings.Cut(key, "/")
+	return MemoRelationType(relationType), relatedMemoUID, ok
+}
+
+// recordMemoChange appends a change of the memo to the change log.
+// It's called in the transaction that saves the change, so a change is never saved without its log entry.
+func (s *Store) recordMemoChange(ctx context.Context, memo *Memo, visibility Visibility, changeType MemoChangeType, resourceKey string) error {
+	if memo == nil {
+		return nil
+	}
+	if _, err := s.driver.CreateMemoChange(ctx, &MemoChange{
+		MemoID:      memo.ID,
+		MemoUID:     memo.UID,
+		CreatorID:   memo.CreatorID,
+		Visibility:  visibility,
+		Type:        changeType,
+		ResourceKey: resourceKey,
+	}); err != nil {
+		return errors.Wrap(err, "failed to record memo change")
+	}
+	return nil
+}
+
+// getMemoForChange returns the memo with the given id or uid, including memos in the trash, or nil if it doesn't exist.
+func (s *Store) getMemoForChange(ctx context.Context, find *FindMemo) (*Memo, error) {
+	find.ExcludeContent = true
+	find.IncludeTrash = true
+	list, err := s.driver.ListMemos(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// widerVisibility returns the visibility that lets more users see a memo.
+func widerVisibility(a, b Visibility) Visibility {
+	rank := map[Visibility]int{Private: 0, Group: 1, Protected: 2, Public: 3}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// MemoGrantRole is the access a memo grant gives its user.
+type MemoGrantRole string
+
+const (
+	// MemoGrantRoleViewer can read the memo.
+	MemoGrantRoleViewer MemoGrantRole = "VIEWER"
+	// MemoGrantRoleCommenter can read and comment on the memo.
+	MemoGrantRoleCommenter MemoGrantRole = "COMMENTER"
+	// MemoGrantRoleEditor can read, comment on and edit the memo.
+	MemoGrantRoleEditor MemoGrantRole = "EDITOR"
+)
+
+// CanComment reports whether the role allows commenting on the memo.
+func (r MemoGrantRole) CanComment() bool {
+	return r == MemoGrantRoleCommenter || r == MemoGrantRoleEditor
+}
+
+// CanEdit reports whether the role allows editing the memo.
+func (r MemoGrantRole) CanEdit() bool {
+	return r == MemoGrantRoleEditor
+}
+
+// MemoGrant gives a specific user access to a memo, whatever the memo's visibility.
+type MemoGrant struct {
+	ID int32
+
+	// Standard fields
+	CreatorID int32
+	CreatedTs int64
+
+	// Domain specific fields
+	MemoID int32
+	UserID int32
+	Role   MemoGrantRole
+}
+
+type FindMemoGrant struct {
+	ID     *int32
+	MemoID *int32
+	UserID *int32
+}
+
+type DeleteMemoGrant struct {
+	ID     *int32
+	MemoID *int32
+}
+
+// UpsertMemoGrant creates the grant, or updates its role if the user already has a grant on the memo.
+func (s *Store) UpsertMemoGrant(ctx context.Context, upsert *MemoGrant) (*MemoGrant, error) {
+	var grant *MemoGrant
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		grant, err = s.driver.UpsertMemoGrant(ctx, upsert)
+		if err != nil {
+			return err
+		}
+		return s.recordMemoGrantChange(ctx, grant)
+	}); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+func (s *Store) ListMemoGrants(ctx context.Context, find *FindMemoGrant) ([]*MemoGrant, error) {
+	return s.driver.ListMemoGrants(ctx, find)
+}
+
+func (s *Store) GetMemoGrant(ctx context.Context, find *FindMemoGrant) (*MemoGrant, error) {
+	list, err := s.ListMemoGrants(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoGrant(ctx context.Context, delete *DeleteMemoGrant) error {
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		grants, err := s.driver.ListMemoGrants(ctx, &FindMemoGrant{ID: delete.ID, MemoID: delete.MemoID})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.DeleteMemoGrant(ctx, delete); err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			if err := s.recordMemoGrantChange(ctx, grant); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) recordMemoGrantChange(ctx context.Context, grant *MemoGrant) error {
+	memo, err := s.getMemoForChange(ctx, &FindMemo{ID: &grant.MemoID})
+	if err != nil || memo == nil {
+		return err
+	}
+	return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeGrant, fmt.Sprintf("%d", grant.UserID))
+}
@@ -33,7 +33,18 @@ type DeleteMemoRelation struct {
 }
 
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
-	return s.driver.UpsertMemoRelation(ctx, create)
+	var memoRelation *MemoRelation
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		memoRelation, err = s.driver.UpsertMemoRelation(ctx, create)
+		if err != nil {
+			return err
+		}
+		return s.recordMemoRelationChange(ctx, memoRelation)
+	}); err != nil {
+		return nil, err
+	}
+	return memoRelation, nil
 }
 
 func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error) {
@@ -41,5 +52,35 @@ func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) (
 }
 
 func (s *Store) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
-	return s.driver.DeleteMemoRelation(ctx, delete)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		memoRelations, err := s.driver.ListMemoRelations(ctx, &FindMemoRelation{
+			MemoID:        delete.MemoID,
+			RelatedMemoID: delete.RelatedMemoID,
+			Type:          delete.Type,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.DeleteMemoRelation(ctx, delete); err != nil {
+			return err
+		}
+		for _, memoRelation := range memoRelations {
+			if err := s.recordMemoRelationChange(ctx, memoRelation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) recordMemoRelationChange(ctx context.Context, memoRelation *MemoRelation) error {
+	memo, err := s.getMemoForChange(ctx, &FindMemo{ID: &memoRelation.MemoID})
+	if err != nil || memo == nil {
+		return err
+	}
+	relatedMemo, err := s.getMemoForChange(ctx, &FindMemo{ID: &memoRelation.RelatedMemoID})
+	if err != nil || relatedMemo == nil {
+		return err
+	}
+	return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeRelation, GetMemoRelationChangeKey(memoRelation.Type, relatedMemo.UID))
 }
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShare is a link that lets anyone holding its token read a memo, whatever the memo's visibility.
+type MemoShare struct {
+	ID  int32
+	UID string
+
+	// Standard fields
+	CreatorID int32
+	CreatedTs int64
+
+	// Domain specific fields
+	MemoID int32
+	// Token is the unguessable secret of the share link.
+	Token string
+	// ExpiresTs is the time the share link stops working, nil if it never expires.
+	ExpiresTs *int64
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	UID    *string
+	Token  *string
+	MemoID *int32
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
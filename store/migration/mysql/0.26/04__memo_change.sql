@@ -0,0 +1,10 @@
+CREATE TABLE `memo_change` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL,
+  `creator_id` INT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `type` VARCHAR(256) NOT NULL,
+  `resource_key` VARCHAR(512) NOT NULL
+);
@@ -0,0 +1,23 @@
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`group_id`, `user_id`),
+  INDEX `idx_user_group_member_user_id` (`user_id`)
+);
+
+CREATE TABLE `memo_group` (
+  `memo_id` INT NOT NULL,
+  `group_id` INT NOT NULL,
+  UNIQUE(`memo_id`, `group_id`),
+  INDEX `idx_memo_group_group_id` (`group_id`)
+);
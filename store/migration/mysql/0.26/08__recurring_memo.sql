@@ -0,0 +1,14 @@
+CREATE TABLE `recurring_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
+  `schedule` VARCHAR(256) NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` JSON NOT NULL,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `missed_runs` INT NOT NULL DEFAULT 0,
+  INDEX `idx_recurring_memo_creator_id` (`creator_id`)
+);
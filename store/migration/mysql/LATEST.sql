@@ -45,7 +45,10 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `trashed_ts` BIGINT NOT NULL DEFAULT 0,
+  `publish_ts` BIGINT NOT NULL DEFAULT 0,
+  FULLTEXT INDEX `idx_memo_content_fulltext` (`content`)
 );
 
 -- memo_organizer
@@ -107,7 +110,8 @@ CREATE TABLE `inbox` (
   `sender_id` INT NOT NULL,
   `receiver_id` INT NOT NULL,
   `status` TEXT NOT NULL,
-  `message` TEXT NOT NULL
+  `message` TEXT NOT NULL,
+  INDEX `idx_inbox_receiver_id` (`receiver_id`)
 );
 
 -- reaction
@@ -119,3 +123,93 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  INDEX `idx_memo_revision_memo_id` (`memo_id`)
+);
+
+-- memo_change
+CREATE TABLE `memo_change` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL,
+  `creator_id` INT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `type` VARCHAR(256) NOT NULL,
+  `resource_key` VARCHAR(512) NOT NULL
+);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_ts` BIGINT,
+  INDEX `idx_memo_share_memo_id` (`memo_id`)
+);
+
+-- memo_grant
+CREATE TABLE `memo_grant` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`memo_id`, `user_id`),
+  INDEX `idx_memo_grant_user_id` (`user_id`)
+);
+
+-- user_group
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`group_id`, `user_id`),
+  INDEX `idx_user_group_member_user_id` (`user_id`)
+);
+
+-- memo_group
+CREATE TABLE `memo_group` (
+  `memo_id` INT NOT NULL,
+  `group_id` INT NOT NULL,
+  UNIQUE(`memo_id`, `group_id`),
+  INDEX `idx_memo_group_group_id` (`group_id`)
+);
+
+-- recurring_memo
+CREATE TABLE `recurring_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
+  `schedule` VARCHAR(256) NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` JSON NOT NULL,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `missed_runs` INT NOT NULL DEFAULT 0,
+  INDEX `idx_recurring_memo_creator_id` (`creator_id`)
+);
@@ -0,0 +1 @@
+CREATE INDEX idx_memo_content_fts ON memo USING GIN (to_tsvector('simple', content));
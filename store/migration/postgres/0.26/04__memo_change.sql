@@ -0,0 +1,10 @@
+CREATE TABLE memo_change (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  type TEXT NOT NULL,
+  resource_key TEXT NOT NULL
+);
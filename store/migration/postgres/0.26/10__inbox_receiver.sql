@@ -0,0 +1 @@
+CREATE INDEX idx_inbox_receiver_id ON inbox (receiver_id);
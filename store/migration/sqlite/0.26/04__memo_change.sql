@@ -0,0 +1,10 @@
+CREATE TABLE memo_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  type TEXT NOT NULL,
+  resource_key TEXT NOT NULL
+);
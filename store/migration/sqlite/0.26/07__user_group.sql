@@ -0,0 +1,98 @@
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT '',
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+CREATE TABLE memo_group (
+  memo_id INTEGER NOT NULL,
+  group_id INTEGER NOT NULL,
+  UNIQUE(memo_id, group_id)
+);
+
+CREATE INDEX idx_memo_group_group_id ON memo_group (group_id);
+
+-- Rebuild the tables with a visibility check to allow the GROUP visibility.
+CREATE TABLE _memo_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE', 'GROUP')) DEFAULT 'PRIVATE',
+  pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}',
+  trashed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO _memo_new (id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload, trashed_ts)
+SELECT id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload, trashed_ts FROM memo;
+
+DROP TABLE memo;
+
+ALTER TABLE _memo_new RENAME TO memo;
+
+CREATE INDEX idx_memo_creator_id ON memo (creator_id);
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TABLE _memo_revision_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE', 'GROUP')) DEFAULT 'PRIVATE'
+);
+
+INSERT INTO _memo_revision_new (id, memo_id, creator_id, created_ts, content, visibility)
+SELECT id, memo_id, creator_id, created_ts, content, visibility FROM memo_revision;
+
+DROP TABLE memo_revision;
+
+ALTER TABLE _memo_revision_new RENAME TO memo_revision;
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+CREATE TABLE _memo_change_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  creator_id INTEGER NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE', 'GROUP')) DEFAULT 'PRIVATE',
+  type TEXT NOT NULL,
+  resource_key TEXT NOT NULL
+);
+
+INSERT INTO _memo_change_new (id, created_ts, memo_id, memo_uid, creator_id, visibility, type, resource_key)
+SELECT id, created_ts, memo_id, memo_uid, creator_id, visibility, type, resource_key FROM memo_change;
+
+DROP TABLE memo_change;
+
+ALTER TABLE _memo_change_new RENAME TO memo_change;
@@ -0,0 +1,15 @@
+CREATE TABLE recurring_memo (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  schedule TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  missed_runs INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_recurring_memo_creator_id ON recurring_memo (creator_id);
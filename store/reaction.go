@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"strings"
 )
 
 type Reaction struct {
@@ -25,7 +27,18 @@ type DeleteReaction struct {
 }
 
 func (s *Store) UpsertReaction(ctx context.Context, upsert *Reaction) (*Reaction, error) {
-	return s.driver.UpsertReaction(ctx, upsert)
+	var reaction *Reaction
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		reaction, err = s.driver.UpsertReaction(ctx, upsert)
+		if err != nil {
+			return err
+		}
+		return s.recordReactionChange(ctx, reaction)
+	}); err != nil {
+		return nil, err
+	}
+	return reaction, nil
 }
 
 func (s *Store) ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error) {
@@ -33,5 +46,31 @@ func (s *Store) ListReactions(ctx context.Context, find *FindReaction) ([]*React
 }
 
 func (s *Store) DeleteReaction(ctx context.Context, delete *DeleteReaction) error {
-	return s.driver.DeleteReaction(ctx, delete)
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		reactions, err := s.driver.ListReactions(ctx, &FindReaction{ID: &delete.ID})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.DeleteReaction(ctx, delete); err != nil {
+			return err
+		}
+		for _, reaction := range reactions {
+			if err := s.recordReactionChange(ctx, reaction); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) recordReactionChange(ctx context.Context, reaction *Reaction) error {
+	memoUID, ok := strings.CutPrefix(reaction.ContentID, "memos/")
+	if !ok {
+		return nil
+	}
+	memo, err := s.getMemoForChange(ctx, &FindMemo{UID: &memoUID})
+	if err != nil || memo == nil {
+		return err
+	}
+	return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeReaction, fmt.Sprintf("%d", reaction.ID))
 }
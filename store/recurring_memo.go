@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// RecurringMemo creates a memo for its creator on every run of a cron schedule.
+type RecurringMemo struct {
+	ID  int32
+	UID string
+
+	// Standard fields
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+	// RowStatus is Archived while the recurring memo is paused.
+	RowStatus RowStatus
+
+	// Domain specific fields
+	Schedule   string
+	Visibility Visibility
+	Payload    *storepb.RecurringMemoPayload
+	// LastRunTs is the scheduled time of the last run, or 0 if it never ran.
+	LastRunTs int64
+	// MissedRuns counts the scheduled runs that didn't create a memo, e.g. while the server was down.
+	MissedRuns int32
+}
+
+type FindRecurringMemo struct {
+	ID        *int32
+	UID       *string
+	CreatorID *int32
+	RowStatus *RowStatus
+}
+
+type UpdateRecurringMemo struct {
+	ID         int32
+	UpdatedTs  *int64
+	RowStatus  *RowStatus
+	Schedule   *string
+	Visibility *Visibility
+	Payload    *storepb.RecurringMemoPayload
+	LastRunTs  *int64
+	MissedRuns *int32
+}
+
+type DeleteRecurringMemo struct {
+	ID int32
+}
+
+func (s *Store) CreateRecurringMemo(ctx context.Context, create *RecurringMemo) (*RecurringMemo, error) {
+	return s.driver.CreateRecurringMemo(ctx, create)
+}
+
+func (s *Store) ListRecurringMemos(ctx context.Context, find *FindRecurringMemo) ([]*RecurringMemo, error) {
+	return s.driver.ListRecurringMemos(ctx, find)
+}
+
+func (s *Store) GetRecurringMemo(ctx context.Context, find *FindRecurringMemo) (*RecurringMemo, error) {
+	list, err := s.ListRecurringMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateRecurringMemo(ctx context.Context, update *UpdateRecurringMemo) (*RecurringMemo, error) {
+	return s.driver.UpdateRecurringMemo(ctx, update)
+}
+
+func (s *Store) DeleteRecurringMemo(ctx context.Context, delete *DeleteRecurringMemo) error {
+	return s.driver.DeleteRecurringMemo(ctx, delete)
+}
@@ -52,3 +52,35 @@ func TestInboxStore(t *testing.T) {
 	require.Equal(t, 0, len(inboxes))
 	ts.Close()
 }
+
+func TestInboxStoreFindByMessage(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memoID, otherMemoID := int32(1), int32(2)
+	for _, message := range []*storepb.InboxMessage{
+		{Type: storepb.InboxMessage_MEMO_REACTION, MemoId: &memoID},
+		{Type: storepb.InboxMessage_MEMO_REFERENCE, MemoId: &memoID},
+		{Type: storepb.InboxMessage_MEMO_REACTION, MemoId: &otherMemoID},
+		{Type: storepb.InboxMessage_MEMO_COMMENT},
+	} {
+		_, err := ts.CreateInbox(ctx, &store.Inbox{
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message:    message,
+		})
+		require.NoError(t, err)
+	}
+	messageType := storepb.InboxMessage_MEMO_REACTION
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:  &user.ID,
+		MessageType: &messageType,
+		MemoID:      &memoID,
+	})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, storepb.InboxMessage_MEMO_REACTION, inboxes[0].Message.Type)
+	require.Equal(t, memoID, inboxes[0].Message.GetMemoId())
+	ts.Close()
+}
@@ -0,0 +1,104 @@
+package teststore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoChangeStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	latest, err := ts.GetLatestMemoChange(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Nil(t, latest)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	relatedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "related-memo",
+		CreatorID:  user.ID,
+		Content:    "related_content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: relatedMemo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	private := store.Private
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Visibility: &private,
+	})
+	require.NoError(t, err)
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: relatedMemo.ID})
+	require.NoError(t, err)
+
+	changes, err := ts.ListMemoChanges(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(changes))
+	require.Equal(t, store.MemoChangeMemo, changes[0].Type)
+	require.Equal(t, memo.UID, changes[0].ResourceKey)
+	require.Equal(t, store.MemoChangeRelation, changes[2].Type)
+	require.Equal(t, memo.ID, changes[2].MemoID)
+	relationType, relatedMemoUID, ok := store.ParseMemoRelationChangeKey(changes[2].ResourceKey)
+	require.True(t, ok)
+	require.Equal(t, store.MemoRelationReference, relationType)
+	require.Equal(t, relatedMemo.UID, relatedMemoUID)
+	// Narrowing the visibility keeps the wider one, so that former viewers learn about the change.
+	require.Equal(t, store.Public, changes[3].Visibility)
+	require.Equal(t, relatedMemo.ID, changes[4].MemoID)
+
+	latest, err = ts.GetLatestMemoChange(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Equal(t, changes[4].ID, latest.ID)
+
+	changes, err = ts.ListMemoChanges(ctx, &store.FindMemoChange{AfterID: &changes[2].ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+
+	// A memo isn't saved without its change, nor a change without its memo.
+	err = ts.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        "rolled-back-memo",
+			CreatorID:  user.ID,
+			Content:    "rolled_back_content",
+			Visibility: store.Public,
+		}); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	require.EqualError(t, err, "rollback")
+	rolledBackUID := "rolled-back-memo"
+	rolledBackMemo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &rolledBackUID})
+	require.NoError(t, err)
+	require.Nil(t, rolledBackMemo)
+	latest, err = ts.GetLatestMemoChange(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Equal(t, changes[1].ID, latest.ID)
+
+	createdBefore := time.Now().Add(time.Minute).Unix()
+	err = ts.DeleteMemoChange(ctx, &store.DeleteMemoChange{CreatedBefore: &createdBefore})
+	require.NoError(t, err)
+	changes, err = ts.ListMemoChanges(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	ts.Close()
+}
@@ -0,0 +1,75 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoGrantStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	grant, err := ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		CreatorID: user.ID,
+		MemoID:    memo.ID,
+		UserID:    otherUser.ID,
+		Role:      store.MemoGrantRoleViewer,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, grant.ID)
+	require.Equal(t, store.MemoGrantRoleViewer, grant.Role)
+
+	// Upserting the grant of the same user changes its role instead of adding a grant.
+	updated, err := ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		CreatorID: user.ID,
+		MemoID:    memo.ID,
+		UserID:    otherUser.ID,
+		Role:      store.MemoGrantRoleEditor,
+	})
+	require.NoError(t, err)
+	require.Equal(t, grant.ID, updated.ID)
+	require.Equal(t, store.MemoGrantRoleEditor, updated.Role)
+
+	grants, err := ts.ListMemoGrants(ctx, &store.FindMemoGrant{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	found, err := ts.GetMemoGrant(ctx, &store.FindMemoGrant{UserID: &otherUser.ID})
+	require.NoError(t, err)
+	require.Equal(t, grant.ID, found.ID)
+
+	// Memos granted to the user match the granted_to filter.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{fmt.Sprintf("granted_to(%d)", otherUser.ID)}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: []string{fmt.Sprintf("granted_to(%d)", user.ID)}})
+	require.NoError(t, err)
+	require.Len(t, memos, 0)
+
+	err = ts.DeleteMemoGrant(ctx, &store.DeleteMemoGrant{MemoID: &memo.ID})
+	require.NoError(t, err)
+	grants, err = ts.ListMemoGrants(ctx, &store.FindMemoGrant{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, grants, 0)
+
+	ts.Close()
+}
@@ -0,0 +1,63 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	revisionOne, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "first version",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "first version", revisionOne.Content)
+	revisionTwo, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "second version",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(revisions))
+	// Newest revisions come first.
+	require.Equal(t, revisionTwo.ID, revisions[0].ID)
+	require.Equal(t, store.Private, revisions[1].Visibility)
+
+	limit := 1
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(revisions))
+
+	revision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisionOne.ID})
+	require.NoError(t, err)
+	require.Equal(t, "first version", revision.Content)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+	ts.Close()
+}
@@ -0,0 +1,65 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	expiresTs := int64(1893456000)
+	share, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		UID:       "share-one",
+		Token:     "token-one",
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		ExpiresTs: &expiresTs,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, share.ID)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{
+		UID:       "share-two",
+		Token:     "token-two",
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+	})
+	require.NoError(t, err)
+
+	token := "token-one"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, share.ID, found.ID)
+	require.Equal(t, expiresTs, *found.ExpiresTs)
+
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shares))
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &share.ID})
+	require.NoError(t, err)
+	found, err = ts.GetMemoShare(ctx, &store.FindMemoShare{Token: &token})
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	shares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shares))
+	ts.Close()
+}
@@ -2,6 +2,7 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -114,3 +115,90 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestMemoTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	trashedTs := int64(1000)
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		TrashedTs: &trashedTs,
+	})
+	require.NoError(t, err)
+
+	// Memos in the trash are excluded by default.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{InTrash: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, trashedTs, memoList[0].TrashedTs)
+
+	trashedBefore := int64(500)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{InTrash: true, TrashedBefore: &trashedBefore})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	trashedBefore = 2000
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{InTrash: true, TrashedBefore: &trashedBefore})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	ts.Close()
+}
+
+func TestMemoListWithCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Memos sharing the same timestamp are ordered by id.
+	for i, createdTs := range []int64{100, 200, 200, 200, 300} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("cursor-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			CreatedTs:  createdTs,
+		})
+		require.NoError(t, err)
+	}
+
+	for _, orderByTimeAsc := range []bool{false, true} {
+		uids := []string{}
+		var cursor *store.Cursor
+		for {
+			limit := 2
+			memos, err := ts.ListMemos(ctx, &store.FindMemo{
+				Limit:          &limit,
+				Cursor:         cursor,
+				OrderByTimeAsc: orderByTimeAsc,
+			})
+			require.NoError(t, err)
+			if len(memos) == 0 {
+				break
+			}
+			for _, memo := range memos {
+				uids = append(uids, memo.UID)
+			}
+			last := memos[len(memos)-1]
+			cursor = &store.Cursor{Timestamp: last.CreatedTs, ID: last.ID}
+		}
+		if orderByTimeAsc {
+			require.Equal(t, []string{"cursor-memo-0", "cursor-memo-1", "cursor-memo-2", "cursor-memo-3", "cursor-memo-4"}, uids)
+		} else {
+			require.Equal(t, []string{"cursor-memo-4", "cursor-memo-3", "cursor-memo-2", "cursor-memo-1", "cursor-memo-0"}, uids)
+		}
+	}
+	ts.Close()
+}
@@ -0,0 +1,62 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRecurringMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	recurringMemo, err := ts.CreateRecurringMemo(ctx, &store.RecurringMemo{
+		UID:        "stand-up",
+		CreatorID:  user.ID,
+		Schedule:   "0 9 * * 1-5",
+		Visibility: store.Private,
+		Payload: &storepb.RecurringMemoPayload{
+			Content:   "# Stand-up {{date}} for {{team}}",
+			Variables: map[string]string{"team": "platform"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, recurringMemo.ID)
+	require.Equal(t, store.Normal, recurringMemo.RowStatus)
+	require.Zero(t, recurringMemo.LastRunTs)
+
+	lastRunTs, missedRuns := int64(1700000000), int32(2)
+	updated, err := ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:         recurringMemo.ID,
+		LastRunTs:  &lastRunTs,
+		MissedRuns: &missedRuns,
+	})
+	require.NoError(t, err)
+	require.Equal(t, lastRunTs, updated.LastRunTs)
+	require.Equal(t, missedRuns, updated.MissedRuns)
+	require.Equal(t, "platform", updated.Payload.Variables["team"])
+
+	archived := store.Archived
+	_, err = ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{ID: recurringMemo.ID, RowStatus: &archived})
+	require.NoError(t, err)
+	normal := store.Normal
+	list, err := ts.ListRecurringMemos(ctx, &store.FindRecurringMemo{RowStatus: &normal})
+	require.NoError(t, err)
+	require.Empty(t, list)
+	list, err = ts.ListRecurringMemos(ctx, &store.FindRecurringMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	err = ts.DeleteRecurringMemo(ctx, &store.DeleteRecurringMemo{ID: recurringMemo.ID})
+	require.NoError(t, err)
+	found, err := ts.GetRecurringMemo(ctx, &store.FindRecurringMemo{UID: &recurringMemo.UID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	ts.Close()
+}
@@ -44,6 +44,13 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS memo;
 		DROP TABLE IF EXISTS memo_organizer;
 		DROP TABLE IF EXISTS memo_relation;
+		DROP TABLE IF EXISTS memo_revision;
+		DROP TABLE IF EXISTS memo_change;
+		DROP TABLE IF EXISTS memo_share;
+		DROP TABLE IF EXISTS memo_grant;
+		DROP TABLE IF EXISTS memo_group;
+		DROP TABLE IF EXISTS user_group;
+		DROP TABLE IF EXISTS user_group_member;
 		DROP TABLE IF EXISTS resource;
 		DROP TABLE IF EXISTS tag;
 		DROP TABLE IF EXISTS activity;
@@ -64,6 +71,13 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS memo CASCADE;
 		DROP TABLE IF EXISTS memo_organizer CASCADE;
 		DROP TABLE IF EXISTS memo_relation CASCADE;
+		DROP TABLE IF EXISTS memo_revision CASCADE;
+		DROP TABLE IF EXISTS memo_change CASCADE;
+		DROP TABLE IF EXISTS memo_share CASCADE;
+		DROP TABLE IF EXISTS memo_grant CASCADE;
+		DROP TABLE IF EXISTS memo_group CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;
 		DROP TABLE IF EXISTS resource CASCADE;
 		DROP TABLE IF EXISTS tag CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
@@ -0,0 +1,85 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID:   user.ID,
+		Name:        "engineering",
+		Description: "The engineering team",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, group.ID)
+	require.Equal(t, "engineering", group.Name)
+
+	name := "platform"
+	updated, err := ts.UpdateUserGroup(ctx, &store.UpdateUserGroup{ID: group.ID, Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, "platform", updated.Name)
+	require.Equal(t, "The engineering team", updated.Description)
+	found, err := ts.GetUserGroup(ctx, &store.FindUserGroup{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, group.ID, found.ID)
+
+	// Adding a member twice keeps a single membership.
+	for i := 0; i < 2; i++ {
+		_, err = ts.UpsertUserGroupMember(ctx, &store.UserGroupMember{GroupID: group.ID, UserID: member.ID})
+		require.NoError(t, err)
+	}
+	members, err := ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, member.ID, members[0].UserID)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Group,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoGroup(ctx, &store.MemoGroup{MemoID: memo.ID, GroupID: group.ID})
+	require.NoError(t, err)
+
+	// Group memos match the in_groups_of filter of the members only.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{fmt.Sprintf("in_groups_of(%d)", member.ID)}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: []string{fmt.Sprintf("in_groups_of(%d)", user.ID)}})
+	require.NoError(t, err)
+	require.Len(t, memos, 0)
+
+	// Deleting the group removes its memberships and memo targets.
+	err = ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: group.ID})
+	require.NoError(t, err)
+	members, err = ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Len(t, members, 0)
+	memoGroups, err := ts.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, memoGroups, 0)
+	groups, err := ts.ListUserGroups(ctx, &store.FindUserGroup{})
+	require.NoError(t, err)
+	require.Len(t, groups, 0)
+
+	ts.Close()
+}
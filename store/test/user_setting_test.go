@@ -2,6 +2,8 @@ package teststore
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +28,24 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserSettingStoreConcurrentTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// Concurrent updates must not overwrite each other's tags.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, ts.UpsertUserTag(ctx, user.ID, &storepb.TagsUserSetting_Tag{Tag: fmt.Sprintf("tag%d", i)}))
+		}(i)
+	}
+	wg.Wait()
+	tags, err := ts.GetUserTags(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(tags))
+	ts.Close()
+}
@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserGroup is a named set of users that memos with the GROUP visibility can target.
+type UserGroup struct {
+	ID int32
+
+	// Standard fields
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Domain specific fields
+	Name        string
+	Description string
+}
+
+type FindUserGroup struct {
+	ID     *int32
+	Name   *string
+	IDList []int32
+}
+
+type UpdateUserGroup struct {
+	ID          int32
+	UpdatedTs   *int64
+	Name        *string
+	Description *string
+}
+
+type DeleteUserGroup struct {
+	ID int32
+}
+
+// UserGroupMember is the membership of a user in a group.
+type UserGroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+type DeleteUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+// MemoGroup is a group targeted by a memo with the GROUP visibility.
+type MemoGroup struct {
+	MemoID  int32
+	GroupID int32
+}
+
+type FindMemoGroup struct {
+	MemoID  *int32
+	GroupID *int32
+}
+
+type DeleteMemoGroup struct {
+	MemoID  *int32
+	GroupID *int32
+}
+
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error) {
+	return s.driver.CreateUserGroup(ctx, create)
+}
+
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error) {
+	return s.driver.ListUserGroups(ctx, find)
+}
+
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroup) (*UserGroup, error) {
+	list, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error) {
+	return s.driver.UpdateUserGroup(ctx, update)
+}
+
+// DeleteUserGroup deletes the group along with its memberships and memo targets.
+func (s *Store) DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error {
+	if err := s.driver.DeleteUserGroupMember(ctx, &DeleteUserGroupMember{GroupID: &delete.ID}); err != nil {
+		return err
+	}
+	if err := s.driver.DeleteMemoGroup(ctx, &DeleteMemoGroup{GroupID: &delete.ID}); err != nil {
+		return err
+	}
+	return s.driver.DeleteUserGroup(ctx, delete)
+}
+
+func (s *Store) UpsertUserGroupMember(ctx context.Context, upsert *UserGroupMember) (*UserGroupMember, error) {
+	return s.driver.UpsertUserGroupMember(ctx, upsert)
+}
+
+func (s *Store) ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error) {
+	return s.driver.ListUserGroupMembers(ctx, find)
+}
+
+func (s *Store) DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error {
+	return s.driver.DeleteUserGroupMember(ctx, delete)
+}
+
+func (s *Store) UpsertMemoGroup(ctx context.Context, upsert *MemoGroup) (*MemoGroup, error) {
+	var memoGroup *MemoGroup
+	if err := s.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		memoGroup, err = s.driver.UpsertMemoGroup(ctx, upsert)
+		if err != nil {
+			return err
+		}
+		return s.recordMemoGroupChange(ctx, memoGroup)
+	}); err != nil {
+		return nil, err
+	}
+	return memoGroup, nil
+}
+
+func (s *Store) ListMemoGroups(ctx context.Context, find *FindMemoGroup) ([]*MemoGroup, error) {
+	return s.driver.ListMemoGroups(ctx, find)
+}
+
+func (s *Store) DeleteMemoGroup(ctx context.Context, delete *DeleteMemoGroup) error {
+	return s.RunInTx(ctx, func(ctx context.Context) error {
+		memoGroups, err := s.driver.ListMemoGroups(ctx, &FindMemoGroup{MemoID: delete.MemoID, GroupID: delete.GroupID})
+		if err != nil {
+			return err
+		}
+		if err := s.driver.DeleteMemoGroup(ctx, delete); err != nil {
+			return err
+		}
+		for _, memoGroup := range memoGroups {
+			if err := s.recordMemoGroupChange(ctx, memoGroup); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) recordMemoGroupChange(ctx context.Context, memoGroup *MemoGroup) error {
+	memo, err := s.getMemoForChange(ctx, &FindMemo{ID: &memoGroup.MemoID})
+	if err != nil || memo == nil {
+		return err
+	}
+	return s.recordMemoChange(ctx, memo, memo.Visibility, MemoChangeGroup, fmt.Sprintf("%d", memoGroup.GroupID))
+}
@@ -19,6 +19,11 @@ type UserSetting struct {
 type FindUserSetting struct {
 	UserID *int32
 	Key    storepb.UserSetting_Key
+	// EmailIngestToken only matches the email ingest settings with the token.
+	EmailIngestToken *string
+	// ForUpdate locks the matched settings until the end of the transaction.
+	// SQLite transactions lock the whole database already, so it's only used by the other drivers.
+	ForUpdate bool
 }
 
 func (s *Store) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
@@ -42,6 +47,42 @@ func (s *Store) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetti
 	return userSetting, nil
 }
 
+// UpdateUserSetting reads the setting of the user with the key and saves it once update changed it.
+// Settings that don't exist yet are passed to update empty. The setting is read from the database and locked
+// in the transaction it's saved in, so that concurrent updates of the same setting don't overwrite each other.
+func (s *Store) UpdateUserSetting(ctx context.Context, userID int32, key storepb.UserSetting_Key, update func(userSetting *storepb.UserSetting) error) error {
+	err := s.RunInTx(ctx, func(ctx context.Context) error {
+		userSettingRawList, err := s.driver.ListUserSettings(ctx, &FindUserSetting{
+			UserID:    &userID,
+			Key:       key,
+			ForUpdate: true,
+		})
+		if err != nil {
+			return err
+		}
+		userSetting := &storepb.UserSetting{UserId: userID, Key: key}
+		if len(userSettingRawList) > 0 {
+			existing, err := convertUserSettingFromRaw(userSettingRawList[0])
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				userSetting = existing
+			}
+		}
+		if err := update(userSetting); err != nil {
+			return err
+		}
+		_, err = s.UpsertUserSetting(ctx, userSetting)
+		return err
+	})
+	if err != nil {
+		// The setting may have been cached before the transaction failed.
+		s.userSettingCache.Delete(ctx, getUserSettingCacheKey(userID, key.String()))
+	}
+	return err
+}
+
 func (s *Store) ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*storepb.UserSetting, error) {
 	userSettingRawList, err := s.driver.ListUserSettings(ctx, find)
 	if err != nil {
@@ -349,6 +390,189 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserTags returns the tag metadata of the user.
+func (s *Store) GetUserTags(ctx context.Context, userID int32) ([]*storepb.TagsUserSetting_Tag, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TAGS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.TagsUserSetting_Tag{}, nil
+	}
+
+	tagsUserSetting := userSetting.GetTags()
+	return tagsUserSetting.Tags, nil
+}
+
+// UpsertUserTag adds the metadata of a tag for the user, replacing any existing metadata of the same tag.
+func (s *Store) UpsertUserTag(ctx context.Context, userID int32, tag *storepb.TagsUserSetting_Tag) error {
+	return s.UpdateUserSetting(ctx, userID, storepb.UserSetting_TAGS, func(userSetting *storepb.UserSetting) error {
+		existingTags := userSetting.GetTags().GetTags()
+		updatedTags := make([]*storepb.TagsUserSetting_Tag, 0, len(existingTags)+1)
+		tagExists := false
+		for _, existing := range existingTags {
+			if existing.Tag == tag.Tag {
+				updatedTags = append(updatedTags, tag)
+				tagExists = true
+			} else {
+				updatedTags = append(updatedTags, existing)
+			}
+		}
+		if !tagExists {
+			updatedTags = append(updatedTags, tag)
+		}
+
+		userSetting.Value = &storepb.UserSetting_Tags{
+			Tags: &storepb.TagsUserSetting{
+				Tags: updatedTags,
+			},
+		}
+		return nil
+	})
+}
+
+// RemoveUserTag removes the metadata of a tag for the user.
+func (s *Store) RemoveUserTag(ctx context.Context, userID int32, tag string) error {
+	return s.UpdateUserSetting(ctx, userID, storepb.UserSetting_TAGS, func(userSetting *storepb.UserSetting) error {
+		oldTags := userSetting.GetTags().GetTags()
+		newTags := make([]*storepb.TagsUserSetting_Tag, 0, len(oldTags))
+		for _, existing := range oldTags {
+			if existing.Tag != tag {
+				newTags = append(newTags, existing)
+			}
+		}
+
+		userSetting.Value = &storepb.UserSetting_Tags{
+			Tags: &storepb.TagsUserSetting{
+				Tags: newTags,
+			},
+		}
+		return nil
+	})
+}
+
+// GetUserTagAliases returns, for every tag of the user that has aliases, the other tags that mean the same.
+// For "kubernetes" with the alias "k8s", both "kubernetes" -> ["k8s"] and "k8s" -> ["kubernetes"] are returned.
+func (s *Store) GetUserTagAliases(ctx context.Context, userID int32) (map[string][]string, error) {
+	tags, err := s.GetUserTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagAliases := map[string][]string{}
+	for _, tag := range tags {
+		if len(tag.Aliases) == 0 {
+			continue
+		}
+		group := append([]string{tag.Tag}, tag.Aliases...)
+		for _, name := range group {
+			for _, other := range group {
+				if other != name {
+					tagAliases[name] = append(tagAliases[name], other)
+				}
+			}
+		}
+	}
+	return tagAliases, nil
+}
+
+// GetUserTemplates returns the memo templates of the user.
+func (s *Store) GetUserTemplates(ctx context.Context, userID int32) ([]*storepb.MemoTemplate, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TEMPLATES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.MemoTemplate{}, nil
+	}
+
+	templatesUserSetting := userSetting.GetTemplates()
+	return templatesUserSetting.Templates, nil
+}
+
+// UpsertUserTemplate adds a memo template for the user, replacing any existing template with the same id.
+func (s *Store) UpsertUserTemplate(ctx context.Context, userID int32, template *storepb.MemoTemplate) error {
+	return s.UpdateUserSetting(ctx, userID, storepb.UserSetting_TEMPLATES, func(userSetting *storepb.UserSetting) error {
+		existingTemplates := userSetting.GetTemplates().GetTemplates()
+		updatedTemplates := make([]*storepb.MemoTemplate, 0, len(existingTemplates)+1)
+		templateExists := false
+		for _, existing := range existingTemplates {
+			if existing.Id == template.Id {
+				updatedTemplates = append(updatedTemplates, template)
+				templateExists = true
+			} else {
+				updatedTemplates = append(updatedTemplates, existing)
+			}
+		}
+		if !templateExists {
+			updatedTemplates = append(updatedTemplates, template)
+		}
+
+		userSetting.Value = &storepb.UserSetting_Templates{
+			Templates: &storepb.TemplatesUserSetting{
+				Templates: updatedTemplates,
+			},
+		}
+		return nil
+	})
+}
+
+// RemoveUserTemplate removes a memo template of the user.
+func (s *Store) RemoveUserTemplate(ctx context.Context, userID int32, templateID string) error {
+	return s.UpdateUserSetting(ctx, userID, storepb.UserSetting_TEMPLATES, func(userSetting *storepb.UserSetting) error {
+		oldTemplates := userSetting.GetTemplates().GetTemplates()
+		newTemplates := make([]*storepb.MemoTemplate, 0, len(oldTemplates))
+		for _, existing := range oldTemplates {
+			if existing.Id != templateID {
+				newTemplates = append(newTemplates, existing)
+			}
+		}
+
+		userSetting.Value = &storepb.UserSetting_Templates{
+			Templates: &storepb.TemplatesUserSetting{
+				Templates: newTemplates,
+			},
+		}
+		return nil
+	})
+}
+
+// GetUserNotificationsSetting returns the notification preferences of the user.
+func (s *Store) GetUserNotificationsSetting(ctx context.Context, userID int32) (*storepb.NotificationsUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_NOTIFICATIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.NotificationsUserSetting{}, nil
+	}
+	return userSetting.GetNotifications(), nil
+}
+
+// GetUserEmailIngestSetting returns the settings of creating memos by email of the user.
+func (s *Store) GetUserEmailIngestSetting(ctx context.Context, userID int32) (*storepb.EmailIngestUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_EMAIL_INGEST,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.EmailIngestUserSetting{}, nil
+	}
+	return userSetting.GetEmailIngest(), nil
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -386,6 +610,30 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := &storepb.TagsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), tagsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Tags{Tags: tagsUserSetting}
+	case storepb.UserSetting_TEMPLATES:
+		templatesUserSetting := &storepb.TemplatesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), templatesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Templates{Templates: templatesUserSetting}
+	case storepb.UserSetting_NOTIFICATIONS:
+		notificationsUserSetting := &storepb.NotificationsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notifications{Notifications: notificationsUserSetting}
+	case storepb.UserSetting_EMAIL_INGEST:
+		emailIngestUserSetting := &storepb.EmailIngestUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), emailIngestUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_EmailIngest{EmailIngest: emailIngestUserSetting}
 	default:
 		return nil, nil
 	}
@@ -434,6 +682,34 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := userSetting.GetTags()
+		value, err := protojson.Marshal(tagsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TEMPLATES:
+		templatesUserSetting := userSetting.GetTemplates()
+		value, err := protojson.Marshal(templatesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATIONS:
+		notificationsUserSetting := userSetting.GetNotifications()
+		value, err := protojson.Marshal(notificationsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_EMAIL_INGEST:
+		emailIngestUserSetting := userSetting.GetEmailIngest()
+		value, err := protojson.Marshal(emailIngestUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -41,6 +41,12 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
 	} else if upsert.Key == storepb.WorkspaceSettingKey_AI {
 		valueBytes, err = protojson.Marshal(upsert.GetAiSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_TEMPLATE {
+		valueBytes, err = protojson.Marshal(upsert.GetTemplateSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_SMTP {
+		valueBytes, err = protojson.Marshal(upsert.GetSmtpSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_EMAIL_INGEST {
+		valueBytes, err = protojson.Marshal(upsert.GetEmailIngestSetting())
 	} else {
 		return nil, errors.Errorf("unsupported workspace setting key: %v", upsert.Key)
 	}
@@ -150,6 +156,9 @@ var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕"
 // DefaultNsfwTags is the default tags that mark content as NSFW for blurring.
 var DefaultNsfwTags = []string{"nsfw"}
 
+// DefaultTrashRetentionDays is the default number of days memos stay in the trash.
+const DefaultTrashRetentionDays = 30
+
 func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.WorkspaceMemoRelatedSetting, error) {
 	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
 		Name: storepb.WorkspaceSettingKey_MEMO_RELATED.String(),
@@ -171,6 +180,9 @@ func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.Wo
 	if len(workspaceMemoRelatedSetting.NsfwTags) == 0 {
 		workspaceMemoRelatedSetting.NsfwTags = append(workspaceMemoRelatedSetting.NsfwTags, DefaultNsfwTags...)
 	}
+	if workspaceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		workspaceMemoRelatedSetting.TrashRetentionDays = DefaultTrashRetentionDays
+	}
 	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_MEMO_RELATED.String(), &storepb.WorkspaceSetting{
 		Key:   storepb.WorkspaceSettingKey_MEMO_RELATED,
 		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: workspaceMemoRelatedSetting},
@@ -256,6 +268,71 @@ func (s *Store) GetWorkspaceAISetting(ctx context.Context) (*storepb.WorkspaceAI
 	return workspaceAISetting, nil
 }
 
+func (s *Store) GetWorkspaceTemplateSetting(ctx context.Context) (*storepb.WorkspaceTemplateSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_TEMPLATE.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace template setting")
+	}
+
+	workspaceTemplateSetting := &storepb.WorkspaceTemplateSetting{}
+	if workspaceSetting != nil {
+		workspaceTemplateSetting = workspaceSetting.GetTemplateSetting()
+	}
+	return workspaceTemplateSetting, nil
+}
+
+// defaultSMTPPort is the submission port, used with STARTTLS.
+const defaultSMTPPort = int32(587)
+
+func (s *Store) GetWorkspaceSMTPSetting(ctx context.Context) (*storepb.WorkspaceSMTPSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_SMTP.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace SMTP setting")
+	}
+
+	workspaceSMTPSetting := &storepb.WorkspaceSMTPSetting{}
+	if workspaceSetting != nil {
+		workspaceSMTPSetting = workspaceSetting.GetSmtpSetting()
+	}
+	if workspaceSMTPSetting.Port <= 0 {
+		workspaceSMTPSetting.Port = defaultSMTPPort
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_SMTP.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_SMTP,
+		Value: &storepb.WorkspaceSetting_SmtpSetting{SmtpSetting: workspaceSMTPSetting},
+	})
+	return workspaceSMTPSetting, nil
+}
+
+// defaultEmailIngestMaxMessageSizeMb is the max size of received emails when it isn't set.
+const defaultEmailIngestMaxMessageSizeMb = int64(25)
+
+func (s *Store) GetWorkspaceEmailIngestSetting(ctx context.Context) (*storepb.WorkspaceEmailIngestSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_EMAIL_INGEST.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace email ingest setting")
+	}
+
+	workspaceEmailIngestSetting := &storepb.WorkspaceEmailIngestSetting{}
+	if workspaceSetting != nil {
+		workspaceEmailIngestSetting = workspaceSetting.GetEmailIngestSetting()
+	}
+	if workspaceEmailIngestSetting.MaxMessageSizeMb <= 0 {
+		workspaceEmailIngestSetting.MaxMessageSizeMb = defaultEmailIngestMaxMessageSizeMb
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_EMAIL_INGEST.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_EMAIL_INGEST,
+		Value: &storepb.WorkspaceSetting_EmailIngestSetting{EmailIngestSetting: workspaceEmailIngestSetting},
+	})
+	return workspaceEmailIngestSetting, nil
+}
+
 // loadAISettingFromEnv loads AI configuration from environment variables.
 func loadAISettingFromEnv() *storepb.WorkspaceAISetting {
 	timeoutSeconds := defaultAITimeoutSeconds
@@ -315,6 +392,24 @@ func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*sto
 			return nil, err
 		}
 		workspaceSetting.Value = &storepb.WorkspaceSetting_AiSetting{AiSetting: aiSetting}
+	case storepb.WorkspaceSettingKey_TEMPLATE.String():
+		templateSetting := &storepb.WorkspaceTemplateSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), templateSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_TemplateSetting{TemplateSetting: templateSetting}
+	case storepb.WorkspaceSettingKey_SMTP.String():
+		smtpSetting := &storepb.WorkspaceSMTPSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), smtpSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SmtpSetting{SmtpSetting: smtpSetting}
+	case storepb.WorkspaceSettingKey_EMAIL_INGEST.String():
+		emailIngestSetting := &storepb.WorkspaceEmailIngestSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), emailIngestSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmailIngestSetting{EmailIngestSetting: emailIngestSetting}
 	default:
 		// Skip unsupported workspace setting key.
 		return nil, nil